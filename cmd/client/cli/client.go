@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"log"
+	"os"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -15,9 +16,27 @@ var rootCommand = &cobra.Command{
 	Long:  "cli to interact with slef hosted spawner service",
 }
 
+//tokenEnv environment variable holding the api token sent to spawner
+const tokenEnv = "SPAWNER_TOKEN"
+
+//bearerToken sends the api token with each call
+type bearerToken string
+
+func (t bearerToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (t bearerToken) RequireTransportSecurity() bool {
+	return false
+}
+
 func getSpawnerConn(addr string) (*grpc.ClientConn, error) {
 	log.Println("connecting to ", addr, "...")
-	return grpc.Dial(addr, grpc.WithInsecure(), grpc.WithTimeout(time.Second))
+	opts := []grpc.DialOption{grpc.WithInsecure(), grpc.WithTimeout(time.Second)}
+	if token := os.Getenv(tokenEnv); token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(bearerToken(token)))
+	}
+	return grpc.Dial(addr, opts...)
 }
 
 func setupCommands() {
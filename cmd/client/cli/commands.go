@@ -69,18 +69,18 @@ func createCluster() *cobra.Command {
 
 			log.Printf("creating cluster '%s'\n", name)
 
-			_, err = client.CreateCluster(cmd.Context(), req)
+			res, err := client.CreateCluster(cmd.Context(), req)
 
 			if err != nil {
 				log.Fatal("create cluster failed: ", err.Error())
 			}
 
-			if req.Provider == "aws" {
-				stat := waitUntilClusterReady(cmd.Context(), client, req)
-				if stat != "ACTIVE" {
-					log.Fatal("failed to wait on cluster activation, please check provider portal")
-				}
+			_, err = waitForOperation(cmd.Context(), client, res.Operation)
+			if err != nil {
+				log.Fatal("failed to wait on cluster activation, please check provider portal: ", err.Error())
+			}
 
+			if req.Provider == "aws" {
 				//add default node
 				nsr := &proto.NodeSpawnRequest{}
 				nsr.Provider = req.Provider
@@ -88,7 +88,10 @@ func createCluster() *cobra.Command {
 				nsr.NodeSpec = req.Node
 				nsr.ClusterName = req.ClusterName
 				log.Printf("cluster '%s' is active, adding node '%s'\n", name, req.Node.Name)
-				_, err := client.AddNode(cmd.Context(), nsr)
+				res, err := client.AddNode(cmd.Context(), nsr)
+				if err == nil {
+					_, err = waitForOperation(cmd.Context(), client, res.Operation)
+				}
 				if err != nil {
 					log.Fatalf("failed to attach node to cluster '%s', can retry 'nodepool add' %s\n", name, err.Error())
 					return
@@ -111,6 +114,8 @@ func clusteStatus() *cobra.Command {
 	provider := ""
 	region := ""
 	addr := ""
+	watch := false
+	follow := false
 
 	c := &cobra.Command{
 		Use:     "cluster-status",
@@ -130,17 +135,31 @@ func clusteStatus() *cobra.Command {
 				name = args[0]
 			}
 
-			req := &proto.ClusterStatusRequest{}
-			req.ClusterName = name
-			req.Provider = provider
-			req.Region = region
-
 			conn, err := getSpawnerConn(addr)
 			if err != nil {
 				log.Fatal("failed to connect to spawner ", addr)
 			}
 			defer conn.Close()
 			client := proto.NewSpawnerServiceClient(conn)
+
+			if watch || follow {
+				err := watchCluster(cmd.Context(), client, &proto.WatchClusterStatusRequest{
+					ClusterName: name,
+					Provider:    provider,
+					Region:      region,
+					Follow:      follow,
+				})
+				if err != nil {
+					log.Fatal("failed to watch status: ", err.Error())
+				}
+				return
+			}
+
+			req := &proto.ClusterStatusRequest{}
+			req.ClusterName = name
+			req.Provider = provider
+			req.Region = region
+
 			log.Printf("fetching cluster '%s' status\n", name)
 			resp, err := client.ClusterStatus(cmd.Context(), req)
 			if err != nil {
@@ -155,6 +174,8 @@ func clusteStatus() *cobra.Command {
 	c.Flags().StringVarP(&provider, "provider", "p", "", "cloud provider, one of ['aws', 'azure']")
 	c.Flags().StringVarP(&region, "region", "r", "", "cluster hosted region")
 	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().BoolVarP(&watch, "watch", "w", false, "watch the status changes till cluster is ready")
+	c.Flags().BoolVar(&follow, "follow", false, "keep watching the status changes till cluster is deleted")
 
 	c.MarkFlagRequired("region")
 	c.MarkFlagRequired("provider")
@@ -199,9 +220,12 @@ func deleteCluster() *cobra.Command {
 			defer conn.Close()
 			client := proto.NewSpawnerServiceClient(conn)
 			log.Printf("deleting cluster '%s'\n", name)
-			_, err = client.DeleteCluster(cmd.Context(), req)
+			res, err := client.DeleteCluster(cmd.Context(), req)
+			if err == nil {
+				_, err = waitForOperation(cmd.Context(), client, res.Operation)
+			}
 			if err != nil {
-				log.Fatal("failed to get status: ", err.Error())
+				log.Fatal("failed to delete cluster: ", err.Error())
 			}
 
 			log.Printf("cluster '%s' deleted\n", name)
@@ -256,7 +280,10 @@ func addNodePool() *cobra.Command {
 			defer conn.Close()
 			client := proto.NewSpawnerServiceClient(conn)
 			log.Printf("adding nodepool '%s' to cluster '%s'\n", req.NodeSpec.Name, name)
-			_, err = client.AddNode(cmd.Context(), req)
+			res, err := client.AddNode(cmd.Context(), req)
+			if err == nil {
+				_, err = waitForOperation(cmd.Context(), client, res.Operation)
+			}
 			if err != nil {
 				log.Fatal("failed to add new node pool: ", err.Error())
 			}
@@ -310,7 +337,10 @@ func deleteNodePool() *cobra.Command {
 			defer conn.Close()
 			client := proto.NewSpawnerServiceClient(conn)
 			log.Printf("deleting nodepool '%s' in cluster '%s'\n", req.NodeGroupName, name)
-			_, err = client.DeleteNode(cmd.Context(), req)
+			res, err := client.DeleteNode(cmd.Context(), req)
+			if err == nil {
+				_, err = waitForOperation(cmd.Context(), client, res.Operation)
+			}
 			if err != nil {
 				log.Fatal("failed to delete node pool: ", err.Error())
 			}
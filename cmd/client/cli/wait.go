@@ -2,45 +2,91 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"log"
 	"time"
 
+	"github.com/pkg/errors"
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc/status"
 )
 
-//waitUntilClusterReady Wait until the give cluster return "ACTIVE" status
+//operationPollInterval interval between operation status checks
+const operationPollInterval = 10 * time.Second
+
+//waitForOperation wait until the given operation is completed, returns the completed operation
 //
-// This will run for about 12min, with multiuple iteration of sleeps.
-// Starting with 6min, then 4min, 2min.
-// Cluster can become fully active in any one of sleep cycle, as soon as we see status is ACTIVE, we return it.
-// When sleep counter reaches 0, we return FAILED status, 15min is too long for cluster to become active
-func waitUntilClusterReady(ctx context.Context, c proto.SpawnerServiceClient, req *proto.ClusterRequest) string {
-	clusterStatus := &proto.ClusterStatusRequest{
-		Provider:    req.Provider,
-		Region:      req.Region,
-		ClusterName: req.ClusterName,
+// error is returned when the operation is failed or cancelled
+func waitForOperation(ctx context.Context, c proto.SpawnerServiceClient, op *proto.Operation) (*proto.Operation, error) {
+	if op == nil {
+		return nil, errors.New("spawner did not return an operation")
 	}
 
-	waitfor := 6 //minute
-
+	message := ""
 	for {
-		log.Println("wait for cluster to be active, duration ", waitfor, "min")
-		time.Sleep(time.Duration(waitfor) * time.Minute)
+		if op.Message != message {
+			message = op.Message
+			log.Printf("%s '%s': %s (%d%%)\n", op.Kind, op.Resource, message, op.Progress)
+		}
+
+		switch op.State {
+		case proto.OperationState_OPERATION_SUCCEEDED:
+			return op, nil
+		case proto.OperationState_OPERATION_FAILED:
+			if op.Status != nil {
+				return op, status.ErrorProto(op.Status)
+			}
+			return op, errors.New(op.Error)
+		case proto.OperationState_OPERATION_CANCELLED:
+			return op, fmt.Errorf("operation '%s' is cancelled", op.Id)
+		}
 
-		//check cluster status and if active return
-		stat, err := c.ClusterStatus(ctx, clusterStatus)
+		select {
+		case <-time.After(operationPollInterval):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		var err error
+		op, err = c.GetOperation(ctx, &proto.GetOperationRequest{Id: op.Id})
 		if err != nil {
-			log.Println("failed to fetch cluster stat")
+			return nil, errors.Wrap(err, "failed to fetch operation")
 		}
+	}
+}
 
-		if stat.Status == "ACTIVE" {
-			return stat.Status
+//watchCluster print cluster status changes till server closes the stream
+func watchCluster(ctx context.Context, c proto.SpawnerServiceClient, req *proto.WatchClusterStatusRequest) error {
+	stream, err := c.WatchClusterStatus(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("watching cluster '%s' status\n", req.ClusterName)
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if event.Deleted {
+			log.Printf("cluster '%s' is deleted\n", event.ClusterName)
+			continue
 		}
-		waitfor = waitfor - 2
 
-		if waitfor == 0 {
-			//already waited for 12+min
-			return "FAILED"
+		log.Printf("cluster '%s': %s\n", event.ClusterName, event.Status)
+		for _, ng := range event.NodeGroups {
+			log.Printf("  nodegroup '%s': %s, nodes %d\n", ng.Name, ng.Status, ng.Count)
+			for _, issue := range ng.Issues {
+				log.Printf("    %s: %s\n", issue.Code, issue.Description)
+			}
+		}
+		if event.Error != "" {
+			log.Println("  error: ", event.Error)
 		}
 	}
 }
@@ -7,25 +7,151 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/netbook-ai/interceptors"
 	"github.com/netbookai/log"
 	"github.com/netbookai/log/loggers"
 	"github.com/netbookai/log/loggers/zap"
 	"github.com/oklog/oklog/pkg/group"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"gitlab.com/netbook-devs/spawner-service/pkg/audit"
+	"gitlab.com/netbook-devs/spawner-service/pkg/auth"
 	"gitlab.com/netbook-devs/spawner-service/pkg/config"
+	"gitlab.com/netbook-devs/spawner-service/pkg/errmap"
+	"gitlab.com/netbook-devs/spawner-service/pkg/fleet"
 	"gitlab.com/netbook-devs/spawner-service/pkg/gateway"
+	"gitlab.com/netbook-devs/spawner-service/pkg/health"
+	"gitlab.com/netbook-devs/spawner-service/pkg/idempotency"
 	"gitlab.com/netbook-devs/spawner-service/pkg/metrics"
+	"gitlab.com/netbook-devs/spawner-service/pkg/ratelimit"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/registry"
+	"gitlab.com/netbook-devs/spawner-service/pkg/tracing"
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
-var skipMethods = []string{"ReadCredential", "WriteCredential", "GetContainerRegistryAuth", "GetToken", "GetKubeConfig"}
+//skipMethods methods carrying secrets, not logged by the logging interceptor. they are audited with secrets redacted
+var skipMethods = []string{"ReadCredential", "RevealCredential", "WriteCredential", "RotateCredential", "GetContainerRegistryAuth", "GetToken", "GetKubeConfig"}
 
-func startHttpServer(ctx context.Context, g *group.Group, config config.Config, logger log.Logger) {
+//auditedMethods mutating and credential access methods recorded in the audit log
+var auditedMethods = []string{
+	"CreateCluster", "DeleteCluster", "AddNode", "DeleteNode", "TagNodeInstance", "RegisterWithRancher", "RegisterClusterOIDC",
+	"CreateVolume", "DeleteVolume", "CreateSnapshot", "DeleteSnapshot", "CreateSnapshotAndDelete", "CopySnapshot",
+	"AddToken", "AddRoute53Record", "CreateRoute53Records", "DeleteRoute53Records", "CreateContainerRegistryRepo", "CancelOperation",
+	"WriteCredential", "ReadCredential", "RevealCredential", "DeleteCredential", "RotateCredential", "VerifyCredential", "GetContainerRegistryAuth", "GetToken", "GetKubeConfig", "PresignS3Url",
+}
+
+//idempotentMethods methods accepting idempotency key
+var idempotentMethods = []string{"CreateCluster", "AddNode", "CreateVolume", "CreateSnapshot", "CopySnapshot", "CreateContainerRegistryRepo"}
+
+const defaultIdempotencyKeyTTL = 24 * time.Hour
+
+const defaultAuditFileMaxSize = 100
+
+const (
+	defaultHealthCheckInterval = 30 * time.Second
+	healthCheckTimeout         = 10 * time.Second
+)
+
+//newLimiter creates the rate and in-flight limiter, calls are not limited till the limits are configured
+func newLimiter(config config.Config) (*ratelimit.Limiter, error) {
+	rates, inFlight, err := parseLimits(config)
+	if err != nil {
+		return nil, err
+	}
+	return ratelimit.New(rates, inFlight), nil
+}
+
+func parseLimits(config config.Config) (map[string]ratelimit.RateLimit, map[string]int, error) {
+	rates, err := ratelimit.ParseRateLimits(config.RateLimits)
+	if err != nil {
+		return nil, nil, err
+	}
+	inFlight, err := ratelimit.ParseInFlightLimits(config.InFlightLimits)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rates, inFlight, nil
+}
+
+func idempotencyKeyTTL(config config.Config) time.Duration {
+	if config.IdempotencyKeyTTL > 0 {
+		return time.Duration(config.IdempotencyKeyTTL) * time.Hour
+	}
+	return defaultIdempotencyKeyTTL
+}
+
+//providerRegions regions of the registered providers, the label values of the call metrics
+func providerRegions() map[string][]string {
+	regions := map[string][]string{}
+	for _, p := range registry.Providers() {
+		regions[p.Name] = p.Regions
+	}
+	return regions
+}
+
+//logLevel LOG_LEVEL, debug for local and info for the other envs when not set
+func logLevel(c config.Config) loggers.Level {
+	if level, err := loggers.ParseLevel(c.LogLevel); err == nil {
+		return level
+	}
+	if c.Env == config.EnvLocal {
+		return loggers.DebugLevel
+	}
+	return loggers.InfoLevel
+}
+
+//reloadLimits applies the limits and the idempotency key ttl of the reloaded config
+func reloadLimits(logger log.Logger, limiter *ratelimit.Limiter, store *idempotency.Store) {
+	config.OnReload(func(c config.Config) {
+		rates, inFlight, err := parseLimits(c)
+		if err != nil {
+			//validated by config.Reload, not expected
+			logger.Error(context.Background(), "reloadLimits: invalid limits, keeping the current ones", "error", err)
+			return
+		}
+		limiter.SetLimits(rates, inFlight)
+		store.SetTTL(idempotencyKeyTTL(c))
+	})
+}
+
+//newAuditor creates auditor writing to the configured sinks, returns nil when audit log is disabled
+func newAuditor(config config.Config, logger log.Logger) (*audit.Auditor, error) {
+	var sinks []audit.Sink
+	for _, name := range strings.Split(config.AuditSinks, ",") {
+		switch strings.TrimSpace(name) {
+		case "":
+		case "stdout":
+			sinks = append(sinks, audit.NewWriterSink(os.Stdout))
+		case "file":
+			maxSize := defaultAuditFileMaxSize
+			if config.AuditFileMaxSize > 0 {
+				maxSize = config.AuditFileMaxSize
+			}
+			sink, err := audit.NewFileSink(config.AuditFilePath, int64(maxSize)<<20, config.AuditFileMaxBackups)
+			if err != nil {
+				return nil, err
+			}
+			sinks = append(sinks, sink)
+		default:
+			return nil, fmt.Errorf("unknown audit sink '%s', must be one of ['stdout', 'file']", name)
+		}
+	}
+
+	if len(sinks) == 0 {
+		return nil, nil
+	}
+	return audit.New(logger, auditedMethods, sinks...), nil
+}
+
+func startHttpServer(ctx context.Context, g *group.Group, config config.Config, logger log.Logger, checker *health.Checker) {
 
 	address := fmt.Sprintf("%s:%d", "", config.DebugPort)
 
@@ -39,6 +165,15 @@ func startHttpServer(ctx context.Context, g *group.Group, config config.Config,
 	router := http.NewServeMux()
 
 	router.Handle("/metrics", promhttp.Handler())
+	router.Handle("/healthz", health.Healthz())
+	router.Handle("/readyz", checker.Readyz())
+
+	rest, err := gateway.NewRESTHandler(ctx, fmt.Sprintf("localhost:%d", config.Port))
+	if err != nil {
+		logger.Error(ctx, "startHttpServer: failed to setup rest gateway", "error", err)
+		os.Exit(1)
+	}
+	router.Handle("/v1/", rest)
 
 	g.Add(func() error {
 		logger.Info(ctx, "startHttpServer", "transport", "debug/HTTP", "address", address)
@@ -49,10 +184,12 @@ func startHttpServer(ctx context.Context, g *group.Group, config config.Config,
 	})
 }
 
-func startGRPCServer(ctx context.Context, g *group.Group, config config.Config, logger log.Logger) {
+func startGRPCServer(ctx context.Context, g *group.Group, config config.Config, logger log.Logger, checker *health.Checker) {
 
 	address := fmt.Sprintf("%s:%d", "", config.Port)
 	service := service.New(logger)
+	service.RegisterHealthChecks(checker)
+	startFleetCollector(g, config, logger, service)
 	grpcServer := gateway.New(service)
 	listener, err := net.Listen("tcp", address)
 	if err != nil {
@@ -60,25 +197,181 @@ func startGRPCServer(ctx context.Context, g *group.Group, config config.Config,
 		os.Exit(1)
 	}
 
-	interceptors := interceptors.NewInterceptor("spawnerservice",
-		logger,
-		interceptors.WithInterecptor(metrics.RPCInstrumentation()),
+	auditor, err := newAuditor(config, logger)
+	if err != nil {
+		logger.Error(ctx, "startGRPCServer: failed to setup audit log", "error", err)
+		os.Exit(1)
+	}
+
+	var authenticator *auth.Authenticator
+	if config.AuthPolicyFile != "" {
+		authenticator, err = auth.NewFromFile(config.AuthPolicyFile)
+		if err != nil {
+			logger.Error(ctx, "startGRPCServer: failed to load auth policy", "file", config.AuthPolicyFile, "error", err)
+			os.Exit(1)
+		}
+	}
+
+	limiter, err := newLimiter(config)
+	if err != nil {
+		logger.Error(ctx, "startGRPCServer: invalid limits", "error", err)
+		os.Exit(1)
+	}
+
+	//tracing, metrics and audit run ahead of auth and limits to observe the rejected calls
+	metrics.SetRegions(providerRegions())
+	options := []interceptors.InterceptorOption{
+		interceptors.WithInterecptor(tracing.Interceptor()),
+		interceptors.WithInterecptor(metrics.RPCInstrumentation(errmap.ToStatus)),
+	}
+	streamInterceptors := []grpc.StreamServerInterceptor{tracing.StreamInterceptor(), metrics.StreamInstrumentation(errmap.ToStatus), errmap.StreamInterceptor()}
+
+	if auditor != nil {
+		if authenticator != nil {
+			auditor.WithPrincipal(authenticator.Authenticate)
+		}
+		options = append(options, interceptors.WithInterecptor(auditor.Interceptor()))
+	} else {
+		logger.Warn(ctx, "startGRPCServer: AUDIT_SINKS is not set, audit log and RevealCredential are disabled")
+	}
+
+	if authenticator != nil {
+		options = append(options, interceptors.WithInterecptor(authenticator.Interceptor()))
+		streamInterceptors = append(streamInterceptors, authenticator.StreamInterceptor())
+	} else {
+		logger.Warn(ctx, "startGRPCServer: AUTH_POLICY_FILE is not set, grpc api is not authenticated and RevealCredential is disabled")
+	}
+
+	options = append(options, interceptors.WithInterecptor(limiter.Interceptor()))
+	streamInterceptors = append(streamInterceptors, limiter.StreamInterceptor())
+
+	store := idempotency.NewStore(idempotencyKeyTTL(config))
+	reloadLimits(logger, limiter, store)
+
+	options = append(options,
+		interceptors.WithInterecptor(errmap.Interceptor()),
+		interceptors.WithInterecptor(idempotency.Interceptor(store, idempotentMethods)),
 		interceptors.WithSkipMethod(skipMethods))
 
+	interceptors := interceptors.NewInterceptor("spawnerservice", logger, options...)
+
 	g.Add(func() error {
 		logger.Info(ctx, "startGRPCServer", "transport", "gRPC", "address", address)
 
-		baseServer := grpc.NewServer(interceptors.Get())
+		baseServer := grpc.NewServer(interceptors.Get(), grpc.ChainStreamInterceptor(streamInterceptors...))
 
 		proto.RegisterSpawnerServiceServer(baseServer, grpcServer)
+		healthpb.RegisterHealthServer(baseServer, checker.Server())
+		reflection.Register(baseServer)
 		return baseServer.Serve(listener)
 	}, func(error) {
 		logger.Error(ctx, "startGRPCServer", "error", err)
+		checker.Shutdown()
 		listener.Close()
+		if auditor != nil {
+			auditor.Close()
+		}
 	})
 
 }
 
+//startFleetCollector exports the fleet gauges, listing the spawner resources every interval till the group exits
+func startFleetCollector(g *group.Group, config config.Config, logger log.Logger, source fleet.Source) {
+	if config.FleetCollectionInterval <= 0 {
+		return
+	}
+	collector := fleet.New(logger, time.Duration(config.FleetCollectionInterval)*time.Minute, source)
+	prometheus.MustRegister(collector)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	g.Add(func() error {
+		collector.Run(ctx)
+		return nil
+	}, func(error) {
+		cancel()
+	})
+}
+
+//startHealthChecks runs the readiness checks in background till the group exits
+func startHealthChecks(g *group.Group, checker *health.Checker) {
+	ctx, cancel := context.WithCancel(context.Background())
+	g.Add(func() error {
+		checker.Run(ctx)
+		return nil
+	}, func(error) {
+		cancel()
+	})
+}
+
+//startTracing sets up the tracer provider, spans are flushed when the group exits
+func startTracing(ctx context.Context, g *group.Group, config config.Config, logger log.Logger) {
+	shutdown, err := tracing.Init(ctx, tracing.Config{
+		ServiceName: "spawnerservice",
+		Exporter:    config.TraceExporter,
+		Endpoint:    config.TraceEndpoint,
+		Insecure:    config.TraceInsecure,
+		SampleRatio: config.TraceSampleRatio,
+	})
+	if err != nil {
+		logger.Error(ctx, "startTracing: failed to setup tracing", "error", err)
+		os.Exit(1)
+	}
+
+	done := make(chan struct{})
+	g.Add(func() error {
+		<-done
+		return nil
+	}, func(error) {
+		close(done)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdown(ctx); err != nil {
+			logger.Error(ctx, "startTracing: failed to flush spans", "error", err)
+		}
+	})
+}
+
+//reloadConfig applies the reloadable settings of the config files, invalid config is rejected and the current one kept
+func reloadConfig(logger log.Logger, trigger string) {
+	ctx := context.Background()
+	applied, restart, err := config.Reload()
+	if err != nil {
+		logger.Error(ctx, "reloadConfig: config is not reloaded", "trigger", trigger, "error", err)
+		return
+	}
+	if len(restart) > 0 {
+		logger.Warn(ctx, "reloadConfig: changes take effect after restart", "trigger", trigger, "keys", restart)
+	}
+	logger.Info(ctx, "reloadConfig: config reloaded", "trigger", trigger, "applied", applied)
+}
+
+//startConfigReloader reloads the config on SIGHUP and when the config files change
+func startConfigReloader(g *group.Group, logger log.Logger) {
+	ctx, cancel := context.WithCancel(context.Background())
+	g.Add(func() error {
+		go func() {
+			err := config.Watch(ctx, func() { reloadConfig(logger, "file") })
+			if err != nil {
+				logger.Warn(ctx, "startConfigReloader: config files are not watched, reload with SIGHUP", "error", err)
+			}
+		}()
+
+		c := make(chan os.Signal, 1)
+		signal.Notify(c, syscall.SIGHUP)
+		defer signal.Stop(c)
+		for {
+			select {
+			case <-c:
+				reloadConfig(logger, "SIGHUP")
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	}, func(error) {
+		cancel()
+	})
+}
+
 func startSignalHandler(g *group.Group) {
 
 	cancelInterrupt := make(chan struct{})
@@ -102,13 +395,14 @@ func main() {
 	err := config.Load(".")
 	logger := log.NewLogger(zap.NewLogger())
 
-	if config.Get().Env == "local" {
-		logger.SetLevel(loggers.DebugLevel)
-	}
+	logger.SetLevel(logLevel(config.Get()))
 	if err != nil {
 		logger.Error(ctx, "failed to load config", "error", err)
 		return
 	}
+	config.OnReload(func(c config.Config) {
+		logger.SetLevel(logLevel(c))
+	})
 
 	//ENV value can be either prod or dev
 	config := config.Get()
@@ -118,8 +412,17 @@ func main() {
 	}
 	var g group.Group
 
-	startHttpServer(ctx, &g, config, logger)
-	startGRPCServer(ctx, &g, config, logger)
+	startTracing(ctx, &g, config, logger)
+	healthCheckInterval := defaultHealthCheckInterval
+	if config.HealthCheckInterval > 0 {
+		healthCheckInterval = time.Duration(config.HealthCheckInterval) * time.Second
+	}
+	checker := health.New(logger, healthCheckInterval, healthCheckTimeout, proto.SpawnerService_ServiceDesc.ServiceName)
+
+	startHttpServer(ctx, &g, config, logger, checker)
+	startGRPCServer(ctx, &g, config, logger, checker)
+	startHealthChecks(&g, checker)
+	startConfigReloader(&g, logger)
 	startSignalHandler(&g)
 
 	logger.Info(ctx, "main", "exit", g.Run())
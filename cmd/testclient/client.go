@@ -484,6 +484,18 @@ func main() {
 		}
 		sugar.Infow("ReadCredential", "response", v)
 
+	case "RevealCredentialAws":
+		v, err := client.RevealCredential(context.Background(), &proto.RevealCredentialRequest{
+			Account: "alexis",
+			Type:    "aws",
+			Reason:  "testing",
+		})
+		if err != nil {
+			sugar.Errorw("error revealing credentials", "error", err)
+			return
+		}
+		sugar.Infow("RevealCredential", "response", v)
+
 	case "WriteCredentialAws":
 		v, err := client.WriteCredential(context.Background(), &proto.WriteCredentialRequest{
 			Account: "alexis",
@@ -501,6 +513,33 @@ func main() {
 			return
 		}
 		sugar.Infow("WriteCredentialAws", "response", v)
+	case "ReadCredentialAwsRole":
+		v, err := client.ReadCredential(context.Background(), &proto.ReadCredentialRequest{
+			Account: "alexis",
+			Type:    "aws-role",
+		})
+		if err != nil {
+			sugar.Errorw("error reading credentials", "error", err)
+			return
+		}
+		sugar.Infow("ReadCredential", "response", v)
+
+	case "WriteCredentialAwsRole":
+		v, err := client.WriteCredential(context.Background(), &proto.WriteCredentialRequest{
+			Account: "alexis",
+			Type:    "aws-role",
+			Cred: &proto.WriteCredentialRequest_AwsRoleCred{
+				AwsRoleCred: &proto.AwsRoleCredentials{
+					RoleArn:    "arn:aws:iam::123456789012:role/spawner",
+					ExternalID: "external_id",
+				},
+			},
+		})
+		if err != nil {
+			sugar.Errorw("error writing credentials", "error", err)
+			return
+		}
+		sugar.Infow("WriteCredentialAwsRole", "response", v)
 	case "ReadCredentialAzure":
 		v, err := client.ReadCredential(context.Background(), &proto.ReadCredentialRequest{
 			Account: "netbook-azure-dev",
@@ -531,6 +570,41 @@ func main() {
 			return
 		}
 		sugar.Infow("WriteCredentialAws", "response", v)
+	case "WriteCredentialAzureFederated":
+		v, err := client.WriteCredential(context.Background(), &proto.WriteCredentialRequest{
+			Account: "alex",
+			Type:    "azure-federated",
+			Cred: &proto.WriteCredentialRequest_AzureFederatedCred{
+				AzureFederatedCred: &proto.AzureFederatedCredentials{
+					SubscriptionID: "subscription",
+					TenantID:       "tenant_id",
+					ClientID:       "client_id",
+					ResourceGroup:  "resource_group",
+				},
+			},
+		})
+		if err != nil {
+			sugar.Errorw("error writing credentials", "error", err)
+			return
+		}
+		sugar.Infow("WriteCredentialAzureFederated", "response", v)
+	case "WriteCredentialGcpFederated":
+		v, err := client.WriteCredential(context.Background(), &proto.WriteCredentialRequest{
+			Account: "alex",
+			Type:    "gcp-federated",
+			Cred: &proto.WriteCredentialRequest_GcpFederatedCred{
+				GcpFederatedCred: &proto.GcpFederatedCredentials{
+					ProjectID:      "project_id",
+					Audience:       "//iam.googleapis.com/projects/123456789012/locations/global/workloadIdentityPools/spawner/providers/eks",
+					ServiceAccount: "spawner@project_id.iam.gserviceaccount.com",
+				},
+			},
+		})
+		if err != nil {
+			sugar.Errorw("error writing credentials", "error", err)
+			return
+		}
+		sugar.Infow("WriteCredentialGcpFederated", "response", v)
 
 	case "ReadCredentialGitPAT":
 		v, err := client.ReadCredential(context.Background(), &proto.ReadCredentialRequest{
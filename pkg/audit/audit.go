@@ -0,0 +1,245 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/netbookai/log"
+	"gitlab.com/netbook-devs/spawner-service/pkg/auth"
+	"gitlab.com/netbook-devs/spawner-service/pkg/errmap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/encoding/protojson"
+	gproto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+//Redacted value replacing the secret fields
+const Redacted = "REDACTED"
+
+//secretFields credential fields which must never be recorded
+var secretFields = map[protoreflect.FullName]bool{
+	"spawner.AwsCredentials.secretAccessKey":  true,
+	"spawner.AwsCredentials.token":            true,
+	"spawner.AwsRoleCredentials.externalID":   true,
+	"spawner.AzureCredentials.clientSecret":   true,
+	"spawner.GithubPersonalAccessToken.token": true,
+	"spawner.GcpCredentials.certificate":      true,
+}
+
+//resourceFields request fields naming the resource, joined in the order to form the resource path
+var resourceFields = []protoreflect.Name{"clusterName", "nodeGroupName", "volumeid", "snapshotid", "snapshotId", "recordName", "name", "id"}
+
+//Record single audited call
+type Record struct {
+	Time      time.Time       `json:"time"`
+	Principal string          `json:"principal,omitempty"`
+	Peer      string          `json:"peer,omitempty"`
+	Method    string          `json:"method"`
+	Provider  string          `json:"provider,omitempty"`
+	Account   string          `json:"account,omitempty"`
+	Region    string          `json:"region,omitempty"`
+	Resource  string          `json:"resource,omitempty"`
+	Reason    string          `json:"reason,omitempty"`
+	Request   json.RawMessage `json:"request,omitempty"`
+	Code      string          `json:"code"`
+	Error     string          `json:"error,omitempty"`
+	Duration  float64         `json:"durationMs"`
+}
+
+//Sink destination of the audit records
+type Sink interface {
+	Write(r *Record) error
+	Close() error
+}
+
+//redact clears the secret fields in place
+func redact(m protoreflect.Message) {
+	var secrets []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case secretFields[fd.FullName()] && fd.Kind() == protoreflect.StringKind && !fd.IsList():
+			secrets = append(secrets, fd)
+		case fd.Kind() == protoreflect.MessageKind && fd.IsList():
+			l := v.List()
+			for i := 0; i < l.Len(); i++ {
+				redact(l.Get(i).Message())
+			}
+		case fd.Kind() == protoreflect.MessageKind && fd.IsMap():
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				if fd.MapValue().Kind() == protoreflect.MessageKind {
+					redact(mv.Message())
+				}
+				return true
+			})
+		case fd.Kind() == protoreflect.MessageKind:
+			redact(v.Message())
+		}
+		return true
+	})
+
+	//message must not be mutated while ranging over it
+	for _, fd := range secrets {
+		m.Set(fd, protoreflect.ValueOfString(Redacted))
+	}
+}
+
+//Redact returns copy of the message with the credential secrets replaced by Redacted
+func Redact(msg gproto.Message) gproto.Message {
+	msg = gproto.Clone(msg)
+	redact(msg.ProtoReflect())
+	return msg
+}
+
+func stringField(m protoreflect.Message, names ...protoreflect.Name) string {
+	for _, name := range names {
+		if fd := m.Descriptor().Fields().ByName(name); fd != nil && fd.Kind() == protoreflect.StringKind {
+			return m.Get(fd).String()
+		}
+	}
+	return ""
+}
+
+//resource path of the resource the request acts on, such as 'cluster/nodegroup'
+func resource(m protoreflect.Message) string {
+	var parts []string
+	for _, name := range resourceFields {
+		if v := stringField(m, name); v != "" {
+			parts = append(parts, v)
+		}
+	}
+	if fd := m.Descriptor().Fields().ByName("nodeSpec"); fd != nil && fd.Kind() == protoreflect.MessageKind && m.Has(fd) {
+		if v := stringField(m.Get(fd).Message(), "name"); v != "" {
+			parts = append(parts, v)
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+//PrincipalFunc resolves the caller of the request
+type PrincipalFunc func(ctx context.Context) (string, error)
+
+//Auditor records the calls of the audited methods to the sinks
+type Auditor struct {
+	logger    log.Logger
+	methods   map[string]bool
+	sinks     []Sink
+	principal PrincipalFunc
+	now       func() time.Time
+}
+
+//New creates auditor recording the given methods
+func New(logger log.Logger, methods []string, sinks ...Sink) *Auditor {
+	enabled := map[string]bool{}
+	for _, m := range methods {
+		enabled[m] = true
+	}
+	return &Auditor{
+		logger:  logger,
+		methods: enabled,
+		sinks:   sinks,
+		now:     time.Now,
+	}
+}
+
+//WithPrincipal sets the resolver of the caller. Auditor runs ahead of authentication so that rejected calls are
+//recorded too, the principal is resolved by the auditor itself.
+func (a *Auditor) WithPrincipal(fn PrincipalFunc) *Auditor {
+	a.principal = fn
+	return a
+}
+
+//Record writes the record to all sinks, failures are logged and do not fail the call
+func (a *Auditor) Record(ctx context.Context, r *Record) {
+	for _, s := range a.sinks {
+		if err := s.Write(r); err != nil {
+			a.logger.Error(ctx, "failed to write audit record", "method", r.Method, "error", err)
+		}
+	}
+}
+
+//Close closes all sinks
+func (a *Auditor) Close() error {
+	var first error
+	for _, s := range a.sinks {
+		if err := s.Close(); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+func getMethod(fullMethod string) string {
+	splits := strings.Split(fullMethod, "/")
+	return splits[len(splits)-1]
+}
+
+func (a *Auditor) newRecord(ctx context.Context, method string, req interface{}) *Record {
+	r := &Record{
+		Time:   a.now().UTC(),
+		Method: method,
+	}
+	if p, ok := auth.FromContext(ctx); ok {
+		r.Principal = p
+	} else if a.principal != nil {
+		r.Principal, _ = a.principal(ctx)
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		r.Peer = p.Addr.String()
+	}
+
+	msg, ok := req.(gproto.Message)
+	if !ok {
+		return r
+	}
+	m := msg.ProtoReflect()
+	r.Provider = stringField(m, "provider")
+	r.Account = stringField(m, "accountName", "account")
+	r.Region = stringField(m, "region")
+	r.Resource = resource(m)
+	r.Reason = stringField(m, "reason")
+
+	b, err := protojson.Marshal(Redact(msg))
+	if err != nil {
+		a.logger.Error(ctx, "failed to marshal audit request", "method", method, "error", err)
+		return r
+	}
+	r.Request = b
+	return r
+}
+
+type auditedKey struct{}
+
+//Audited reports whether the call is recorded to at least one audit sink
+func Audited(ctx context.Context) bool {
+	audited, _ := ctx.Value(auditedKey{}).(bool)
+	return audited
+}
+
+//Interceptor records the outcome of the audited unary methods
+func (a *Auditor) Interceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method := getMethod(info.FullMethod)
+		if !a.methods[method] {
+			return handler(ctx, req)
+		}
+		if len(a.sinks) > 0 {
+			ctx = context.WithValue(ctx, auditedKey{}, true)
+		}
+
+		r := a.newRecord(ctx, method, req)
+		start := a.now()
+		res, err := handler(ctx, req)
+		r.Duration = float64(a.now().Sub(start).Microseconds()) / 1000
+
+		st := errmap.ToStatus(err)
+		r.Code = st.Code().String()
+		if err != nil {
+			r.Error = st.Message()
+		}
+		a.Record(ctx, r)
+		return res, err
+	}
+}
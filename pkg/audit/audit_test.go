@@ -0,0 +1,88 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/netbookai/log"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/fake"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc"
+)
+
+func Test_Redact(t *testing.T) {
+	req := &proto.WriteCredentialRequest{
+		Account: "team-a",
+		Type:    "aws",
+		Cred: &proto.WriteCredentialRequest_AwsCred{AwsCred: &proto.AwsCredentials{
+			AccessKeyID:     "AKIA",
+			SecretAccessKey: "secret",
+			Token:           "session",
+		}},
+	}
+	redacted := Redact(req).(*proto.WriteCredentialRequest)
+	assert.Equal(t, "AKIA", redacted.GetAwsCred().AccessKeyID)
+	assert.Equal(t, Redacted, redacted.GetAwsCred().SecretAccessKey)
+	assert.Equal(t, Redacted, redacted.GetAwsCred().Token)
+	assert.Equal(t, "secret", req.GetAwsCred().SecretAccessKey, "original request must not be modified")
+
+	res := &proto.ReadCredentialResponse{
+		Account: "team-a",
+		Cred:    &proto.ReadCredentialResponse_AzureCred{AzureCred: &proto.AzureCredentials{ClientID: "client", ClientSecret: "secret"}},
+	}
+	assert.Equal(t, Redacted, Redact(res).(*proto.ReadCredentialResponse).GetAzureCred().ClientSecret)
+}
+
+func Test_Interceptor(t *testing.T) {
+	var out bytes.Buffer
+	a := New(log.GetLogger(), []string{"DeleteCluster", "WriteCredential"}, NewWriterSink(&out)).
+		WithPrincipal(func(ctx context.Context) (string, error) { return "ci", nil })
+	in := a.Interceptor()
+
+	failing := func(ctx context.Context, req interface{}) (interface{}, error) {
+		//provider error, translated to status by the interceptors after audit
+		return nil, fake.ErrClusterNotFound
+	}
+	req := &proto.ClusterDeleteRequest{Provider: "aws", Region: "us-west-2", AccountName: "team-a", ClusterName: "c1"}
+	_, _ = in(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "/spawner.SpawnerService/DeleteCluster"}, failing)
+	_, _ = in(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "/spawner.SpawnerService/GetCluster"}, failing)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Equal(t, 1, len(lines), "only audited methods must be recorded")
+
+	r := Record{}
+	assert.Nil(t, json.Unmarshal([]byte(lines[0]), &r))
+	assert.Equal(t, "ci", r.Principal)
+	assert.Equal(t, "DeleteCluster", r.Method)
+	assert.Equal(t, "team-a", r.Account)
+	assert.Equal(t, "us-west-2", r.Region)
+	assert.Equal(t, "c1", r.Resource)
+	assert.Equal(t, "NotFound", r.Code)
+	assert.Equal(t, fake.ErrClusterNotFound.Error(), r.Error)
+}
+
+func Test_FileSinkRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	s, err := NewFileSink(path, 200, 2)
+	assert.Nil(t, err)
+
+	for i := 0; i < 10; i++ {
+		assert.Nil(t, s.Write(&Record{Method: "DeleteCluster", Resource: strings.Repeat("c", 50)}))
+	}
+	assert.Nil(t, s.Close())
+
+	for _, f := range []string{path, path + ".1", path + ".2"} {
+		b, err := ioutil.ReadFile(f)
+		assert.Nil(t, err)
+		assert.LessOrEqual(t, len(b), 200)
+	}
+	_, err = os.Stat(path + ".3")
+	assert.True(t, os.IsNotExist(err), "backups beyond max must be removed")
+}
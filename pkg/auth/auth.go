@@ -0,0 +1,319 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	jwt "github.com/form3tech-oss/jwt-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	gproto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	//MetadataKey grpc metadata carrying the bearer token
+	MetadataKey = "authorization"
+
+	bearerPrefix = "bearer "
+	defaultClaim = "sub"
+)
+
+//accountFields request fields carrying the account, credential requests name it 'account'
+var accountFields = []protoreflect.Name{"accountName", "account"}
+
+const providerField protoreflect.Name = "provider"
+
+type principalKey struct{}
+
+//NewContext returns context carrying the authenticated principal
+func NewContext(ctx context.Context, principal string) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+//FromContext returns the principal authenticated for the call
+func FromContext(ctx context.Context) (string, bool) {
+	p, ok := ctx.Value(principalKey{}).(string)
+	return p, ok
+}
+
+type authenticatorKey struct{}
+
+//newContext returns context carrying the principal and the authenticator, for the checks made by the handler
+func (a *Authenticator) newContext(ctx context.Context, principal string) context.Context {
+	return context.WithValue(NewContext(ctx, principal), authenticatorKey{}, a)
+}
+
+//Enabled reports whether the call was authenticated, false when the authentication is not enabled
+func Enabled(ctx context.Context) bool {
+	_, ok := ctx.Value(authenticatorKey{}).(*Authenticator)
+	return ok
+}
+
+//AuthorizeResource checks the principal of the call is allowed to call the method on the resource owned by the
+//provider and account. Methods taking the resource id alone call it once the resource is resolved, calls are
+//allowed when authentication is not enabled.
+func AuthorizeResource(ctx context.Context, method, provider, account string) error {
+	a, ok := ctx.Value(authenticatorKey{}).(*Authenticator)
+	if !ok {
+		return nil
+	}
+	principal, _ := FromContext(ctx)
+	r := Request{Method: method, Account: &account}
+	if provider != "" {
+		r.Provider = &provider
+	}
+	return a.authorize(principal, r)
+}
+
+//Authenticator resolves the bearer tokens to principals and authorizes their calls against the policy
+type Authenticator struct {
+	policy *Policy
+	tokens map[[sha256.Size]byte]string
+	keys   map[string]interface{}
+}
+
+//New creates authenticator for the policy, loading the jwt keys referred in it
+func New(policy *Policy) (*Authenticator, error) {
+	a := &Authenticator{
+		policy: policy,
+		tokens: map[[sha256.Size]byte]string{},
+		keys:   map[string]interface{}{},
+	}
+
+	for _, t := range policy.Tokens {
+		var sum [sha256.Size]byte
+		b, err := hex.DecodeString(t.Sha256)
+		if err != nil || len(b) != sha256.Size {
+			return nil, fmt.Errorf("token of '%s' must be a hex encoded sha256", t.Principal)
+		}
+		copy(sum[:], b)
+		a.tokens[sum] = t.Principal
+	}
+
+	if j := policy.JWT; j != nil {
+		if j.SecretFile != "" {
+			secret, err := ioutil.ReadFile(j.SecretFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read jwt secret: %w", err)
+			}
+			a.keys["HS"] = []byte(strings.TrimSpace(string(secret)))
+		}
+		if j.PublicKeyFile != "" {
+			pem, err := ioutil.ReadFile(j.PublicKeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read jwt public key: %w", err)
+			}
+			if key, err := jwt.ParseRSAPublicKeyFromPEM(pem); err == nil {
+				a.keys["RS"] = key
+			} else if key, err := jwt.ParseECPublicKeyFromPEM(pem); err == nil {
+				a.keys["ES"] = key
+			} else {
+				return nil, fmt.Errorf("jwt public key must be a PEM encoded RSA or ECDSA key")
+			}
+		}
+	}
+	return a, nil
+}
+
+//NewFromFile creates authenticator from the policy file
+func NewFromFile(file string) (*Authenticator, error) {
+	p, err := LoadPolicy(file)
+	if err != nil {
+		return nil, err
+	}
+	return New(p)
+}
+
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	v := md.Get(MetadataKey)
+	if len(v) == 0 {
+		return ""
+	}
+	if len(v[0]) > len(bearerPrefix) && strings.EqualFold(v[0][:len(bearerPrefix)], bearerPrefix) {
+		return strings.TrimSpace(v[0][len(bearerPrefix):])
+	}
+	return ""
+}
+
+//verifyJWT validates the token signature and claims, returns the principal claim
+func (a *Authenticator) verifyJWT(token string) (string, error) {
+	j := a.policy.JWT
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		alg := t.Method.Alg()
+		if len(alg) < 2 {
+			return nil, fmt.Errorf("unexpected signing method '%s'", alg)
+		}
+		key, ok := a.keys[alg[:2]]
+		if !ok {
+			return nil, fmt.Errorf("unexpected signing method '%s'", alg)
+		}
+		return key, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	//exp is optional for jwt-go, tokens without it would never expire
+	now := time.Now().Unix()
+	if !claims.VerifyExpiresAt(now, true) {
+		return "", fmt.Errorf("token has no 'exp' claim or is expired")
+	}
+	if !claims.VerifyNotBefore(now, false) {
+		return "", fmt.Errorf("token is not valid yet")
+	}
+
+	if j.Issuer != "" && !claims.VerifyIssuer(j.Issuer, true) {
+		return "", fmt.Errorf("unexpected issuer")
+	}
+	if j.Audience != "" && !claims.VerifyAudience(j.Audience, true) {
+		return "", fmt.Errorf("unexpected audience")
+	}
+
+	claim := j.Claim
+	if claim == "" {
+		claim = defaultClaim
+	}
+	principal, _ := claims[claim].(string)
+	if principal == "" {
+		return "", fmt.Errorf("token has no '%s' claim", claim)
+	}
+	return principal, nil
+}
+
+//Authenticate resolves the bearer token of the call to the principal
+func (a *Authenticator) Authenticate(ctx context.Context) (string, error) {
+	token := bearerToken(ctx)
+	if token == "" {
+		return "", status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+
+	sum := sha256.Sum256([]byte(token))
+	for known, principal := range a.tokens {
+		if subtle.ConstantTimeCompare(known[:], sum[:]) == 1 {
+			return principal, nil
+		}
+	}
+
+	if a.policy.JWT != nil && strings.Count(token, ".") == 2 {
+		principal, err := a.verifyJWT(token)
+		if err != nil {
+			return "", status.Errorf(codes.Unauthenticated, "invalid token: %s", err)
+		}
+		return principal, nil
+	}
+	return "", status.Error(codes.Unauthenticated, "invalid token")
+}
+
+func stringField(m protoreflect.Message, names ...protoreflect.Name) *string {
+	for _, name := range names {
+		if fd := m.Descriptor().Fields().ByName(name); fd != nil && fd.Kind() == protoreflect.StringKind {
+			v := m.Get(fd).String()
+			return &v
+		}
+	}
+	return nil
+}
+
+//Authorize checks the policy allows principal to call the method with the request
+func (a *Authenticator) Authorize(principal, method string, req interface{}) error {
+	r := Request{Method: method}
+	if msg, ok := req.(gproto.Message); ok {
+		m := msg.ProtoReflect()
+		r.Provider = stringField(m, providerField)
+		r.Account = stringField(m, accountFields...)
+	}
+	return a.authorize(principal, r)
+}
+
+func (a *Authenticator) authorize(principal string, r Request) error {
+	if !a.policy.Allowed(principal, r) {
+		return status.Errorf(codes.PermissionDenied, "'%s' is not allowed to call %s", principal, r.Method)
+	}
+	return nil
+}
+
+func getMethod(fullMethod string) string {
+	splits := strings.Split(fullMethod, "/")
+	return splits[len(splits)-1]
+}
+
+//Interceptor authenticates and authorizes the unary calls
+func (a *Authenticator) Interceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if a.policy.public(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		principal, err := a.Authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := a.Authorize(principal, getMethod(info.FullMethod), req); err != nil {
+			return nil, err
+		}
+		return handler(a.newContext(ctx, principal), req)
+	}
+}
+
+//authorizedStream authorizes the first message received on the stream
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx        context.Context
+	auth       *Authenticator
+	principal  string
+	method     string
+	authorized bool
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authorizedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.authorized {
+		return nil
+	}
+	if err := s.auth.Authorize(s.principal, s.method, m); err != nil {
+		return err
+	}
+	s.authorized = true
+	return nil
+}
+
+//StreamInterceptor authenticates the streaming calls and authorizes them on the request message
+func (a *Authenticator) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if a.policy.public(info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		principal, err := a.Authenticate(ss.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authorizedStream{
+			ServerStream: ss,
+			ctx:          a.newContext(ss.Context(), principal),
+			auth:         a,
+			principal:    principal,
+			method:       getMethod(info.FullMethod),
+		})
+	}
+}
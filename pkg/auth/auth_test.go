@@ -0,0 +1,159 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	jwt "github.com/form3tech-oss/jwt-go"
+	"github.com/stretchr/testify/assert"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testPolicy = `
+tokens:
+  - principal: ci
+    sha256: %s
+jwt:
+  issuer: test-issuer
+  secretFile: %s
+public:
+  - /grpc.health.v1.Health/*
+rules:
+  - principals: [ci]
+    methods: ["Get*", "ClusterStatus"]
+    providers: [aws]
+    accounts: ["team-*"]
+  - principals: [admin]
+  - principals: [security]
+    methods: ["*Credential", RevealCredential]
+`
+
+func newTestAuthenticator(t *testing.T) *Authenticator {
+	dir := t.TempDir()
+	secret := filepath.Join(dir, "secret")
+	assert.Nil(t, ioutil.WriteFile(secret, []byte("s3cr3t\n"), 0600))
+
+	sum := sha256.Sum256([]byte("ci-token"))
+	file := filepath.Join(dir, "policy.yaml")
+	policy := []byte(fmt.Sprintf(testPolicy, hex.EncodeToString(sum[:]), secret))
+	assert.Nil(t, ioutil.WriteFile(file, policy, 0600))
+
+	a, err := NewFromFile(file)
+	assert.Nil(t, err)
+	return a
+}
+
+func withToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, "Bearer "+token))
+}
+
+func signed(t *testing.T, claims jwt.MapClaims) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("s3cr3t"))
+	assert.Nil(t, err)
+	return token
+}
+
+func Test_interceptor(t *testing.T) {
+	a := newTestAuthenticator(t)
+	in := a.Interceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/spawner.SpawnerService/ClusterStatus"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		p, _ := FromContext(ctx)
+		return p, nil
+	}
+
+	allowed := &proto.ClusterStatusRequest{Provider: "aws", AccountName: "team-a"}
+	_, err := in(context.Background(), allowed, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "missing token")
+
+	_, err = in(withToken("wrong"), allowed, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "unknown token")
+
+	res, err := in(withToken("ci-token"), allowed, info, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, "ci", res)
+
+	_, err = in(withToken("ci-token"), &proto.ClusterStatusRequest{Provider: "aws", AccountName: "other"}, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "account not granted")
+
+	_, err = in(withToken("ci-token"), &proto.ClusterStatusRequest{Provider: "azure", AccountName: "team-a"}, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "provider not granted")
+
+	_, err = in(withToken("ci-token"), &proto.ReadCredentialRequest{Account: "team-a"}, &grpc.UnaryServerInfo{FullMethod: "/spawner.SpawnerService/ReadCredential"}, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "method not granted")
+
+	_, err = in(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"}, handler)
+	assert.Nil(t, err, "public method")
+}
+
+func Test_jwt(t *testing.T) {
+	a := newTestAuthenticator(t)
+
+	p, err := a.Authenticate(withToken(signed(t, jwt.MapClaims{"sub": "admin", "iss": "test-issuer", "exp": time.Now().Add(time.Hour).Unix()})))
+	assert.Nil(t, err)
+	assert.Equal(t, "admin", p)
+
+	_, err = a.Authenticate(withToken(signed(t, jwt.MapClaims{"sub": "admin", "iss": "other"})))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "issuer mismatch")
+
+	_, err = a.Authenticate(withToken(signed(t, jwt.MapClaims{"sub": "admin", "iss": "test-issuer", "exp": time.Now().Add(-time.Hour).Unix()})))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "expired")
+
+	_, err = a.Authenticate(withToken(signed(t, jwt.MapClaims{"sub": "admin", "iss": "test-issuer"})))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "token without exp must not be accepted")
+
+	_, err = a.Authenticate(withToken(signed(t, jwt.MapClaims{"sub": "admin", "iss": "test-issuer", "exp": time.Now().Add(2 * time.Hour).Unix(), "nbf": time.Now().Add(time.Hour).Unix()})))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "not valid yet")
+
+	forged, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "admin", "iss": "test-issuer"}).SignedString([]byte("guess"))
+	_, err = a.Authenticate(withToken(forged))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "bad signature")
+}
+
+func Test_privilegedMethods(t *testing.T) {
+	a := newTestAuthenticator(t)
+	req := &proto.RevealCredentialRequest{Account: "team-a", Type: "aws"}
+
+	assert.NotNil(t, a.Authorize("admin", "RevealCredential", req), "rule without methods must not grant privileged method")
+	assert.Nil(t, a.Authorize("admin", "ReadCredential", req))
+	assert.Nil(t, a.Authorize("security", "RevealCredential", req))
+	assert.Nil(t, a.Authorize("security", "ReadCredential", req))
+	assert.True(t, matchMethod([]string{"RevealCredential"}, "RevealCredential"))
+	assert.False(t, matchMethod([]string{"*Credential"}, "RevealCredential"), "pattern must not grant privileged method")
+}
+
+func Test_accountScope(t *testing.T) {
+	a := newTestAuthenticator(t)
+	in := a.Interceptor()
+
+	list := &proto.ListResourcesRequest{Provider: "aws"}
+	assert.NotNil(t, a.Authorize("ci", "GetResource", list), "empty account must not grant every account")
+	assert.Nil(t, a.Authorize("admin", "GetResource", list))
+
+	//operation is resolved by the handler, authorized on the account owning it
+	info := &grpc.UnaryServerInfo{FullMethod: "/spawner.SpawnerService/GetOperation"}
+	owner := ""
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, AuthorizeResource(ctx, "GetOperation", "aws", owner)
+	}
+
+	owner = "team-a"
+	_, err := in(withToken("ci-token"), &proto.GetOperationRequest{Id: "op-1"}, info, handler)
+	assert.Nil(t, err)
+
+	owner = "other"
+	_, err = in(withToken("ci-token"), &proto.GetOperationRequest{Id: "op-1"}, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "operation of another account")
+
+	assert.Nil(t, AuthorizeResource(context.Background(), "GetOperation", "aws", "other"), "authentication disabled")
+}
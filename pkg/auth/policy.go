@@ -0,0 +1,162 @@
+package auth
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path"
+
+	"sigs.k8s.io/yaml"
+)
+
+//Token static api token, only the hex encoded sha256 of the token is kept in the policy
+type Token struct {
+	Principal string `json:"principal"`
+	Sha256    string `json:"sha256"`
+}
+
+//JWT verification settings of the bearer tokens issued by an identity provider
+type JWT struct {
+	Issuer   string `json:"issuer"`
+	Audience string `json:"audience"`
+	//SecretFile shared secret verifying HS256 tokens
+	SecretFile string `json:"secretFile"`
+	//PublicKeyFile PEM encoded RSA or ECDSA public key verifying RS256/ES256 tokens
+	PublicKeyFile string `json:"publicKeyFile"`
+	//Claim holding the principal name, defaults to 'sub'
+	Claim string `json:"claim"`
+}
+
+//Rule grants the principals access to the methods on the providers and accounts.
+//
+//Entries are glob patterns such as 'Get*' or 'team-*', an omitted list matches everything. privileged methods
+//are granted only by the rules listing them by name.
+type Rule struct {
+	Principals []string `json:"principals"`
+	Methods    []string `json:"methods"`
+	Providers  []string `json:"providers"`
+	Accounts   []string `json:"accounts"`
+}
+
+//Policy authentication and authorization rules of the grpc api
+type Policy struct {
+	Tokens []Token `json:"tokens"`
+	JWT    *JWT    `json:"jwt"`
+	//Public methods allowed without authentication, such as health checks
+	Public []string `json:"public"`
+	Rules  []Rule   `json:"rules"`
+}
+
+//LoadPolicy reads the yaml or json policy file
+func LoadPolicy(file string) (*Policy, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Policy{}
+	if err := yaml.UnmarshalStrict(b, p); err != nil {
+		return nil, fmt.Errorf("invalid policy file '%s': %w", file, err)
+	}
+	return p, p.validate()
+}
+
+func (p *Policy) validate() error {
+	for _, t := range p.Tokens {
+		if t.Principal == "" {
+			return fmt.Errorf("token without principal")
+		}
+	}
+
+	if p.JWT != nil && p.JWT.SecretFile == "" && p.JWT.PublicKeyFile == "" {
+		return fmt.Errorf("jwt requires secretFile or publicKeyFile")
+	}
+
+	for _, r := range p.Rules {
+		if len(r.Principals) == 0 {
+			return fmt.Errorf("rule without principals")
+		}
+		for _, patterns := range [][]string{r.Principals, r.Methods, r.Providers, r.Accounts} {
+			for _, pattern := range patterns {
+				if _, err := path.Match(pattern, ""); err != nil {
+					return fmt.Errorf("invalid pattern '%s': %w", pattern, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+//match reports if any of the patterns match the value, empty patterns match everything
+func match(patterns []string, value string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, value); ok {
+			return true
+		}
+	}
+	return false
+}
+
+//matchAccount reports if the account is granted by the patterns. empty account stands for all the accounts, such
+//as list calls without the account filter, granted only when the patterns match every account
+func matchAccount(patterns []string, account string) bool {
+	if account != "" {
+		return match(patterns, account)
+	}
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, p := range patterns {
+		if p == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+//privilegedMethods methods disclosing the credential secrets, not matched by the patterns or an omitted methods list
+var privilegedMethods = map[string]bool{"RevealCredential": true}
+
+//matchMethod reports if the method is granted by the patterns, privileged methods must be named
+func matchMethod(patterns []string, method string) bool {
+	if !privilegedMethods[method] {
+		return match(patterns, method)
+	}
+	for _, p := range patterns {
+		if p == method {
+			return true
+		}
+	}
+	return false
+}
+
+//Request attributes of the call being authorized, provider and account are set only when request carries them
+type Request struct {
+	Method   string
+	Provider *string
+	Account  *string
+}
+
+//Allowed reports if any of the rules grant the principal access to the request
+func (p *Policy) Allowed(principal string, req Request) bool {
+	for _, r := range p.Rules {
+		if !match(r.Principals, principal) || !matchMethod(r.Methods, req.Method) {
+			continue
+		}
+		if req.Provider != nil && !match(r.Providers, *req.Provider) {
+			continue
+		}
+		if req.Account != nil && !matchAccount(r.Accounts, *req.Account) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+//public reports if the method can be called without authentication
+func (p *Policy) public(method string) bool {
+	return len(p.Public) > 0 && match(p.Public, method)
+}
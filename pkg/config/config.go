@@ -1,18 +1,37 @@
 package config
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+
+	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 )
 
-var config Config
+var (
+	mu     sync.RWMutex
+	config Config
+	//dir and loaded are the directory and the files of the last Load, read again on Reload
+	dir    string
+	loaded []string
+	//loadErr outcome of the last Load
+	loadErr = errors.New("config is not loaded")
+)
 
 // Config stores all configuration of the application.
 // The values are read by viper from a config file or environment variable.
+//
+// Fields tagged `reload:"true"` are applied by Reload, the rest are read once at startup and require restart.
 type Config struct {
 	//Env value can be 'dev', 'prod' or local
 	Env       string `mapstructure:"ENV"`
 	Port      int    `mapstructure:"GRPC_PORT"`
 	DebugPort int    `mapstructure:"HTTP_PORT"`
+	//LogLevel one of 'debug', 'info', 'warn' or 'error', defaults to debug for local and info otherwise
+	LogLevel string `mapstructure:"LOG_LEVEL" reload:"true"`
 	//Rancher optional, requires to register cluster with rancher
 
 	RancherUsername string `mapstructure:"RANCHER_USERNAME"`
@@ -30,10 +49,94 @@ type Config struct {
 	//SecretHostRegion aws secret manager region, used for storing user credentials
 	SecretHostRegion string `mapstructure:"SECRET_HOST_REGION"`
 
+	//SecretStore backend keeping the account credentials, one of 'aws', 'vault' or 'file'. defaults to 'aws'
+	SecretStore string `mapstructure:"SECRET_STORE"`
+	//Vault kv v2 settings of the 'vault' secret store
+	VaultAddr      string `mapstructure:"VAULT_ADDR"`
+	VaultToken     string `mapstructure:"VAULT_TOKEN"`
+	VaultNamespace string `mapstructure:"VAULT_NAMESPACE"`
+	//VaultMount mount path of the kv v2 engine, defaults to 'secret'
+	VaultMount string `mapstructure:"VAULT_MOUNT"`
+	//VaultPathPrefix path under the mount the secrets are kept in
+	VaultPathPrefix string `mapstructure:"VAULT_PATH_PREFIX"`
+	//SecretFilePath encrypted file of the 'file' secret store
+	SecretFilePath string `mapstructure:"SECRET_FILE_PATH"`
+	//SecretFileKey base64 encoded 32 byte AES-256 key the file is encrypted with
+	SecretFileKey string `mapstructure:"SECRET_FILE_KEY"`
+	//CredentialKeyProvider encrypts the account credentials before they are stored, one of 'aws-kms',
+	//'vault-transit' or 'file'. credentials are stored unencrypted when not set
+	CredentialKeyProvider string `mapstructure:"CREDENTIAL_KEY_PROVIDER"`
+	//KMSKeyID id, arn or alias of the kms key of the 'aws-kms' key provider
+	KMSKeyID string `mapstructure:"KMS_KEY_ID"`
+	//KMSRegion region of the kms key, defaults to SECRET_HOST_REGION
+	KMSRegion string `mapstructure:"KMS_REGION"`
+	//VaultTransitMount mount path of the transit engine of the 'vault-transit' key provider, defaults to 'transit'.
+	//vault address and token are same as of the 'vault' secret store
+	VaultTransitMount string `mapstructure:"VAULT_TRANSIT_MOUNT"`
+	//VaultTransitKey name of the transit key
+	VaultTransitKey string `mapstructure:"VAULT_TRANSIT_KEY"`
+	//CredentialKeyFile file holding the base64 encoded 32 byte key of the 'file' key provider
+	CredentialKeyFile string `mapstructure:"CREDENTIAL_KEY_FILE"`
+	//CredentialAllowPlaintext reads the credentials stored before the encryption was enabled, every such read is
+	//logged. meant to be set only while the credentials are migrated
+	CredentialAllowPlaintext bool `mapstructure:"CREDENTIAL_ALLOW_PLAINTEXT"`
+	//CredentialCacheTTL time in seconds the account credentials read from the secret store are cached,
+	//cache is disabled when 0
+	CredentialCacheTTL int `mapstructure:"CREDENTIAL_CACHE_TTL_IN_SECONDS" reload:"true"`
+
 	//NodeDeletionTimeout during the cluster deletion with force flag enabled, all attached nodes will be deleted
 	//and spawner will wait till NodeDeletionTimeout before attemption cluster deletion.
 	//make sure this is set sufficiently for the nodes to be deleted, otherwise cluster deletion will fail
-	NodeDeletionTimeout int32 `mapstructure:"NODE_DELETION_TIME_IN_SECONDS"`
+	NodeDeletionTimeout int32 `mapstructure:"NODE_DELETION_TIME_IN_SECONDS" reload:"true"`
+
+	//OperationTimeout max time in minutes a long running operation can take, defaults to 60min
+	OperationTimeout int `mapstructure:"OPERATION_TIMEOUT_IN_MINUTES" reload:"true"`
+	//OperationRetention time in hours completed operations are kept, defaults to 24h
+	OperationRetention int `mapstructure:"OPERATION_RETENTION_IN_HOURS" reload:"true"`
+
+	//IdempotencyKeyTTL time in hours the result of a call made with idempotency key is kept, defaults to 24h
+	IdempotencyKeyTTL int `mapstructure:"IDEMPOTENCY_KEY_TTL_IN_HOURS" reload:"true"`
+
+	//AuthPolicyFile yaml file with the api tokens, jwt settings and the rules granting principals access to
+	//the accounts, providers and methods. grpc api is not authenticated when empty
+	AuthPolicyFile string `mapstructure:"AUTH_POLICY_FILE"`
+
+	//AuditSinks comma separated audit log destinations, 'stdout' and 'file'. audit log is disabled when empty
+	AuditSinks string `mapstructure:"AUDIT_SINKS"`
+	//AuditFilePath json lines file of the 'file' audit sink
+	AuditFilePath string `mapstructure:"AUDIT_FILE_PATH"`
+	//AuditFileMaxSize size in MB audit file is rotated at, defaults to 100MB
+	AuditFileMaxSize int `mapstructure:"AUDIT_FILE_MAX_SIZE_IN_MB"`
+	//AuditFileMaxBackups number of rotated audit files to keep
+	AuditFileMaxBackups int `mapstructure:"AUDIT_FILE_MAX_BACKUPS"`
+
+	//RateLimits comma separated 'Method:count/unit[:burst]' token bucket limits applied per account, provider and
+	//method, '*' sets the limit of the methods without one. eg. 'GetClusters:2/s:5,*:20/s'
+	RateLimits string `mapstructure:"RATE_LIMITS" reload:"true"`
+	//InFlightLimits comma separated 'Method:max' concurrent calls allowed per account, provider and method
+	InFlightLimits string `mapstructure:"IN_FLIGHT_LIMITS" reload:"true"`
+
+	//TraceExporter span exporter, one of 'otlp', 'stdout' or 'none'. tracing is disabled when empty
+	TraceExporter string `mapstructure:"TRACE_EXPORTER"`
+	//TraceEndpoint host:port of the otlp grpc collector, defaults to localhost:4317
+	TraceEndpoint string `mapstructure:"TRACE_OTLP_ENDPOINT"`
+	//TraceInsecure disables tls to the otlp collector
+	TraceInsecure bool `mapstructure:"TRACE_OTLP_INSECURE"`
+	//TraceSampleRatio fraction of the traces started by spawner to sample, all are sampled when 0
+	TraceSampleRatio float64 `mapstructure:"TRACE_SAMPLE_RATIO"`
+
+	//HealthCheckInterval time in seconds between the readiness checks of the dependencies, defaults to 30s
+	HealthCheckInterval int `mapstructure:"HEALTH_CHECK_INTERVAL_IN_SECONDS"`
+
+	//InventoryPath bolt db file recording the resources provisioned by spawner, inventory is disabled when empty
+	InventoryPath string `mapstructure:"INVENTORY_DB_PATH"`
+
+	//FleetCollectionInterval time in minutes between the listings of the spawner resources exported as fleet
+	//gauges, collection is disabled when 0
+	FleetCollectionInterval int `mapstructure:"FLEET_COLLECTION_INTERVAL_IN_MINUTES"`
+	//FleetTargets comma separated 'provider:account:region' scanned by the fleet collector along with the ones
+	//found in the inventory. provider must be one of 'aws', 'azure' or 'fake'
+	FleetTargets string `mapstructure:"FLEET_TARGETS" reload:"true"`
 
 	//Azure config
 
@@ -50,26 +153,93 @@ type Config struct {
 	// GCP dev credential config
 	GcpProject     string `mapstructure:"GCP_PROJECT"`
 	GcpCertificate string `mapstructure:"GCP_CERTIFICATE"`
+
+	//Providers comma separated list of providers to enable, all except opt-in providers are enabled when empty
+	Providers string `mapstructure:"PROVIDERS"`
+
+	//Fake provider, in-memory provider used for testing spawner clients without cloud accounts
+	FakeProviderEnabled bool `mapstructure:"FAKE_PROVIDER_ENABLED"`
+	//FakeTransitionDelay time in seconds fake resources stay in CREATING/DELETING state
+	FakeTransitionDelay int `mapstructure:"FAKE_TRANSITION_DELAY_IN_SECONDS"`
+	//FakeLatency time in milliseconds added to every fake provider call
+	FakeLatency int `mapstructure:"FAKE_LATENCY_IN_MILLISECONDS"`
+	//FakeFailures comma separated list of 'Method[:count]' calls to fail, fails always when count is not set
+	FakeFailures string `mapstructure:"FAKE_FAILURES"`
 }
 
-// Load reads configuration from file or environment variables.
+//Load reads config.env from path, overlays the profile of the env, config.<env>.env, when it exists and applies
+//the environment variable overrides. The config is validated, Get returns the loaded config even when it is invalid.
 func Load(path string) error {
-	viper.AddConfigPath(path)
-	viper.SetConfigName("config")
-	viper.SetConfigType("env")
+	c, files, err := read(path)
+	if err == nil {
+		err = Validate(c)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	dir = path
+	loaded = files
+	config = c
+	loadErr = err
+	return err
+}
 
-	viper.AutomaticEnv()
+//read loads the config files of the dir without changing the current config
+func read(path string) (Config, []string, error) {
+	var c Config
+	v := viper.New()
+	v.AddConfigPath(path)
+	v.SetConfigName("config")
+	v.SetConfigType("env")
 
-	err := viper.ReadInConfig()
-	if err != nil {
-		return err
+	v.AutomaticEnv()
+	//keys missing in the files are overridden by env only when known to viper
+	for _, key := range keys() {
+		_ = v.BindEnv(key)
 	}
-	err = viper.Unmarshal(&config)
 
-	return err
+	if err := v.ReadInConfig(); err != nil {
+		return c, nil, err
+	}
+	files := []string{v.ConfigFileUsed()}
+
+	if env := v.GetString("ENV"); env != "" {
+		profile := filepath.Join(path, fmt.Sprintf("config.%s.env", env))
+		if _, err := os.Stat(profile); err == nil {
+			v.SetConfigFile(profile)
+			if err := v.MergeInConfig(); err != nil {
+				return c, nil, errors.Wrapf(err, "failed to read profile '%s'", profile)
+			}
+			files = append(files, profile)
+		}
+	}
+
+	err := v.Unmarshal(&c)
+	return c, files, err
+}
+
+//keys config keys, the mapstructure tags of Config
+func keys() []string {
+	t := reflect.TypeOf(Config{})
+	keys := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		if key := t.Field(i).Tag.Get("mapstructure"); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+//Loaded returns the error of loading the config, nil once it is loaded
+func Loaded() error {
+	mu.RLock()
+	defer mu.RUnlock()
+	return loadErr
 }
 
 //Get retrieve cached config
 func Get() Config {
+	mu.RLock()
+	defer mu.RUnlock()
 	return config
 }
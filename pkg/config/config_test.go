@@ -0,0 +1,137 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const base = `ENV=dev
+GRPC_PORT=8083
+HTTP_PORT=8080
+SECRET_HOST_REGION=us-west-2
+OPERATION_TIMEOUT_IN_MINUTES=60
+RATE_LIMITS=
+`
+
+func writeFile(t *testing.T, dir, name, content string) {
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600))
+}
+
+func Test_LoadProfile(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "config.env", base)
+	writeFile(t, dir, "config.dev.env", "OPERATION_TIMEOUT_IN_MINUTES=30\nLOG_LEVEL=debug\n")
+	t.Setenv("HTTP_PORT", "9090")
+	t.Setenv("TRACE_EXPORTER", "stdout")
+
+	assert.Nil(t, Load(dir))
+	c := Get()
+	assert.Equal(t, 30, c.OperationTimeout, "profile must override config.env")
+	assert.Equal(t, "debug", c.LogLevel)
+	assert.Equal(t, 9090, c.DebugPort, "env must override the files")
+	assert.Equal(t, "stdout", c.TraceExporter, "env must override keys missing in the files")
+	assert.Nil(t, Loaded())
+}
+
+func Test_Validate(t *testing.T) {
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "missing"))
+
+	err := Validate(Config{
+		Env:              EnvLocal,
+		Port:             8080,
+		DebugPort:        8080,
+		AWSAccessID:      "id",
+		Providers:        "aws,azure",
+		OperationTimeout: -1,
+		RateLimits:       "GetClusters:2",
+		AuditSinks:       "stdout,syslog",
+		FleetTargets:     "aws:team-a:us-west-2,gcp:team-a:us-central1,azure",
+	})
+	var verr *ValidationError
+	assert.True(t, errors.As(err, &verr))
+	assert.ElementsMatch(t, []string{
+		"GRPC_PORT and HTTP_PORT must be different, both are 8080",
+		"AWS_ACCESS_ID and AWS_SECRET_KEY must be set together",
+		"AZURE_CLIENT_ID is required when ENV=local",
+		"AZURE_CLIENT_SECRET is required when ENV=local",
+		"AZURE_RESOURCE_GROUP is required when ENV=local",
+		"AZURE_SUBSCRIPTION_ID is required when ENV=local",
+		"AZURE_TENANT_ID is required when ENV=local",
+		"OPERATION_TIMEOUT_IN_MINUTES must not be negative, got -1",
+		"RATE_LIMITS: invalid rate '2' in 'GetClusters:2', must be 'count/unit' with unit one of s, m or h",
+		"AUDIT_SINKS must be one of ['stdout', 'file'], got 'syslog'",
+		"FLEET_TARGETS provider must be one of ['aws', 'azure', 'fake'], got 'gcp'",
+		"FLEET_TARGETS entry 'azure' must be 'provider:account:region'",
+	}, verr.Problems)
+
+	err = Validate(Config{Env: EnvLocal, Port: 8083, DebugPort: 8080})
+	assert.Equal(t, []string{
+		"AWS_ACCESS_ID and AWS_SECRET_KEY are required when ENV=local and no 'default' profile is found in the aws shared credentials file",
+	}, err.(*ValidationError).Problems)
+
+	err = Validate(Config{Env: EnvProd, Port: 8083, DebugPort: 8080})
+	assert.Equal(t, []string{"SECRET_HOST_REGION is required when ENV=prod"}, err.(*ValidationError).Problems)
+
+	err = Validate(Config{Env: EnvProd, Port: 8083, DebugPort: 8080, SecretStore: "vault", VaultAddr: "http://vault:8200"})
+	assert.Equal(t, []string{"VAULT_ADDR and VAULT_TOKEN are required for the 'vault' secret store"}, err.(*ValidationError).Problems)
+}
+
+func Test_Reload(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "config.env", base)
+	assert.Nil(t, Load(dir))
+
+	var reloaded Config
+	OnReload(func(c Config) { reloaded = c })
+
+	writeFile(t, dir, "config.env", base+"RATE_LIMITS=*:10/s\nGRPC_PORT=9000\n")
+	applied, restart, err := Reload()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"RATE_LIMITS"}, applied)
+	assert.Equal(t, []string{"GRPC_PORT"}, restart)
+	assert.Equal(t, "*:10/s", Get().RateLimits)
+	assert.Equal(t, 8083, Get().Port, "structural settings must not change till restart")
+	assert.Equal(t, "*:10/s", reloaded.RateLimits)
+
+	writeFile(t, dir, "config.env", base+"RATE_LIMITS=*:10\n")
+	_, _, err = Reload()
+	assert.NotNil(t, err, "invalid config must be rejected")
+	assert.Equal(t, "*:10/s", Get().RateLimits)
+
+	os.Remove(filepath.Join(dir, "config.env"))
+	_, _, err = Reload()
+	assert.NotNil(t, err)
+}
+
+func Test_Watch(t *testing.T) {
+	watchDebounce = 200 * time.Millisecond
+	dir := t.TempDir()
+	writeFile(t, dir, "config.env", base)
+	assert.Nil(t, Load(dir))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changed := make(chan struct{}, 1)
+	go Watch(ctx, func() { changed <- struct{}{} })
+	time.Sleep(100 * time.Millisecond)
+
+	writeFile(t, dir, "unrelated.txt", "x")
+	writeFile(t, dir, "config.env", base+"LOG_LEVEL=warn\n")
+	select {
+	case <-changed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("config change is not notified")
+	}
+	select {
+	case <-changed:
+		t.Fatal("writes must be debounced")
+	case <-time.After(2 * watchDebounce):
+	}
+}
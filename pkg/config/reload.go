@@ -0,0 +1,115 @@
+package config
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+)
+
+//watchDebounce editors and configmap updates write the files in several steps, reload once they settle
+var watchDebounce = time.Second
+
+var subscribers []func(Config)
+
+//OnReload registers fn to be called with the new config after Reload applied a change
+func OnReload(fn func(Config)) {
+	mu.Lock()
+	defer mu.Unlock()
+	subscribers = append(subscribers, fn)
+}
+
+//Reload reads the files of the last Load again and applies the reloadable settings.
+//
+//applied are the keys which changed and took effect, restart are the keys which changed but are read only at
+//startup. the current config is kept when the files cannot be read or are invalid.
+func Reload() (applied []string, restart []string, err error) {
+	mu.RLock()
+	path := dir
+	mu.RUnlock()
+
+	next, _, err := read(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = Validate(next); err != nil {
+		return nil, nil, err
+	}
+
+	mu.Lock()
+	cur := reflect.ValueOf(&config).Elem()
+	nv := reflect.ValueOf(next)
+	t := cur.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if reflect.DeepEqual(cur.Field(i).Interface(), nv.Field(i).Interface()) {
+			continue
+		}
+		key := t.Field(i).Tag.Get("mapstructure")
+		if t.Field(i).Tag.Get("reload") != "true" {
+			restart = append(restart, key)
+			continue
+		}
+		cur.Field(i).Set(nv.Field(i))
+		applied = append(applied, key)
+	}
+	c := config
+	fns := append([]func(Config){}, subscribers...)
+	mu.Unlock()
+
+	if len(applied) > 0 {
+		for _, fn := range fns {
+			fn(c)
+		}
+	}
+	return applied, restart, nil
+}
+
+//Watch calls onChange when the files of the last Load are written, till ctx is cancelled.
+//
+//The directory is watched instead of the files, editors and kubernetes configmaps replace the file rather than
+//writing to it.
+func Watch(ctx context.Context, onChange func()) error {
+	mu.RLock()
+	files := map[string]bool{}
+	for _, f := range loaded {
+		files[filepath.Clean(f)] = true
+	}
+	path := dir
+	mu.RUnlock()
+
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+	if err := w.Add(path); err != nil {
+		return errors.Wrapf(err, "failed to watch config dir '%s'", path)
+	}
+
+	timer := time.NewTimer(watchDebounce)
+	timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-w.Events:
+			if !ok {
+				return nil
+			}
+			//configmap volumes swap the '..data' symlink to update the files
+			if files[filepath.Clean(e.Name)] || filepath.Base(e.Name) == "..data" {
+				timer.Reset(watchDebounce)
+			}
+		case err, ok := <-w.Errors:
+			if !ok {
+				return nil
+			}
+			return err
+		case <-timer.C:
+			onChange()
+		}
+	}
+}
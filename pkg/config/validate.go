@@ -0,0 +1,216 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/netbookai/log/loggers"
+	"gitlab.com/netbook-devs/spawner-service/pkg/ratelimit"
+)
+
+const (
+	EnvLocal = "local"
+	EnvDev   = "dev"
+	EnvProd  = "prod"
+)
+
+//ValidationError lists all the problems found in the config
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid config: " + strings.Join(e.Problems, "; ")
+}
+
+type validator struct {
+	problems []string
+}
+
+func (v *validator) check(ok bool, format string, args ...interface{}) {
+	if !ok {
+		v.problems = append(v.problems, fmt.Sprintf(format, args...))
+	}
+}
+
+func (v *validator) nonNegative(key string, value int) {
+	v.check(value >= 0, "%s must not be negative, got %d", key, value)
+}
+
+func (v *validator) oneOf(key, value string, allowed ...string) {
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+	v.check(false, "%s must be one of ['%s'], got '%s'", key, strings.Join(allowed, "', '"), value)
+}
+
+func (v *validator) required(env string, values map[string]string) {
+	for _, key := range sortedKeys(values) {
+		v.check(values[key] != "", "%s is required when ENV=%s", key, env)
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+//listed returns true when the provider is named in PROVIDERS
+func (c Config) listed(provider string) bool {
+	for _, name := range strings.Split(c.Providers, ",") {
+		if strings.TrimSpace(name) == provider {
+			return true
+		}
+	}
+	return false
+}
+
+//awsProfileExists returns true when the shared credentials file used by the 'default' profile fallback exists
+func awsProfileExists() bool {
+	file := os.Getenv("AWS_SHARED_CREDENTIALS_FILE")
+	if file == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return false
+		}
+		file = filepath.Join(home, ".aws", "credentials")
+	}
+	_, err := os.Stat(file)
+	return err == nil
+}
+
+//Validate checks the settings and the credentials required by the env, all the problems found are reported
+//in ValidationError.
+//
+//local env uses the aws keys for the system session and the provider credentials set in config, other envs
+//fetch the credentials from the secret store.
+func Validate(c Config) error {
+	v := &validator{}
+
+	v.oneOf("ENV", c.Env, EnvLocal, EnvDev, EnvProd)
+	v.check(c.Port > 0 && c.Port < 65536, "GRPC_PORT must be a valid port, got %d", c.Port)
+	v.check(c.DebugPort > 0 && c.DebugPort < 65536, "HTTP_PORT must be a valid port, got %d", c.DebugPort)
+	v.check(c.Port != c.DebugPort, "GRPC_PORT and HTTP_PORT must be different, both are %d", c.Port)
+	if c.LogLevel != "" {
+		_, err := loggers.ParseLevel(c.LogLevel)
+		v.check(err == nil, "LOG_LEVEL must be one of ['debug', 'info', 'warn', 'error'], got '%s'", c.LogLevel)
+	}
+
+	switch c.Env {
+	case EnvLocal:
+		v.check((c.AWSAccessID == "") == (c.AWSSecretKey == ""), "AWS_ACCESS_ID and AWS_SECRET_KEY must be set together")
+		v.check(c.AWSAccessID != "" || awsProfileExists(),
+			"AWS_ACCESS_ID and AWS_SECRET_KEY are required when ENV=local and no 'default' profile is found in the aws shared credentials file")
+		if c.listed("azure") {
+			v.required(c.Env, map[string]string{
+				"AZURE_SUBSCRIPTION_ID": c.AzureSubscriptionID,
+				"AZURE_TENANT_ID":       c.AzureTenantID,
+				"AZURE_CLIENT_ID":       c.AzureClientID,
+				"AZURE_CLIENT_SECRET":   c.AzureClientSecret,
+				"AZURE_RESOURCE_GROUP":  c.AzureResourceGroup,
+			})
+		}
+		if c.listed("gcp") {
+			v.required(c.Env, map[string]string{
+				"GCP_PROJECT":     c.GcpProject,
+				"GCP_CERTIFICATE": c.GcpCertificate,
+			})
+		}
+	}
+
+	switch c.SecretStore {
+	case "", "aws":
+		if c.Env != EnvLocal {
+			v.required(c.Env, map[string]string{"SECRET_HOST_REGION": c.SecretHostRegion})
+		}
+	case "vault":
+		v.check(c.VaultAddr != "" && c.VaultToken != "", "VAULT_ADDR and VAULT_TOKEN are required for the 'vault' secret store")
+	case "file":
+		v.check(c.SecretFilePath != "" && c.SecretFileKey != "", "SECRET_FILE_PATH and SECRET_FILE_KEY are required for the 'file' secret store")
+	default:
+		v.oneOf("SECRET_STORE", c.SecretStore, "aws", "vault", "file")
+	}
+
+	switch c.CredentialKeyProvider {
+	case "":
+	case "aws-kms":
+		v.check(c.KMSKeyID != "", "KMS_KEY_ID is required for the 'aws-kms' credential key provider")
+		v.check(c.KMSRegion != "" || c.SecretHostRegion != "", "KMS_REGION or SECRET_HOST_REGION is required for the 'aws-kms' credential key provider")
+	case "vault-transit":
+		v.check(c.VaultAddr != "" && c.VaultToken != "" && c.VaultTransitKey != "",
+			"VAULT_ADDR, VAULT_TOKEN and VAULT_TRANSIT_KEY are required for the 'vault-transit' credential key provider")
+	case "file":
+		v.check(c.CredentialKeyFile != "", "CREDENTIAL_KEY_FILE is required for the 'file' credential key provider")
+	default:
+		v.oneOf("CREDENTIAL_KEY_PROVIDER", c.CredentialKeyProvider, "aws-kms", "vault-transit", "file")
+	}
+
+	if c.AzureCloudProvider != "" {
+		_, err := azure.EnvironmentFromName(c.AzureCloudProvider)
+		v.check(err == nil, "AZURE_CLOUD_PROVIDER '%s' is not a known azure cloud", c.AzureCloudProvider)
+	}
+
+	v.nonNegative("NODE_DELETION_TIME_IN_SECONDS", int(c.NodeDeletionTimeout))
+	v.nonNegative("OPERATION_TIMEOUT_IN_MINUTES", c.OperationTimeout)
+	v.nonNegative("OPERATION_RETENTION_IN_HOURS", c.OperationRetention)
+	v.nonNegative("IDEMPOTENCY_KEY_TTL_IN_HOURS", c.IdempotencyKeyTTL)
+	v.nonNegative("HEALTH_CHECK_INTERVAL_IN_SECONDS", c.HealthCheckInterval)
+	v.nonNegative("FLEET_COLLECTION_INTERVAL_IN_MINUTES", c.FleetCollectionInterval)
+	v.nonNegative("CREDENTIAL_CACHE_TTL_IN_SECONDS", c.CredentialCacheTTL)
+	v.nonNegative("AUDIT_FILE_MAX_SIZE_IN_MB", c.AuditFileMaxSize)
+	v.nonNegative("AUDIT_FILE_MAX_BACKUPS", c.AuditFileMaxBackups)
+
+	for _, sink := range strings.Split(c.AuditSinks, ",") {
+		sink = strings.TrimSpace(sink)
+		if sink != "" {
+			v.oneOf("AUDIT_SINKS", sink, "stdout", "file")
+		}
+		if sink == "file" {
+			v.check(c.AuditFilePath != "", "AUDIT_FILE_PATH is required for the 'file' audit sink")
+		}
+	}
+
+	//gcp does not list its fleet, its targets would export no gauges at all
+	for _, target := range strings.Split(c.FleetTargets, ",") {
+		target = strings.TrimSpace(target)
+		if target == "" {
+			continue
+		}
+		parts := strings.Split(target, ":")
+		if len(parts) != 3 {
+			v.check(false, "FLEET_TARGETS entry '%s' must be 'provider:account:region'", target)
+			continue
+		}
+		v.oneOf("FLEET_TARGETS provider", parts[0], "aws", "azure", "fake")
+	}
+
+	if c.AuthPolicyFile != "" {
+		_, err := os.Stat(c.AuthPolicyFile)
+		v.check(err == nil, "AUTH_POLICY_FILE '%s' is not readable: %v", c.AuthPolicyFile, err)
+	}
+
+	_, err := ratelimit.ParseRateLimits(c.RateLimits)
+	v.check(err == nil, "RATE_LIMITS: %v", err)
+	_, err = ratelimit.ParseInFlightLimits(c.InFlightLimits)
+	v.check(err == nil, "IN_FLIGHT_LIMITS: %v", err)
+
+	if c.TraceExporter != "" {
+		v.oneOf("TRACE_EXPORTER", c.TraceExporter, "otlp", "stdout", "none")
+	}
+	v.check(c.TraceSampleRatio >= 0 && c.TraceSampleRatio <= 1, "TRACE_SAMPLE_RATIO must be between 0 and 1, got %v", c.TraceSampleRatio)
+
+	if len(v.problems) > 0 {
+		return &ValidationError{Problems: v.problems}
+	}
+	return nil
+}
@@ -0,0 +1,326 @@
+package errmap
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/googleapis/gax-go/v2/apierror"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/aws"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/fake"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/gcp"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/operation"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/registry"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//spawnerDomain domain of the errors raised by spawner itself
+const spawnerDomain = "spawner"
+
+//sentinels errors raised by spawner and providers and the codes they are reported with
+var sentinels = []struct {
+	err    error
+	code   codes.Code
+	reason string
+}{
+	{registry.ErrProviderNotFound, codes.InvalidArgument, "PROVIDER_NOT_FOUND"},
+	{registry.ErrNotSupported, codes.Unimplemented, "NOT_SUPPORTED"},
+	{registry.ErrInvalidCredential, codes.InvalidArgument, "INVALID_CREDENTIAL"},
+	{operation.ErrNotFound, codes.NotFound, "OPERATION_NOT_FOUND"},
+	{operation.ErrDone, codes.FailedPrecondition, "OPERATION_DONE"},
+	{inventory.ErrNotFound, codes.NotFound, "RESOURCE_NOT_FOUND"},
+	{constants.ErrInvalidCredentiualType, codes.InvalidArgument, "INVALID_CREDENTIAL_TYPE"},
+	{constants.ErrInvalidCredentialStage, codes.InvalidArgument, "INVALID_CREDENTIAL_STAGE"},
+	{constants.ErrRevealReasonRequired, codes.InvalidArgument, "REVEAL_REASON_REQUIRED"},
+	{constants.ErrRevealNotAudited, codes.FailedPrecondition, "REVEAL_NOT_AUDITED"},
+	{constants.ErrClusterFailed, codes.FailedPrecondition, "CLUSTER_FAILED"},
+	{system.ErrSecretNotFound, codes.NotFound, "CREDENTIAL_NOT_FOUND"},
+	{system.ErrNoPendingVersion, codes.FailedPrecondition, "NO_PENDING_CREDENTIAL"},
+	{system.ErrSecretConflict, codes.Aborted, "CREDENTIAL_CONFLICT"},
+	{aws.ERR_CLUSTER_EXIST, codes.AlreadyExists, "CLUSTER_EXIST"},
+	{aws.ERR_NODEGROUP_EXIST, codes.AlreadyExists, "NODEGROUP_EXIST"},
+	{gcp.ErrClusterNotFound, codes.NotFound, "CLUSTER_NOT_FOUND"},
+	{fake.ErrClusterExist, codes.AlreadyExists, "CLUSTER_EXIST"},
+	{fake.ErrClusterNotFound, codes.NotFound, "CLUSTER_NOT_FOUND"},
+	{fake.ErrClusterNotActive, codes.FailedPrecondition, "CLUSTER_NOT_ACTIVE"},
+	{fake.ErrNodegroupExist, codes.AlreadyExists, "NODEGROUP_EXIST"},
+	{fake.ErrNodegroupMissing, codes.NotFound, "NODEGROUP_NOT_FOUND"},
+	{fake.ErrClusterHasNodes, codes.FailedPrecondition, "CLUSTER_HAS_NODES"},
+	{fake.ErrVolumeNotFound, codes.NotFound, "VOLUME_NOT_FOUND"},
+	{fake.ErrSnapshotNotFound, codes.NotFound, "SNAPSHOT_NOT_FOUND"},
+}
+
+//awsCodes aws error codes which cannot be classified by their suffix
+var awsCodes = map[string]codes.Code{
+	"ResourceInUseException":        codes.FailedPrecondition,
+	"DependencyViolation":           codes.FailedPrecondition,
+	"IncorrectState":                codes.FailedPrecondition,
+	"IncorrectInstanceState":        codes.FailedPrecondition,
+	"VolumeInUse":                   codes.FailedPrecondition,
+	"NoSuchEntity":                  codes.NotFound,
+	"NoSuchHostedZone":              codes.NotFound,
+	"NoSuchBucket":                  codes.NotFound,
+	"NoSuchKey":                     codes.NotFound,
+	"EntityAlreadyExists":           codes.AlreadyExists,
+	"AccessDenied":                  codes.PermissionDenied,
+	"AccessDeniedException":         codes.PermissionDenied,
+	"UnauthorizedOperation":         codes.PermissionDenied,
+	"AuthFailure":                   codes.Unauthenticated,
+	"ExpiredToken":                  codes.Unauthenticated,
+	"ExpiredTokenException":         codes.Unauthenticated,
+	"InvalidClientTokenId":          codes.Unauthenticated,
+	"UnrecognizedClientException":   codes.Unauthenticated,
+	"Throttling":                    codes.ResourceExhausted,
+	"ThrottlingException":           codes.ResourceExhausted,
+	"RequestLimitExceeded":          codes.ResourceExhausted,
+	"TooManyRequestsException":      codes.ResourceExhausted,
+	"ServiceQuotaExceededException": codes.ResourceExhausted,
+	"InsufficientInstanceCapacity":  codes.ResourceExhausted,
+	"ValidationError":               codes.InvalidArgument,
+	"ValidationException":           codes.InvalidArgument,
+	"InvalidParameterException":     codes.InvalidArgument,
+	"InvalidRequestException":       codes.InvalidArgument,
+	"InvalidChangeBatch":            codes.InvalidArgument,
+	"ServiceUnavailable":            codes.Unavailable,
+	"ServiceUnavailableException":   codes.Unavailable,
+	"InternalFailure":               codes.Internal,
+	"InternalError":                 codes.Internal,
+	"ServerException":               codes.Internal,
+	"RequestCanceled":               codes.Canceled,
+	"RequestError":                  codes.Unavailable,
+}
+
+//azureCodes azure service error codes
+var azureCodes = map[string]codes.Code{
+	"NotFound":                     codes.NotFound,
+	"ResourceNotFound":             codes.NotFound,
+	"ResourceGroupNotFound":        codes.NotFound,
+	"ParentResourceNotFound":       codes.NotFound,
+	"AuthorizationFailed":          codes.PermissionDenied,
+	"LinkedAuthorizationFailed":    codes.PermissionDenied,
+	"AuthenticationFailed":         codes.Unauthenticated,
+	"InvalidAuthenticationToken":   codes.Unauthenticated,
+	"ExpiredAuthenticationToken":   codes.Unauthenticated,
+	"QuotaExceeded":                codes.ResourceExhausted,
+	"TooManyRequests":              codes.ResourceExhausted,
+	"InvalidParameter":             codes.InvalidArgument,
+	"InvalidRequestContent":        codes.InvalidArgument,
+	"BadRequest":                   codes.InvalidArgument,
+	"Conflict":                     codes.FailedPrecondition,
+	"OperationNotAllowed":          codes.FailedPrecondition,
+	"OperationPreempted":           codes.Aborted,
+	"AnotherOperationInProgress":   codes.Aborted,
+	"InternalServerError":          codes.Internal,
+	"ServiceUnavailable":           codes.Unavailable,
+	"SubscriptionNotFound":         codes.NotFound,
+	"DisallowedOperation":          codes.PermissionDenied,
+	"InvalidResourceGroupLocation": codes.InvalidArgument,
+}
+
+//httpCode canonical code for the http status, used when provider code is not known
+func httpCode(statusCode int) codes.Code {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusPreconditionFailed:
+		return codes.FailedPrecondition
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable, http.StatusBadGateway:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	}
+
+	switch {
+	case statusCode >= 500:
+		return codes.Internal
+	case statusCode >= 400:
+		return codes.FailedPrecondition
+	}
+	return codes.Unknown
+}
+
+//awsCode classify the aws error code, falls back to http status when code is unknown
+func awsCode(code string, statusCode int) codes.Code {
+	if c, ok := awsCodes[code]; ok {
+		return c
+	}
+
+	switch {
+	case strings.HasSuffix(code, "NotFound"), strings.HasSuffix(code, "NotFoundException"):
+		return codes.NotFound
+	case strings.HasSuffix(code, "AlreadyExists"), strings.HasSuffix(code, "AlreadyExistsException"), strings.HasSuffix(code, ".Duplicate"):
+		return codes.AlreadyExists
+	case strings.HasSuffix(code, "LimitExceeded"), strings.HasSuffix(code, "LimitExceededException"):
+		return codes.ResourceExhausted
+	case strings.HasPrefix(code, "InvalidParameter"), strings.HasSuffix(code, ".Malformed"):
+		return codes.InvalidArgument
+	}
+	return httpCode(statusCode)
+}
+
+//azureCode classify the azure service error code, falls back to http status when code is unknown
+func azureCode(code string, statusCode int) codes.Code {
+	if c, ok := azureCodes[code]; ok {
+		return c
+	}
+	return httpCode(statusCode)
+}
+
+//providerError error translated to canonical code along with the details reported by provider
+type providerError struct {
+	code       codes.Code
+	domain     string
+	reason     string
+	statusCode int
+	requestId  string
+}
+
+func classify(err error) providerError {
+	for _, s := range sentinels {
+		if errors.Is(err, s.err) {
+			return providerError{code: s.code, domain: spawnerDomain, reason: s.reason}
+		}
+	}
+
+	var reqFailure awserr.RequestFailure
+	if errors.As(err, &reqFailure) {
+		return providerError{
+			code:       awsCode(reqFailure.Code(), reqFailure.StatusCode()),
+			domain:     constants.AwsLabel,
+			reason:     reqFailure.Code(),
+			statusCode: reqFailure.StatusCode(),
+			requestId:  reqFailure.RequestID(),
+		}
+	}
+
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		return providerError{code: awsCode(awsErr.Code(), 0), domain: constants.AwsLabel, reason: awsErr.Code()}
+	}
+
+	var reqErr *azure.RequestError
+	if errors.As(err, &reqErr) {
+		statusCode, _ := reqErr.StatusCode.(int)
+		pe := providerError{code: httpCode(statusCode), domain: constants.AzureLabel, statusCode: statusCode, requestId: reqErr.RequestID}
+		if reqErr.ServiceError != nil {
+			pe.code = azureCode(reqErr.ServiceError.Code, statusCode)
+			pe.reason = reqErr.ServiceError.Code
+		}
+		return pe
+	}
+
+	var detailedErr autorest.DetailedError
+	if errors.As(err, &detailedErr) {
+		statusCode, _ := detailedErr.StatusCode.(int)
+		return providerError{code: httpCode(statusCode), domain: constants.AzureLabel, statusCode: statusCode}
+	}
+
+	var apiErr *apierror.APIError
+	if errors.As(err, &apiErr) {
+		if st := apiErr.GRPCStatus(); st != nil {
+			return providerError{code: st.Code(), domain: constants.GcpLabel, reason: apiErr.Reason()}
+		}
+	}
+
+	var googleErr *googleapi.Error
+	if errors.As(err, &googleErr) {
+		pe := providerError{code: httpCode(googleErr.Code), domain: constants.GcpLabel, statusCode: googleErr.Code}
+		if len(googleErr.Errors) > 0 {
+			pe.reason = googleErr.Errors[0].Reason
+		}
+		return pe
+	}
+
+	//grpc status wrapped by spawner, gcp container api reports them
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) && grpcErr.GRPCStatus() != nil {
+		return providerError{code: grpcErr.GRPCStatus().Code(), domain: constants.GcpLabel}
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return providerError{code: codes.Canceled}
+	case errors.Is(err, context.DeadlineExceeded):
+		return providerError{code: codes.DeadlineExceeded}
+	}
+	return providerError{code: codes.Unknown}
+}
+
+//ToStatus translates the error returned by spawner and the providers to the canonical grpc status.
+//
+//Provider error code is reported in errdetails.ErrorInfo with provider as the domain, request id reported by the
+//provider is set in errdetails.RequestInfo. Errors which are already grpc status are returned as is.
+func ToStatus(err error) *status.Status {
+	if err == nil {
+		return nil
+	}
+	if st, ok := status.FromError(err); ok {
+		return st
+	}
+
+	pe := classify(err)
+	st := status.New(pe.code, err.Error())
+	if pe.domain == "" {
+		return st
+	}
+
+	info := &errdetails.ErrorInfo{Reason: pe.reason, Domain: pe.domain}
+	if pe.statusCode != 0 {
+		info.Metadata = map[string]string{"httpStatus": strconv.Itoa(pe.statusCode)}
+	}
+	if d, err := st.WithDetails(info); err == nil {
+		st = d
+	}
+
+	if pe.requestId != "" {
+		if d, err := st.WithDetails(&errdetails.RequestInfo{RequestId: pe.requestId}); err == nil {
+			st = d
+		}
+	}
+	return st
+}
+
+//Interceptor translates the errors returned by unary methods to grpc status
+func Interceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		res, err := handler(ctx, req)
+		if err != nil {
+			return nil, ToStatus(err).Err()
+		}
+		return res, nil
+	}
+}
+
+//StreamInterceptor translates the errors returned by streaming methods to grpc status
+func StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return ToStatus(err).Err()
+		}
+		return nil
+	}
+}
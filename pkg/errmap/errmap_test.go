@@ -0,0 +1,154 @@
+package errmap
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/netbook-devs/spawner-service/pkg/metrics"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/registry"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_ToStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		code   codes.Code
+		domain string
+		reason string
+	}{
+		{
+			name:   "aws request failure",
+			err:    errors.Wrap(awserr.NewRequestFailure(awserr.New("ResourceNotFoundException", "No cluster found", nil), http.StatusNotFound, "req-1"), "GetCluster"),
+			code:   codes.NotFound,
+			domain: "aws",
+			reason: "ResourceNotFoundException",
+		},
+		{
+			name:   "aws throttling",
+			err:    awserr.New("Throttling", "Rate exceeded", nil),
+			code:   codes.ResourceExhausted,
+			domain: "aws",
+			reason: "Throttling",
+		},
+		{
+			name:   "aws code falls back to http status",
+			err:    awserr.NewRequestFailure(awserr.New("SomethingNew", "", nil), http.StatusForbidden, ""),
+			code:   codes.PermissionDenied,
+			domain: "aws",
+			reason: "SomethingNew",
+		},
+		{
+			name: "azure service error",
+			err: autorest.DetailedError{
+				Original: &azure.RequestError{
+					DetailedError: autorest.DetailedError{StatusCode: http.StatusForbidden},
+					ServiceError:  &azure.ServiceError{Code: "AuthorizationFailed"},
+				},
+				StatusCode: http.StatusForbidden,
+			},
+			code:   codes.PermissionDenied,
+			domain: "azure",
+			reason: "AuthorizationFailed",
+		},
+		{
+			name:   "azure status code",
+			err:    errors.Wrap(autorest.DetailedError{StatusCode: http.StatusTooManyRequests}, "failed"),
+			code:   codes.ResourceExhausted,
+			domain: "azure",
+		},
+		{
+			name:   "googleapi error",
+			err:    errors.Wrap(&googleapi.Error{Code: http.StatusConflict, Errors: []googleapi.ErrorItem{{Reason: "alreadyExists"}}}, "create disk"),
+			code:   codes.AlreadyExists,
+			domain: "gcp",
+			reason: "alreadyExists",
+		},
+		{
+			name:   "spawner error",
+			err:    errors.Wrap(registry.ErrNotSupported, "azure"),
+			code:   codes.Unimplemented,
+			domain: "spawner",
+			reason: "NOT_SUPPORTED",
+		},
+		{
+			name: "context",
+			err:  errors.Wrap(context.DeadlineExceeded, "waiting"),
+			code: codes.DeadlineExceeded,
+		},
+		{
+			name: "unknown",
+			err:  errors.New("boom"),
+			code: codes.Unknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := ToStatus(tt.err)
+			assert.Equal(t, tt.code, st.Code())
+			assert.Equal(t, tt.err.Error(), st.Message())
+
+			var info *errdetails.ErrorInfo
+			for _, d := range st.Details() {
+				if i, ok := d.(*errdetails.ErrorInfo); ok {
+					info = i
+				}
+			}
+			if tt.domain == "" {
+				assert.Nil(t, info)
+				return
+			}
+			assert.NotNil(t, info)
+			assert.Equal(t, tt.domain, info.Domain)
+			assert.Equal(t, tt.reason, info.Reason)
+		})
+	}
+}
+
+func Test_ToStatusKeepsStatus(t *testing.T) {
+	err := status.Error(codes.InvalidArgument, "bad request")
+	assert.Equal(t, codes.InvalidArgument, ToStatus(err).Code())
+	assert.Nil(t, ToStatus(nil))
+}
+
+func Test_metricsCode(t *testing.T) {
+	metrics.SetRegions(map[string][]string{"aws": {"us-west-2"}})
+	defer metrics.SetRegions(nil)
+	in := metrics.RPCInstrumentation(ToStatus)
+	info := &grpc.UnaryServerInfo{FullMethod: "/spawner.SpawnerService/ClusterStatus"}
+
+	//raw provider error as returned by the controller, before it is translated by the interceptor
+	_, _ = in(context.Background(), &proto.ClusterStatusRequest{Provider: "aws", Region: "us-west-2"}, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, awserr.New("ResourceNotFoundException", "No cluster found for name: c1.", nil)
+	})
+
+	families, err := prometheus.DefaultGatherer.Gather()
+	assert.Nil(t, err)
+	seen := map[string]bool{}
+	for _, f := range families {
+		if f.GetName() != "grpc_request_errors_total" {
+			continue
+		}
+		for _, m := range f.Metric {
+			for _, l := range m.Label {
+				if l.GetName() == "code" {
+					seen[l.GetValue()] = true
+				}
+			}
+		}
+	}
+	assert.Equal(t, map[string]bool{"NotFound": true}, seen, "provider error must be counted with its canonical code")
+}
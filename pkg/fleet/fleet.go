@@ -0,0 +1,231 @@
+package fleet
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/netbookai/log"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/registry"
+)
+
+//Target account of the provider in the region scanned by the collector
+type Target struct {
+	Provider string
+	Account  string
+	Region   string
+}
+
+func (t Target) String() string {
+	return t.Provider + ":" + t.Account + ":" + t.Region
+}
+
+//ParseTargets parses comma separated 'provider:account:region' list
+func ParseTargets(s string) ([]Target, error) {
+	targets := []Target{}
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.Split(entry, ":")
+		if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+			return nil, fmt.Errorf("invalid fleet target '%s', must be 'provider:account:region'", entry)
+		}
+		targets = append(targets, Target{Provider: parts[0], Account: parts[1], Region: parts[2]})
+	}
+	return targets, nil
+}
+
+//Source lists the targets to scan and their resources
+type Source interface {
+	FleetTargets(ctx context.Context) ([]Target, error)
+	ListFleet(ctx context.Context, target Target) (*registry.Fleet, error)
+}
+
+var (
+	targetLabels    = []string{"provider", "account", "region"}
+	nodeGroupLabels = append(targetLabels, "cluster", "nodegroup", "instance", "gpu")
+
+	clustersDesc = prometheus.NewDesc("spawner_fleet_clusters",
+		"Number of spawner clusters by status",
+		append(targetLabels, "status"), nil)
+	desiredNodesDesc = prometheus.NewDesc("spawner_fleet_nodegroup_desired_nodes",
+		"Number of nodes the node group is scaled to",
+		nodeGroupLabels, nil)
+	nodesDesc = prometheus.NewDesc("spawner_fleet_nodegroup_nodes",
+		"Number of nodes running in the node group",
+		nodeGroupLabels, nil)
+	issuesDesc = prometheus.NewDesc("spawner_fleet_nodegroup_issues",
+		"Number of health issues reported for the node group",
+		append(targetLabels, "cluster", "nodegroup", "status"), nil)
+	volumeDesc = prometheus.NewDesc("spawner_fleet_volume_created_timestamp_seconds",
+		"Creation time of the spawner volume",
+		append(targetLabels, "volume", "attached"), nil)
+	volumeSizeDesc = prometheus.NewDesc("spawner_fleet_volume_size_bytes",
+		"Size of the spawner volume",
+		append(targetLabels, "volume", "attached"), nil)
+	snapshotDesc = prometheus.NewDesc("spawner_fleet_snapshot_created_timestamp_seconds",
+		"Creation time of the spawner snapshot",
+		append(targetLabels, "snapshot"), nil)
+	successDesc = prometheus.NewDesc("spawner_fleet_collection_success",
+		"1 when the last listing of the target succeeded, the gauges of a failed target report the previous listing",
+		targetLabels, nil)
+	lastSuccessDesc = prometheus.NewDesc("spawner_fleet_last_success_timestamp_seconds",
+		"Time of the last successful listing of the target",
+		targetLabels, nil)
+)
+
+const gb = 1 << 30
+
+type result struct {
+	fleet       *registry.Fleet
+	success     bool
+	lastSuccess time.Time
+}
+
+//Collector lists the fleet of each target every interval and exports the last listing as prometheus gauges.
+//
+//Cloud apis are not called on scrape, listing all the accounts takes longer than the scrape timeout.
+type Collector struct {
+	logger   log.Logger
+	interval time.Duration
+	source   Source
+
+	mu      sync.RWMutex
+	results map[Target]*result
+}
+
+//New creates collector, it must be registered with prometheus and Run for the gauges to be exported
+func New(logger log.Logger, interval time.Duration, source Source) *Collector {
+	return &Collector{
+		logger:   logger,
+		interval: interval,
+		source:   source,
+		results:  map[Target]*result{},
+	}
+}
+
+//CollectNow lists the fleet of all the targets, a target which fails keeps its previous listing
+func (c *Collector) CollectNow(ctx context.Context) {
+	targets, err := c.source.FleetTargets(ctx)
+	if err != nil {
+		c.logger.Error(ctx, "failed to get fleet targets", "error", err)
+		return
+	}
+
+	results := make(map[Target]*result, len(targets))
+	for _, t := range targets {
+		fleet, err := c.source.ListFleet(ctx, t)
+		if errors.Is(err, registry.ErrNotSupported) {
+			continue
+		}
+
+		c.mu.RLock()
+		prev, ok := c.results[t]
+		c.mu.RUnlock()
+		r := &result{}
+		if ok {
+			*r = *prev
+		}
+
+		if err != nil {
+			c.logger.Warn(ctx, "failed to list fleet", "target", t.String(), "error", err)
+			r.success = false
+		} else {
+			r.fleet, r.success, r.lastSuccess = fleet, true, time.Now()
+		}
+		results[t] = r
+	}
+
+	c.mu.Lock()
+	c.results = results
+	c.mu.Unlock()
+}
+
+//Run collects the fleet every interval till ctx is cancelled
+func (c *Collector) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		c.CollectNow(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+//Describe implements prometheus.Collector
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	for _, d := range []*prometheus.Desc{clustersDesc, desiredNodesDesc, nodesDesc, issuesDesc, volumeDesc,
+		volumeSizeDesc, snapshotDesc, successDesc, lastSuccessDesc} {
+		ch <- d
+	}
+}
+
+//Collect implements prometheus.Collector, reports the last listing of each target
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for t, r := range c.results {
+		tl := []string{t.Provider, t.Account, t.Region}
+		ch <- gauge(successDesc, boolValue(r.success), tl)
+		if r.fleet == nil {
+			continue
+		}
+		ch <- gauge(lastSuccessDesc, float64(r.lastSuccess.Unix()), tl)
+
+		status := map[string]int{}
+		for _, cl := range r.fleet.Clusters {
+			status[cl.Status]++
+			for _, ng := range cl.NodeGroups {
+				ngl := append(tl, cl.Name, ng.Name, ng.Instance, strconv.FormatBool(ng.GPU))
+				ch <- gauge(desiredNodesDesc, float64(ng.Desired), ngl)
+				ch <- gauge(nodesDesc, float64(ng.Nodes), ngl)
+				ch <- gauge(issuesDesc, float64(ng.Issues), append(tl, cl.Name, ng.Name, ng.Status))
+			}
+		}
+		for _, s := range sortedKeys(status) {
+			ch <- gauge(clustersDesc, float64(status[s]), append(tl, s))
+		}
+
+		for _, v := range r.fleet.Volumes {
+			vl := append(tl, v.ID, strconv.FormatBool(v.Attached))
+			ch <- gauge(volumeDesc, float64(v.CreatedAt.Unix()), vl)
+			ch <- gauge(volumeSizeDesc, float64(v.SizeGB*gb), vl)
+		}
+		for _, s := range r.fleet.Snapshots {
+			ch <- gauge(snapshotDesc, float64(s.CreatedAt.Unix()), append(tl, s.ID))
+		}
+	}
+}
+
+//gauge copies the label values, callers append to the shared target labels
+func gauge(desc *prometheus.Desc, v float64, labels []string) prometheus.Metric {
+	return prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, v, append([]string{}, labels...)...)
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
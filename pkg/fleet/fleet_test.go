@@ -0,0 +1,103 @@
+package fleet
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/netbookai/log"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/registry"
+)
+
+type stubSource struct {
+	fleets map[Target]*registry.Fleet
+	errs   map[Target]error
+}
+
+func (s *stubSource) FleetTargets(ctx context.Context) ([]Target, error) {
+	targets := []Target{}
+	for t := range s.fleets {
+		targets = append(targets, t)
+	}
+	for t := range s.errs {
+		targets = append(targets, t)
+	}
+	return targets, nil
+}
+
+func (s *stubSource) ListFleet(ctx context.Context, t Target) (*registry.Fleet, error) {
+	if err, ok := s.errs[t]; ok {
+		return nil, err
+	}
+	return s.fleets[t], nil
+}
+
+func Test_ParseTargets(t *testing.T) {
+	targets, err := ParseTargets(" aws:team-a:us-west-2, ,azure:team-b:eastus")
+	assert.Nil(t, err)
+	assert.Equal(t, []Target{{"aws", "team-a", "us-west-2"}, {"azure", "team-b", "eastus"}}, targets)
+
+	_, err = ParseTargets("aws:team-a")
+	assert.NotNil(t, err)
+}
+
+func Test_Collector(t *testing.T) {
+	created := time.Unix(1650000000, 0)
+	aws := Target{"aws", "team-a", "us-west-2"}
+	gcp := Target{"gcp", "team-a", "us-central1"}
+	source := &stubSource{
+		fleets: map[Target]*registry.Fleet{
+			aws: {
+				Clusters: []registry.FleetCluster{{
+					Name:   "c1",
+					Status: "active",
+					NodeGroups: []registry.FleetNodeGroup{
+						{Name: "gpu", Status: "degraded", Instance: "p3.xlarge", GPU: true, Desired: 3, Nodes: 2, Issues: 1},
+					},
+				}},
+				Volumes:   []registry.FleetVolume{{ID: "vol-1", SizeGB: 1, CreatedAt: created}},
+				Snapshots: []registry.FleetSnapshot{{ID: "snap-1", SizeGB: 1, CreatedAt: created}},
+			},
+		},
+		errs: map[Target]error{gcp: registry.ErrNotSupported},
+	}
+
+	c := New(log.GetLogger(), time.Minute, source)
+	c.CollectNow(context.Background())
+
+	expected := `
+# HELP spawner_fleet_clusters Number of spawner clusters by status
+# TYPE spawner_fleet_clusters gauge
+spawner_fleet_clusters{account="team-a",provider="aws",region="us-west-2",status="active"} 1
+# HELP spawner_fleet_nodegroup_issues Number of health issues reported for the node group
+# TYPE spawner_fleet_nodegroup_issues gauge
+spawner_fleet_nodegroup_issues{account="team-a",cluster="c1",nodegroup="gpu",provider="aws",region="us-west-2",status="degraded"} 1
+# HELP spawner_fleet_nodegroup_nodes Number of nodes running in the node group
+# TYPE spawner_fleet_nodegroup_nodes gauge
+spawner_fleet_nodegroup_nodes{account="team-a",cluster="c1",gpu="true",instance="p3.xlarge",nodegroup="gpu",provider="aws",region="us-west-2"} 2
+# HELP spawner_fleet_volume_created_timestamp_seconds Creation time of the spawner volume
+# TYPE spawner_fleet_volume_created_timestamp_seconds gauge
+spawner_fleet_volume_created_timestamp_seconds{account="team-a",attached="false",provider="aws",region="us-west-2",volume="vol-1"} 1.65e+09
+# HELP spawner_fleet_collection_success 1 when the last listing of the target succeeded, the gauges of a failed target report the previous listing
+# TYPE spawner_fleet_collection_success gauge
+spawner_fleet_collection_success{account="team-a",provider="aws",region="us-west-2"} 1
+`
+	names := []string{"spawner_fleet_clusters", "spawner_fleet_nodegroup_issues", "spawner_fleet_nodegroup_nodes",
+		"spawner_fleet_volume_created_timestamp_seconds", "spawner_fleet_collection_success"}
+	assert.Nil(t, testutil.CollectAndCompare(c, strings.NewReader(expected), names...))
+
+	//failed listing keeps reporting the previous one
+	source.errs[aws] = errors.New("throttled")
+	c.CollectNow(context.Background())
+	failed := `
+# HELP spawner_fleet_collection_success 1 when the last listing of the target succeeded, the gauges of a failed target report the previous listing
+# TYPE spawner_fleet_collection_success gauge
+spawner_fleet_collection_success{account="team-a",provider="aws",region="us-west-2"} 0
+`
+	assert.Nil(t, testutil.CollectAndCompare(c, strings.NewReader(failed), "spawner_fleet_collection_success"))
+	assert.Equal(t, 1, testutil.CollectAndCount(c, "spawner_fleet_volume_created_timestamp_seconds"))
+}
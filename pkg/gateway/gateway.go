@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"gitlab.com/netbook-devs/spawner-service/pkg/service"
+	"gitlab.com/netbook-devs/spawner-service/pkg/tracing"
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 )
 
@@ -13,6 +14,16 @@ type gateway struct {
 	proto.UnimplementedSpawnerServiceServer
 }
 
+//watchStream carries the context of the gateway span to the service
+type watchStream struct {
+	proto.SpawnerService_WatchClusterStatusServer
+	ctx context.Context
+}
+
+func (s *watchStream) Context() context.Context {
+	return s.ctx
+}
+
 func New(s service.SpawnerService) proto.SpawnerServiceServer {
 	return &gateway{
 		service: s,
@@ -32,150 +43,303 @@ func (g *gateway) Echo(ctx context.Context, req *proto.EchoRequest) (*proto.Echo
 
 //CreateCluster Spawn required cluster
 func (g *gateway) CreateCluster(ctx context.Context, req *proto.ClusterRequest) (*proto.ClusterResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.CreateCluster")
+	defer span.End()
 	return g.service.CreateCluster(ctx, req)
 }
 
 //AddToken Create add token to secret manager --deprecated
 func (g *gateway) AddToken(ctx context.Context, req *proto.AddTokenRequest) (*proto.AddTokenResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.AddToken")
+	defer span.End()
 	return g.service.AddToken(ctx, req)
 }
 
 // GetToken Get kubernetes token
 func (g *gateway) GetToken(ctx context.Context, req *proto.GetTokenRequest) (*proto.GetTokenResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.GetToken")
+	defer span.End()
 	return g.service.GetToken(ctx, req)
 }
 
 //AddRoute53Record Add Route53 record for Caddy
 func (g *gateway) AddRoute53Record(ctx context.Context, req *proto.AddRoute53RecordRequest) (*proto.AddRoute53RecordResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.AddRoute53Record")
+	defer span.End()
 	return g.service.AddRoute53Record(ctx, req)
 }
 
 // GetCluster describe given cluster
 func (g *gateway) GetCluster(ctx context.Context, req *proto.GetClusterRequest) (*proto.ClusterSpec, error) {
+	ctx, span := tracing.Start(ctx, "gateway.GetCluster")
+	defer span.End()
 	return g.service.GetCluster(ctx, req)
 }
 
 // GetClusters Retrieve all clusters in the user account
 func (g *gateway) GetClusters(ctx context.Context, req *proto.GetClustersRequest) (*proto.GetClustersResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.GetClusters")
+	defer span.End()
 	return g.service.GetClusters(ctx, req)
 }
 
 //AddNode add node to the cluster
 func (g *gateway) AddNode(ctx context.Context, req *proto.NodeSpawnRequest) (*proto.NodeSpawnResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.AddNode")
+	defer span.End()
 	return g.service.AddNode(ctx, req)
 }
 
 // ClusterStatus retrieve cluster status such as 'ACTIVE', 'CREATING', 'DELETING'
 func (g *gateway) ClusterStatus(ctx context.Context, req *proto.ClusterStatusRequest) (*proto.ClusterStatusResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.ClusterStatus")
+	defer span.End()
 	return g.service.ClusterStatus(ctx, req)
 }
 
 // DeleteCluster delete the given cluster
 func (g *gateway) DeleteCluster(ctx context.Context, req *proto.ClusterDeleteRequest) (*proto.ClusterDeleteResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.DeleteCluster")
+	defer span.End()
 	return g.service.DeleteCluster(ctx, req)
 }
 
 // DeleteNode delete attached node
 func (g *gateway) DeleteNode(ctx context.Context, req *proto.NodeDeleteRequest) (*proto.NodeDeleteResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.DeleteNode")
+	defer span.End()
 	return g.service.DeleteNode(ctx, req)
 }
 
 // CreateVolume create a volume on the provider
 func (g *gateway) CreateVolume(ctx context.Context, req *proto.CreateVolumeRequest) (*proto.CreateVolumeResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.CreateVolume")
+	defer span.End()
 	return g.service.CreateVolume(ctx, req)
 }
 
 // DeleteVolume deletes volumes
 func (g *gateway) DeleteVolume(ctx context.Context, req *proto.DeleteVolumeRequest) (*proto.DeleteVolumeResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.DeleteVolume")
+	defer span.End()
 	return g.service.DeleteVolume(ctx, req)
 }
 
 //CreateSnapshot create snapshot of backing volume
 func (g *gateway) CreateSnapshot(ctx context.Context, req *proto.CreateSnapshotRequest) (*proto.CreateSnapshotResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.CreateSnapshot")
+	defer span.End()
 	return g.service.CreateSnapshot(ctx, req)
 }
 
 //CreateSnapshotAndDelete create a snapshot of backing volume and delete the volume
 func (g *gateway) CreateSnapshotAndDelete(ctx context.Context, req *proto.CreateSnapshotAndDeleteRequest) (*proto.CreateSnapshotAndDeleteResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.CreateSnapshotAndDelete")
+	defer span.End()
 	return g.service.CreateSnapshotAndDelete(ctx, req)
 }
 
 //RegisterWithRancher register the cluster with rancher, provides monitoring dashboard for the cluster
 func (g *gateway) RegisterWithRancher(ctx context.Context, req *proto.RancherRegistrationRequest) (*proto.RancherRegistrationResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.RegisterWithRancher")
+	defer span.End()
 	return g.service.RegisterWithRancher(ctx, req)
 }
 
 //GetWorkspacesCost
 func (g *gateway) GetWorkspacesCost(ctx context.Context, req *proto.GetWorkspacesCostRequest) (*proto.GetWorkspacesCostResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.GetWorkspacesCost")
+	defer span.End()
 	return g.service.GetWorkspacesCost(ctx, req)
 }
 
 //GetApplicationsCost
 func (g *gateway) GetApplicationsCost(ctx context.Context, req *proto.GetApplicationsCostRequest) (*proto.GetApplicationsCostResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.GetApplicationsCost")
+	defer span.End()
 	return g.service.GetApplicationsCost(ctx, req)
 }
 
 //WriteCredential save user account credential
 func (g *gateway) WriteCredential(ctx context.Context, req *proto.WriteCredentialRequest) (*proto.WriteCredentialResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.WriteCredential")
+	defer span.End()
 	return g.service.WriteCredential(ctx, req)
 }
 
 //ReadCredential read user credential
 func (g *gateway) ReadCredential(ctx context.Context, req *proto.ReadCredentialRequest) (*proto.ReadCredentialResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.ReadCredential")
+	defer span.End()
 	return g.service.ReadCredential(ctx, req)
 }
 
+//RevealCredential read user credential along with the secrets
+func (g *gateway) RevealCredential(ctx context.Context, req *proto.RevealCredentialRequest) (*proto.ReadCredentialResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.RevealCredential")
+	defer span.End()
+	return g.service.RevealCredential(ctx, req)
+}
+
+//ListCredentials list stored credentials without the secrets
+func (g *gateway) ListCredentials(ctx context.Context, req *proto.ListCredentialsRequest) (*proto.ListCredentialsResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.ListCredentials")
+	defer span.End()
+	return g.service.ListCredentials(ctx, req)
+}
+
+//DeleteCredential delete user account credential
+func (g *gateway) DeleteCredential(ctx context.Context, req *proto.DeleteCredentialRequest) (*proto.DeleteCredentialResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.DeleteCredential")
+	defer span.End()
+	return g.service.DeleteCredential(ctx, req)
+}
+
+//RotateCredential stage or confirm the rotation of user account credential
+func (g *gateway) RotateCredential(ctx context.Context, req *proto.RotateCredentialRequest) (*proto.RotateCredentialResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.RotateCredential")
+	defer span.End()
+	return g.service.RotateCredential(ctx, req)
+}
+
+//VerifyCredential check stored user account credential with the provider
+func (g *gateway) VerifyCredential(ctx context.Context, req *proto.VerifyCredentialRequest) (*proto.VerifyCredentialResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.VerifyCredential")
+	defer span.End()
+	return g.service.VerifyCredential(ctx, req)
+}
+
+//ListCredentialVersions list versions of user account credential
+func (g *gateway) ListCredentialVersions(ctx context.Context, req *proto.ListCredentialVersionsRequest) (*proto.ListCredentialVersionsResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.ListCredentialVersions")
+	defer span.End()
+	return g.service.ListCredentialVersions(ctx, req)
+}
+
 //GetKubeConfig retrieve kube config for the cluster
 func (g *gateway) GetKubeConfig(ctx context.Context, req *proto.GetKubeConfigRequest) (*proto.GetKubeConfigResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.GetKubeConfig")
+	defer span.End()
 	return g.service.GetKubeConfig(ctx, req)
 }
 
 //TagNodeInstance tag underlying vm instances for cluster nodes
 func (g *gateway) TagNodeInstance(ctx context.Context, req *proto.TagNodeInstanceRequest) (*proto.TagNodeInstanceResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.TagNodeInstance")
+	defer span.End()
 	return g.service.TagNodeInstance(ctx, req)
 }
 
 //GetCostByTime
 func (g *gateway) GetCostByTime(ctx context.Context, req *proto.GetCostByTimeRequest) (*proto.GetCostByTimeResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.GetCostByTime")
+	defer span.End()
 	return g.service.GetCostByTime(ctx, req)
 }
 
 func (g *gateway) GetContainerRegistryAuth(ctx context.Context, in *proto.GetContainerRegistryAuthRequest) (*proto.GetContainerRegistryAuthResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.GetContainerRegistryAuth")
+	defer span.End()
 	return g.service.GetContainerRegistryAuth(ctx, in)
 }
 
 func (g *gateway) CreateContainerRegistryRepo(ctx context.Context, in *proto.CreateContainerRegistryRepoRequest) (*proto.CreateContainerRegistryRepoResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.CreateContainerRegistryRepo")
+	defer span.End()
 	return g.service.CreateContainerRegistryRepo(ctx, in)
 }
 
 func (g *gateway) DeleteSnapshot(ctx context.Context, req *proto.DeleteSnapshotRequest) (*proto.DeleteSnapshotResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.DeleteSnapshot")
+	defer span.End()
 	return g.service.DeleteSnapshot(ctx, req)
 }
 
 func (g *gateway) RegisterClusterOIDC(ctx context.Context, in *proto.RegisterClusterOIDCRequest) (*proto.RegisterClusterOIDCResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.RegisterClusterOIDC")
+	defer span.End()
 	return g.service.RegisterClusterOIDC(ctx, in)
 }
 
 //GetRoute53TXTRecords Get Route53 record for Caddy
 func (g *gateway) GetRoute53TXTRecords(ctx context.Context, req *proto.GetRoute53TXTRecordsRequest) (*proto.GetRoute53TXTRecordsResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.GetRoute53TXTRecords")
+	defer span.End()
 	return g.service.GetRoute53TXTRecords(ctx, req)
 }
 
 //CreateRoute53Records append Route53 record for Caddy
 func (g *gateway) CreateRoute53Records(ctx context.Context, req *proto.CreateRoute53RecordsRequest) (*proto.CreateRoute53RecordsResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.CreateRoute53Records")
+	defer span.End()
 	return g.service.CreateRoute53Records(ctx, req)
 }
 
 //DeleteRoute53Records append Route53 record for Caddy
 func (g *gateway) DeleteRoute53Records(ctx context.Context, req *proto.DeleteRoute53RecordsRequest) (*proto.DeleteRoute53RecordsResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.DeleteRoute53Records")
+	defer span.End()
 	return g.service.DeleteRoute53Records(ctx, req)
 }
 
 //CopySnapshot copy snapshot and return the new snapshot id
 func (g *gateway) CopySnapshot(ctx context.Context, in *proto.CopySnapshotRequest) (*proto.CopySnapshotResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.CopySnapshot")
+	defer span.End()
 	return g.service.CopySnapshot(ctx, in)
 }
 
 func (g *gateway) PresignS3Url(ctx context.Context, in *proto.PresignS3UrlRequest) (*proto.PresignS3UrlResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.PresignS3Url")
+	defer span.End()
 	return g.service.PresignS3Url(ctx, in)
 }
+
+//GetOperation retrieve long running operation started by the mutations
+func (g *gateway) GetOperation(ctx context.Context, req *proto.GetOperationRequest) (*proto.Operation, error) {
+	ctx, span := tracing.Start(ctx, "gateway.GetOperation")
+	defer span.End()
+	return g.service.GetOperation(ctx, req)
+}
+
+//ListOperations list long running operations
+func (g *gateway) ListOperations(ctx context.Context, req *proto.ListOperationsRequest) (*proto.ListOperationsResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.ListOperations")
+	defer span.End()
+	return g.service.ListOperations(ctx, req)
+}
+
+//CancelOperation cancel running operation
+func (g *gateway) CancelOperation(ctx context.Context, req *proto.CancelOperationRequest) (*proto.Operation, error) {
+	ctx, span := tracing.Start(ctx, "gateway.CancelOperation")
+	defer span.End()
+	return g.service.CancelOperation(ctx, req)
+}
+
+//WatchClusterStatus stream cluster status changes
+func (g *gateway) WatchClusterStatus(req *proto.WatchClusterStatusRequest, stream proto.SpawnerService_WatchClusterStatusServer) error {
+	ctx, span := tracing.Start(stream.Context(), "gateway.WatchClusterStatus")
+	defer span.End()
+	return g.service.WatchClusterStatus(req, &watchStream{SpawnerService_WatchClusterStatusServer: stream, ctx: ctx})
+}
+
+//ListResources list resources provisioned by spawner
+func (g *gateway) ListResources(ctx context.Context, req *proto.ListResourcesRequest) (*proto.ListResourcesResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.ListResources")
+	defer span.End()
+	return g.service.ListResources(ctx, req)
+}
+
+//GetResource get resource from inventory
+func (g *gateway) GetResource(ctx context.Context, req *proto.GetResourceRequest) (*proto.Resource, error) {
+	ctx, span := tracing.Start(ctx, "gateway.GetResource")
+	defer span.End()
+	return g.service.GetResource(ctx, req)
+}
+
+//ListProviders list registered providers and their capabilities
+func (g *gateway) ListProviders(ctx context.Context, req *proto.ListProvidersRequest) (*proto.ListProvidersResponse, error) {
+	ctx, span := tracing.Start(ctx, "gateway.ListProviders")
+	defer span.End()
+	return g.service.ListProviders(ctx, req)
+}
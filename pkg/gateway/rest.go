@@ -0,0 +1,44 @@
+package gateway
+
+import (
+	"context"
+	"net/textproto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+//forwardedHeaders http headers passed to the grpc server as metadata, authorization is forwarded by default
+var forwardedHeaders = map[string]string{
+	"Traceparent":     "traceparent",
+	"Tracestate":      "tracestate",
+	"Baggage":         "baggage",
+	"Idempotency-Key": "idempotency-key",
+}
+
+func headerMatcher(key string) (string, bool) {
+	if md, ok := forwardedHeaders[textproto.CanonicalMIMEHeaderKey(key)]; ok {
+		return md, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+//NewRESTHandler serves the SpawnerService api as REST/JSON, requests are proxied to the grpc server listening on
+//address, so they go through the same authentication, audit and limits as the grpc calls
+func NewRESTHandler(ctx context.Context, address string) (*runtime.ServeMux, error) {
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(headerMatcher),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions:   protojson.MarshalOptions{EmitUnpopulated: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		}),
+	)
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if err := proto.RegisterSpawnerServiceHandlerFromEndpoint(ctx, mux, address, opts); err != nil {
+		return nil, err
+	}
+	return mux, nil
+}
@@ -0,0 +1,75 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type stubService struct {
+	service.SpawnerService
+	req *proto.GetClustersRequest
+	md  metadata.MD
+}
+
+func (s *stubService) GetClusters(ctx context.Context, req *proto.GetClustersRequest) (*proto.GetClustersResponse, error) {
+	s.req = req
+	s.md, _ = metadata.FromIncomingContext(ctx)
+	return &proto.GetClustersResponse{Clusters: []*proto.ClusterSpec{{Name: "c1"}}}, nil
+}
+
+func (s *stubService) GetOperation(ctx context.Context, req *proto.GetOperationRequest) (*proto.Operation, error) {
+	return nil, status.Errorf(codes.NotFound, "operation '%s' not found", req.Id)
+}
+
+func Test_RESTHandler(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	stub := &stubService{}
+	server := grpc.NewServer()
+	proto.RegisterSpawnerServiceServer(server, New(stub))
+	go server.Serve(lis)
+	defer server.Stop()
+
+	rest, err := NewRESTHandler(context.Background(), lis.Addr().String())
+	assert.Nil(t, err)
+	web := httptest.NewServer(rest)
+	defer web.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, web.URL+"/v1/providers/aws/clusters?region=us-west-2&accountName=team-a", nil)
+	req.Header.Set("Authorization", "Bearer t0ken")
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	res, err := http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	body, _ := ioutil.ReadAll(res.Body)
+	out := map[string][]map[string]interface{}{}
+	assert.Nil(t, json.Unmarshal(body, &out))
+	assert.Equal(t, "c1", out["clusters"][0]["name"])
+
+	assert.Equal(t, "aws", stub.req.Provider)
+	assert.Equal(t, "us-west-2", stub.req.Region)
+	assert.Equal(t, "team-a", stub.req.AccountName)
+	assert.Equal(t, []string{"Bearer t0ken"}, stub.md.Get("authorization"))
+	assert.Equal(t, 1, len(stub.md.Get("traceparent")), "trace context must be propagated")
+
+	res, err = http.Get(web.URL + "/v1/operations/op-1")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	body, _ = ioutil.ReadAll(res.Body)
+	assert.True(t, strings.Contains(string(body), "operation 'op-1' not found"))
+}
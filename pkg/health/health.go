@@ -0,0 +1,196 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/netbookai/log"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+//Check returns error when the dependency is not usable
+type Check func(ctx context.Context) error
+
+//Result outcome of the check
+type Result struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+const (
+	StatusOK    = "ok"
+	StatusError = "error"
+	//StatusPending set till the checks run for the first time
+	StatusPending = "pending"
+)
+
+//Checker runs the readiness checks in background and reports the outcome on the grpc health service
+//and the http probes.
+//
+//Checks are run periodically instead of on every probe, dependencies such as the cloud providers are
+//not called for each request of the kubelet.
+type Checker struct {
+	logger   log.Logger
+	interval time.Duration
+	timeout  time.Duration
+	services []string
+	server   *health.Server
+
+	mu      sync.RWMutex
+	checks  map[string]Check
+	results map[string]Result
+	ready   bool
+	closed  bool
+}
+
+//New creates checker, services are the grpc service names reported on the health service along with
+//the overall server status ""
+func New(logger log.Logger, interval, timeout time.Duration, services ...string) *Checker {
+	c := &Checker{
+		logger:   logger,
+		interval: interval,
+		timeout:  timeout,
+		services: append([]string{""}, services...),
+		server:   health.NewServer(),
+		checks:   map[string]Check{},
+		results:  map[string]Result{},
+	}
+	c.setServingStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	return c
+}
+
+//Add registers the named check, must be called before Run
+func (c *Checker) Add(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks[name] = check
+	c.results[name] = Result{Status: StatusPending}
+}
+
+//Server grpc.health.v1 service reporting readiness
+func (c *Checker) Server() healthpb.HealthServer {
+	return c.server
+}
+
+func (c *Checker) setServingStatus(status healthpb.HealthCheckResponse_ServingStatus) {
+	for _, s := range c.services {
+		c.server.SetServingStatus(s, status)
+	}
+}
+
+//CheckNow runs all the checks concurrently and updates the readiness
+func (c *Checker) CheckNow(ctx context.Context) {
+	c.mu.RLock()
+	checks := make(map[string]Check, len(c.checks))
+	for name, check := range c.checks {
+		checks[name] = check
+	}
+	c.mu.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	results := make(map[string]Result, len(checks))
+	for name, check := range checks {
+		wg.Add(1)
+		go func(name string, check Check) {
+			defer wg.Done()
+			r := Result{Status: StatusOK}
+			if err := check(ctx); err != nil {
+				r = Result{Status: StatusError, Error: err.Error()}
+				c.logger.Warn(ctx, "readiness check failed", "check", name, "error", err)
+			}
+			mu.Lock()
+			results[name] = r
+			mu.Unlock()
+		}(name, check)
+	}
+	wg.Wait()
+
+	ready := true
+	for _, r := range results {
+		if r.Status != StatusOK {
+			ready = false
+		}
+	}
+
+	c.mu.Lock()
+	c.results = results
+	c.ready = ready
+	c.mu.Unlock()
+
+	if ready {
+		c.setServingStatus(healthpb.HealthCheckResponse_SERVING)
+	} else {
+		c.setServingStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+}
+
+//Run checks the dependencies every interval till ctx is cancelled
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		c.CheckNow(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+//Shutdown reports the services as not serving, new calls are not routed to the server while it drains
+func (c *Checker) Shutdown() {
+	c.mu.Lock()
+	c.closed = true
+	c.mu.Unlock()
+	c.server.Shutdown()
+}
+
+//Ready returns the readiness and the result of each check
+func (c *Checker) Ready() (bool, map[string]Result) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	results := make(map[string]Result, len(c.results))
+	for name, r := range c.results {
+		results[name] = r
+	}
+	return c.ready && !c.closed, results
+}
+
+type readiness struct {
+	Status string            `json:"status"`
+	Checks map[string]Result `json:"checks"`
+}
+
+//Readyz responds 200 when all the checks passed, 503 otherwise, along with the result of each check
+func (c *Checker) Readyz() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ready, results := c.Ready()
+		res := readiness{Status: StatusOK, Checks: results}
+		code := http.StatusOK
+		if !ready {
+			res.Status = StatusError
+			code = http.StatusServiceUnavailable
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		_ = json.NewEncoder(w).Encode(res)
+	})
+}
+
+//Healthz liveness probe, responds 200 as long as the process serves http
+func Healthz() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte(StatusOK))
+	})
+}
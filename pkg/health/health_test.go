@@ -0,0 +1,63 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/netbookai/log"
+	"github.com/stretchr/testify/assert"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func servingStatus(t *testing.T, c *Checker, service string) healthpb.HealthCheckResponse_ServingStatus {
+	res, err := c.Server().Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	assert.Nil(t, err)
+	return res.Status
+}
+
+func readyz(t *testing.T, c *Checker) (int, readiness) {
+	rec := httptest.NewRecorder()
+	c.Readyz().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	res := readiness{}
+	assert.Nil(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	return rec.Code, res
+}
+
+func Test_Checker(t *testing.T) {
+	c := New(log.GetLogger(), time.Minute, time.Second, "spawner.SpawnerService")
+
+	var providerErr error
+	c.Add("config", func(ctx context.Context) error { return nil })
+	c.Add("provider-aws", func(ctx context.Context) error { return providerErr })
+
+	code, res := readyz(t, c)
+	assert.Equal(t, http.StatusServiceUnavailable, code, "must not be ready before the checks run")
+	assert.Equal(t, StatusPending, res.Checks["config"].Status)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, c, ""))
+
+	c.CheckNow(context.Background())
+	code, _ = readyz(t, c)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, c, ""))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, c, "spawner.SpawnerService"))
+
+	providerErr = errors.New("sts.amazonaws.com is not reachable")
+	c.CheckNow(context.Background())
+	code, res = readyz(t, c)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, Result{Status: StatusError, Error: providerErr.Error()}, res.Checks["provider-aws"])
+	assert.Equal(t, StatusOK, res.Checks["config"].Status)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, c, "spawner.SpawnerService"))
+
+	providerErr = nil
+	c.CheckNow(context.Background())
+	c.Shutdown()
+	code, _ = readyz(t, c)
+	assert.Equal(t, http.StatusServiceUnavailable, code, "must not be ready once shutting down")
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, c, ""))
+}
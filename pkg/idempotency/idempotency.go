@@ -0,0 +1,199 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"strings"
+	"sync"
+	"time"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	gproto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	//MetadataKey grpc metadata carrying the idempotency key, used when request does not set one
+	MetadataKey = "idempotency-key"
+
+	//keyField request field carrying the idempotency key
+	keyField protoreflect.Name = "idempotencyKey"
+)
+
+type entry struct {
+	fingerprint [sha256.Size]byte
+	//done is closed once the first call completes
+	done    chan struct{}
+	res     gproto.Message
+	err     error
+	expires time.Time
+}
+
+//Store remembers the result of the calls made with an idempotency key.
+//
+//Results are kept in memory of the replica which served the call, retries must reach the same replica to be replayed
+//and the results are lost on restart.
+type Store struct {
+	now func() time.Time
+
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]*entry
+}
+
+//NewStore creates store which keeps the results for ttl
+func NewStore(ttl time.Duration) *Store {
+	return &Store{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]*entry),
+	}
+}
+
+//SetTTL changes the time the results of the calls completing from now on are kept
+func (s *Store) SetTTL(ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ttl = ttl
+}
+
+func getMethod(info *grpc.UnaryServerInfo) string {
+	splits := strings.Split(info.FullMethod, "/")
+	return splits[len(splits)-1]
+}
+
+//requestKey returns the idempotency key set in request, falls back to the grpc metadata
+func requestKey(ctx context.Context, req gproto.Message) string {
+	m := req.ProtoReflect()
+	if fd := m.Descriptor().Fields().ByName(keyField); fd != nil {
+		if k := m.Get(fd).String(); k != "" {
+			return k
+		}
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(MetadataKey); len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ""
+}
+
+//key returns the idempotency key scoped to the authenticated principal and the method, callers cannot replay the
+//results of each other by reusing their keys
+func key(ctx context.Context, method string, req gproto.Message) string {
+	k := requestKey(ctx, req)
+	if k == "" {
+		return ""
+	}
+	principal, _ := auth.FromContext(ctx)
+	return principal + "/" + method + "/" + k
+}
+
+//fingerprint hash of the request without the idempotency key
+func fingerprint(req gproto.Message) ([sha256.Size]byte, error) {
+	req = gproto.Clone(req)
+	m := req.ProtoReflect()
+	if fd := m.Descriptor().Fields().ByName(keyField); fd != nil {
+		m.Clear(fd)
+	}
+
+	b, err := gproto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return sha256.Sum256(b), nil
+}
+
+//prune removes expired entries, must be called with lock held
+func (s *Store) prune() {
+	now := s.now()
+	for k, e := range s.entries {
+		if !e.expires.IsZero() && now.After(e.expires) {
+			delete(s.entries, k)
+		}
+	}
+}
+
+//Do calls fn once for the key and returns its result for the repeated calls with same key.
+//
+//Concurrent calls with the same key wait for the first one to complete. Failed calls are not remembered so
+//they can be retried with the same key. Reusing the key with a different request is rejected.
+func (s *Store) Do(ctx context.Context, key string, req gproto.Message, fn func() (gproto.Message, error)) (gproto.Message, error) {
+	fp, err := fingerprint(req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fingerprint request: %s", err)
+	}
+
+	s.mu.Lock()
+	s.prune()
+	e, ok := s.entries[key]
+	if !ok {
+		e = &entry{fingerprint: fp, done: make(chan struct{})}
+		s.entries[key] = e
+	}
+	s.mu.Unlock()
+
+	if ok {
+		if e.fingerprint != fp {
+			return nil, status.Error(codes.InvalidArgument, "idempotency key is already used with a different request")
+		}
+		select {
+		case <-e.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if e.err != nil {
+			return nil, e.err
+		}
+		return gproto.Clone(e.res), nil
+	}
+
+	res, err := fn()
+
+	s.mu.Lock()
+	if err != nil {
+		e.err = err
+		delete(s.entries, key)
+	} else {
+		e.res = gproto.Clone(res)
+		e.expires = s.now().Add(s.ttl)
+	}
+	s.mu.Unlock()
+	close(e.done)
+
+	return res, err
+}
+
+//Interceptor replays the result of the given methods when they are called again with the same idempotency key
+func Interceptor(store *Store, methods []string) grpc.UnaryServerInterceptor {
+	enabled := map[string]bool{}
+	for _, m := range methods {
+		enabled[m] = true
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method := getMethod(info)
+		msg, ok := req.(gproto.Message)
+		if !enabled[method] || !ok {
+			return handler(ctx, req)
+		}
+
+		k := key(ctx, method, msg)
+		if k == "" {
+			return handler(ctx, req)
+		}
+
+		return store.Do(ctx, k, msg, func() (gproto.Message, error) {
+			res, err := handler(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			return res.(gproto.Message), nil
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/netbook-devs/spawner-service/pkg/auth"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+var createVolumeInfo = &grpc.UnaryServerInfo{FullMethod: "/spawner.SpawnerService/CreateVolume"}
+
+func countingHandler(calls *int) grpc.UnaryHandler {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		*calls++
+		return &proto.CreateVolumeResponse{Volumeid: "vol-1"}, nil
+	}
+}
+
+func Test_replayWithRequestKey(t *testing.T) {
+	in := Interceptor(NewStore(time.Hour), []string{"CreateVolume"})
+	calls := 0
+	handler := countingHandler(&calls)
+
+	req := &proto.CreateVolumeRequest{Region: "us-west-2", Size: 10, IdempotencyKey: "k1"}
+	first, err := in(context.Background(), req, createVolumeInfo, handler)
+	assert.Nil(t, err)
+	second, err := in(context.Background(), req, createVolumeInfo, handler)
+	assert.Nil(t, err)
+
+	assert.Equal(t, 1, calls, "handler must be called once for the key")
+	assert.Equal(t, first.(*proto.CreateVolumeResponse).Volumeid, second.(*proto.CreateVolumeResponse).Volumeid)
+
+	_, err = in(context.Background(), &proto.CreateVolumeRequest{Region: "us-west-2", Size: 10}, createVolumeInfo, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, calls, "call without key must not be replayed")
+}
+
+func Test_replayWithMetadataKey(t *testing.T) {
+	in := Interceptor(NewStore(time.Hour), []string{"CreateVolume"})
+	calls := 0
+	handler := countingHandler(&calls)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, "k1"))
+	req := &proto.CreateVolumeRequest{Region: "us-west-2", Size: 10}
+	_, _ = in(ctx, req, createVolumeInfo, handler)
+	_, _ = in(ctx, req, createVolumeInfo, handler)
+	assert.Equal(t, 1, calls)
+
+	_, err := in(ctx, &proto.CreateVolumeRequest{Region: "us-west-2", Size: 20}, createVolumeInfo, handler)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "key reused with different request")
+}
+
+func Test_keyScopedToPrincipal(t *testing.T) {
+	in := Interceptor(NewStore(time.Hour), []string{"CreateVolume"})
+	calls := 0
+	handler := countingHandler(&calls)
+
+	req := &proto.CreateVolumeRequest{Region: "us-west-2", Size: 10, IdempotencyKey: "k1"}
+	_, err := in(auth.NewContext(context.Background(), "team-a"), req, createVolumeInfo, handler)
+	assert.Nil(t, err)
+	_, err = in(auth.NewContext(context.Background(), "team-b"), req, createVolumeInfo, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, calls, "result must not be replayed to another principal")
+
+	_, err = in(auth.NewContext(context.Background(), "team-b"), &proto.CreateVolumeRequest{Size: 20, IdempotencyKey: "k1"}, createVolumeInfo, handler)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "key reused by the same principal")
+}
+
+func Test_failureIsNotRemembered(t *testing.T) {
+	s := NewStore(time.Hour)
+	req := &proto.CreateVolumeRequest{IdempotencyKey: "k1"}
+
+	failed := errors.New("boom")
+	_, err := s.Do(context.Background(), "CreateVolume/k1", req, func() (gproto.Message, error) {
+		return nil, failed
+	})
+	assert.Equal(t, failed, err)
+
+	res, err := s.Do(context.Background(), "CreateVolume/k1", req, func() (gproto.Message, error) {
+		return &proto.CreateVolumeResponse{Volumeid: "vol-1"}, nil
+	})
+	assert.Nil(t, err, "failed call must be retried with the same key")
+	assert.Equal(t, "vol-1", res.(*proto.CreateVolumeResponse).Volumeid)
+}
+
+func Test_expiry(t *testing.T) {
+	s := NewStore(time.Hour)
+	now := time.Now()
+	s.now = func() time.Time { return now }
+
+	calls := 0
+	fn := func() (gproto.Message, error) {
+		calls++
+		return &proto.CreateVolumeResponse{}, nil
+	}
+	req := &proto.CreateVolumeRequest{}
+
+	_, _ = s.Do(context.Background(), "k1", req, fn)
+	now = now.Add(30 * time.Minute)
+	_, _ = s.Do(context.Background(), "k1", req, fn)
+	assert.Equal(t, 1, calls)
+
+	now = now.Add(time.Hour)
+	_, _ = s.Do(context.Background(), "k1", req, fn)
+	assert.Equal(t, 2, calls, "result must be forgotten after ttl")
+}
@@ -3,19 +3,133 @@ package metrics
 import (
 	"context"
 	"strings"
+	"sync"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	gproto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
-func getMethod(info *grpc.UnaryServerInfo) string {
-	splits := strings.Split(info.FullMethod, "/")
+func getMethod(fullMethod string) string {
+	splits := strings.Split(fullMethod, "/")
 	return splits[len(splits)-1]
 }
 
-//RPCInstrumentation request instrumentation interceptor
-func RPCInstrumentation() grpc.UnaryServerInterceptor {
+func stringField(m protoreflect.Message, name protoreflect.Name) string {
+	if fd := m.Descriptor().Fields().ByName(name); fd != nil && fd.Kind() == protoreflect.StringKind {
+		return m.Get(fd).String()
+	}
+	return ""
+}
+
+//OtherLabel label value of the providers and regions not known to spawner
+const OtherLabel = "other"
+
+var (
+	regionsMu sync.RWMutex
+	//regions known regions by provider
+	regions = map[string]map[string]bool{}
+)
+
+//SetRegions sets the known providers and their regions. labels are taken from the requests before they are
+//authenticated, values outside of these are labelled 'other' to keep the metric cardinality in check
+func SetRegions(r map[string][]string) {
+	known := map[string]map[string]bool{}
+	for provider, list := range r {
+		known[provider] = map[string]bool{}
+		for _, region := range list {
+			known[provider][region] = true
+		}
+	}
+
+	regionsMu.Lock()
+	defer regionsMu.Unlock()
+	regions = known
+}
+
+//knownRegion reports if the region or the region of the zone, such as 'us-central1-a', is known
+func knownRegion(known map[string]bool, region string) bool {
+	if known[region] {
+		return true
+	}
+	i := strings.LastIndex(region, "-")
+	return i > 0 && len(region)-i == 2 && known[region[:i]]
+}
+
+//labels provider and region of the request, empty when request does not carry them
+func labels(req interface{}) (provider, region string) {
+	msg, ok := req.(gproto.Message)
+	if !ok {
+		return "", ""
+	}
+	m := msg.ProtoReflect()
+	provider, region = strings.ToLower(stringField(m, "provider")), stringField(m, "region")
+
+	regionsMu.RLock()
+	known, ok := regions[provider]
+	regionsMu.RUnlock()
+	if provider != "" && !ok {
+		provider = OtherLabel
+	}
+	if region != "" && !knownRegion(known, region) {
+		region = OtherLabel
+	}
+	return provider, region
+}
+
+//RPCInstrumentation request instrumentation interceptor, toStatus translates the errors to the code they are
+//reported with
+func RPCInstrumentation(toStatus func(err error) *status.Status) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-		IncRequest(getMethod(info))
-		return handler(ctx, req)
+		method := getMethod(info.FullMethod)
+		provider, region := labels(req)
+		IncRequest(method)
+
+		IncInFlight(method, provider, region)
+		defer DecInFlight(method, provider, region)
+
+		start := time.Now()
+		resp, err = handler(ctx, req)
+		ObserveRequest(method, provider, region, toStatus(err).Code().String(), time.Since(start))
+		return resp, err
+	}
+}
+
+//instrumentedStream picks the labels from the first message received on the stream
+type instrumentedStream struct {
+	grpc.ServerStream
+	method           string
+	provider, region string
+	received         bool
+}
+
+func (s *instrumentedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if !s.received {
+		s.received = true
+		s.provider, s.region = labels(m)
+		IncInFlight(s.method, s.provider, s.region)
+	}
+	return nil
+}
+
+//StreamInstrumentation streaming request instrumentation interceptor
+func StreamInstrumentation(toStatus func(err error) *status.Status) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		s := &instrumentedStream{ServerStream: ss, method: getMethod(info.FullMethod)}
+		IncRequest(s.method)
+
+		start := time.Now()
+		err := handler(srv, s)
+		if s.received {
+			DecInFlight(s.method, s.provider, s.region)
+		}
+
+		ObserveRequest(s.method, s.provider, s.region, toStatus(err).Code().String(), time.Since(start))
+		return err
 	}
 }
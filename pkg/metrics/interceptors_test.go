@@ -0,0 +1,53 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_RPCInstrumentation(t *testing.T) {
+	SetRegions(map[string][]string{"gcp": {"us-east1"}})
+	defer SetRegions(nil)
+	in := RPCInstrumentation(status.Convert)
+	info := &grpc.UnaryServerInfo{FullMethod: "/spawner.SpawnerService/DeleteVolume"}
+	req := &proto.DeleteVolumeRequest{Provider: "GCP", Region: "us-east1"}
+
+	_, _ = in(context.Background(), req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		assert.Equal(t, float64(1), testutil.ToFloat64(requestsInFlight.WithLabelValues("DeleteVolume", "gcp", "us-east1")))
+		return nil, status.Error(codes.NotFound, "volume not found")
+	})
+	_, _ = in(context.Background(), req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &proto.DeleteVolumeResponse{}, nil
+	})
+
+	assert.Equal(t, float64(0), testutil.ToFloat64(requestsInFlight.WithLabelValues("DeleteVolume", "gcp", "us-east1")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(errorCounter.WithLabelValues("DeleteVolume", "gcp", "us-east1", "NotFound")))
+	assert.Equal(t, 2, testutil.CollectAndCount(requestDuration), "duration must be observed per code")
+}
+
+func Test_labels(t *testing.T) {
+	SetRegions(map[string][]string{"gcp": {"us-east1"}, "azure": {"eastus"}})
+	defer SetRegions(nil)
+
+	for _, c := range []struct {
+		req              *proto.DeleteVolumeRequest
+		provider, region string
+	}{
+		{&proto.DeleteVolumeRequest{Provider: "GCP", Region: "us-east1"}, "gcp", "us-east1"},
+		{&proto.DeleteVolumeRequest{Provider: "gcp", Region: "us-east1-b"}, "gcp", "us-east1-b"},
+		{&proto.DeleteVolumeRequest{Provider: "gcp", Region: "eastus"}, "gcp", OtherLabel},
+		{&proto.DeleteVolumeRequest{Provider: "x-1234", Region: "us-east1"}, OtherLabel, OtherLabel},
+		{&proto.DeleteVolumeRequest{}, "", ""},
+	} {
+		provider, region := labels(c.req)
+		assert.Equal(t, c.provider, provider)
+		assert.Equal(t, c.region, region)
+	}
+}
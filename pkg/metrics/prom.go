@@ -1,6 +1,8 @@
 package metrics
 
 import (
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -12,11 +14,85 @@ var requestCounter = prometheus.NewCounterVec(
 	[]string{"method"},
 )
 
+//requestDuration cloud calls take from milliseconds to several minutes, buckets range from 50ms to ~7min
+var requestDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "grpc_request_duration_seconds",
+		Help:    "Duration of gRPC calls",
+		Buckets: prometheus.ExponentialBuckets(0.05, 2, 14),
+	},
+	[]string{"method", "provider", "region", "code"},
+)
+
+var requestsInFlight = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "grpc_requests_in_flight",
+		Help: "Number of gRPC calls being served",
+	},
+	[]string{"method", "provider", "region"},
+)
+
+var errorCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "grpc_request_errors_total",
+		Help: "Number of failed gRPC calls",
+	},
+	[]string{"method", "provider", "region", "code"},
+)
+
+var cloudCallDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "cloud_api_request_duration_seconds",
+		Help:    "Duration of the cloud provider api calls including retries, gcp grpc calls are observed per attempt",
+		Buckets: prometheus.ExponentialBuckets(0.01, 2, 14),
+	},
+	[]string{"provider", "service", "operation", "status"},
+)
+
+var cloudCallRetries = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "cloud_api_retries_total",
+		Help: "Number of retries of the cloud provider api calls, gcp grpc retries are counted as separate calls instead",
+	},
+	[]string{"provider", "service", "operation"},
+)
+
 func init() {
 	prometheus.Register(requestCounter)
+	prometheus.Register(requestDuration)
+	prometheus.Register(requestsInFlight)
+	prometheus.Register(errorCounter)
+	prometheus.Register(cloudCallDuration)
+	prometheus.Register(cloudCallRetries)
 }
 
 //IncRequest incerement total request counter
 func IncRequest(method string) {
 	requestCounter.WithLabelValues(method).Inc()
 }
+
+//IncInFlight increment in-flight calls gauge
+func IncInFlight(method, provider, region string) {
+	requestsInFlight.WithLabelValues(method, provider, region).Inc()
+}
+
+//DecInFlight decrement in-flight calls gauge
+func DecInFlight(method, provider, region string) {
+	requestsInFlight.WithLabelValues(method, provider, region).Dec()
+}
+
+//ObserveRequest record the duration of the completed call and count it as error when code is not OK
+func ObserveRequest(method, provider, region, code string, duration time.Duration) {
+	requestDuration.WithLabelValues(method, provider, region, code).Observe(duration.Seconds())
+	if code != "OK" {
+		errorCounter.WithLabelValues(method, provider, region, code).Inc()
+	}
+}
+
+//ObserveCloudCall record the duration and retries of the outbound cloud api call
+func ObserveCloudCall(provider, service, operation, status string, retries int, duration time.Duration) {
+	cloudCallDuration.WithLabelValues(provider, service, operation, status).Observe(duration.Seconds())
+	if retries > 0 {
+		cloudCallRetries.WithLabelValues(provider, service, operation).Add(float64(retries))
+	}
+}
@@ -0,0 +1,345 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	gproto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+//Default method name of the limit applied to methods without their own limit
+const Default = "*"
+
+//idleTimeout limiters not used for this long are dropped
+const idleTimeout = time.Hour
+
+//RateLimit token bucket refilled at Rate tokens per second holding at most Burst tokens
+type RateLimit struct {
+	Rate  rate.Limit
+	Burst int
+}
+
+var units = map[string]time.Duration{
+	"s": time.Second,
+	"m": time.Minute,
+	"h": time.Hour,
+}
+
+//ParseRateLimits parse comma separated 'Method:count/unit[:burst]' list, unit being one of s, m or h.
+//
+//burst defaults to the count, eg. 'GetClusters:2/s:5,CreateCluster:5/m,*:20/s'
+func ParseRateLimits(s string) (map[string]RateLimit, error) {
+	limits := map[string]RateLimit{}
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		splits := strings.Split(entry, ":")
+		if len(splits) < 2 || len(splits) > 3 {
+			return nil, fmt.Errorf("invalid rate limit '%s', must be 'Method:count/unit[:burst]'", entry)
+		}
+
+		r := strings.SplitN(splits[1], "/", 2)
+		count, err := strconv.ParseFloat(r[0], 64)
+		if err != nil || count <= 0 || len(r) != 2 || units[r[1]] == 0 {
+			return nil, fmt.Errorf("invalid rate '%s' in '%s', must be 'count/unit' with unit one of s, m or h", splits[1], entry)
+		}
+
+		limit := RateLimit{
+			Rate:  rate.Limit(count / units[r[1]].Seconds()),
+			Burst: int(math.Max(1, math.Ceil(count))),
+		}
+		if len(splits) == 3 {
+			limit.Burst, err = strconv.Atoi(splits[2])
+			if err != nil || limit.Burst < 1 {
+				return nil, fmt.Errorf("invalid burst '%s' in '%s'", splits[2], entry)
+			}
+		}
+		limits[splits[0]] = limit
+	}
+	return limits, nil
+}
+
+//ParseInFlightLimits parse comma separated 'Method:max' list, eg. 'CreateCluster:2,*:50'
+func ParseInFlightLimits(s string) (map[string]int, error) {
+	limits := map[string]int{}
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		splits := strings.SplitN(entry, ":", 2)
+		if len(splits) != 2 {
+			return nil, fmt.Errorf("invalid in-flight limit '%s', must be 'Method:max'", entry)
+		}
+		max, err := strconv.Atoi(splits[1])
+		if err != nil || max < 1 {
+			return nil, fmt.Errorf("invalid in-flight limit '%s', max must be a positive number", entry)
+		}
+		limits[splits[0]] = max
+	}
+	return limits, nil
+}
+
+//key calls are limited per account, provider and method
+type key struct {
+	account  string
+	provider string
+	method   string
+}
+
+func (k key) String() string {
+	return k.account + "/" + k.provider + "/" + k.method
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	inFlight int
+	lastUsed time.Time
+	//reset set till the token bucket is created with the current rate
+	reset bool
+}
+
+//Limiter enforces the rate and in-flight limits of the calls
+type Limiter struct {
+	rates    map[string]RateLimit
+	inFlight map[string]int
+	now      func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[key]*bucket
+	lastPrune time.Time
+}
+
+//New creates limiter, limits are keyed by method with Default applied to the methods without a limit
+func New(rates map[string]RateLimit, inFlight map[string]int) *Limiter {
+	return &Limiter{
+		rates:    rates,
+		inFlight: inFlight,
+		now:      time.Now,
+		buckets:  map[key]*bucket{},
+	}
+}
+
+//SetLimits replaces the limits, the buckets are reset so the new rates apply to the next call. calls in flight
+//are still counted against the new in-flight limits
+func (l *Limiter) SetLimits(rates map[string]RateLimit, inFlight map[string]int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rates = rates
+	l.inFlight = inFlight
+	for _, b := range l.buckets {
+		b.limiter = nil
+		b.reset = true
+	}
+}
+
+//rateLimit must be called with lock held
+func (l *Limiter) rateLimit(method string) (RateLimit, bool) {
+	if r, ok := l.rates[method]; ok {
+		return r, true
+	}
+	r, ok := l.rates[Default]
+	return r, ok
+}
+
+//inFlightLimit must be called with lock held
+func (l *Limiter) inFlightLimit(method string) int {
+	if max, ok := l.inFlight[method]; ok {
+		return max
+	}
+	return l.inFlight[Default]
+}
+
+//prune drops the idle buckets, must be called with lock held
+func (l *Limiter) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < time.Minute {
+		return
+	}
+	l.lastPrune = now
+	for k, b := range l.buckets {
+		if b.inFlight == 0 && now.Sub(b.lastUsed) > idleTimeout {
+			delete(l.buckets, k)
+		}
+	}
+}
+
+func exhausted(k key, msg string, retry time.Duration) error {
+	st := status.New(codes.ResourceExhausted, msg)
+	if s, err := st.WithDetails(&errdetails.QuotaFailure{Violations: []*errdetails.QuotaFailure_Violation{{
+		Subject:     k.String(),
+		Description: msg,
+	}}}); err == nil {
+		st = s
+	}
+	if retry > 0 {
+		if s, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retry)}); err == nil {
+			st = s
+		}
+	}
+	return st.Err()
+}
+
+//Acquire takes a token and an in-flight slot for the call, release must be called once the call completes
+func (l *Limiter) Acquire(account, provider, method string) (release func(), err error) {
+	k := key{account: account, provider: provider, method: method}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	rl, limited := l.rateLimit(method)
+	max := l.inFlightLimit(method)
+
+	now := l.now()
+	l.prune(now)
+
+	b, ok := l.buckets[k]
+	if !ok {
+		b = &bucket{reset: true}
+		l.buckets[k] = b
+	}
+	if b.reset {
+		b.reset = false
+		if limited {
+			b.limiter = rate.NewLimiter(rl.Rate, rl.Burst)
+		}
+	}
+	b.lastUsed = now
+
+	if max > 0 && b.inFlight >= max {
+		return nil, exhausted(k, fmt.Sprintf("too many concurrent %s calls for account '%s', max %d", method, account, max), 0)
+	}
+
+	if b.limiter != nil {
+		r := b.limiter.ReserveN(now, 1)
+		if delay := r.DelayFrom(now); !r.OK() || delay > 0 {
+			r.CancelAt(now)
+			return nil, exhausted(k, fmt.Sprintf("rate limit of %s calls for account '%s' exceeded", method, account), delay)
+		}
+	}
+
+	b.inFlight++
+	released := false
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if !released {
+			released = true
+			b.inFlight--
+		}
+	}, nil
+}
+
+func stringField(m protoreflect.Message, names ...protoreflect.Name) string {
+	for _, name := range names {
+		if fd := m.Descriptor().Fields().ByName(name); fd != nil && fd.Kind() == protoreflect.StringKind {
+			return m.Get(fd).String()
+		}
+	}
+	return ""
+}
+
+//acquireFor acquires the limits of the request
+func (l *Limiter) acquireFor(method string, req interface{}) (func(), error) {
+	var account, provider string
+	if msg, ok := req.(gproto.Message); ok {
+		m := msg.ProtoReflect()
+		account = stringField(m, "accountName", "account")
+		provider = stringField(m, "provider")
+	}
+	return l.Acquire(account, provider, method)
+}
+
+func getMethod(fullMethod string) string {
+	splits := strings.Split(fullMethod, "/")
+	return splits[len(splits)-1]
+}
+
+type slotKey struct{}
+
+//slot in-flight slot of the call, held till the call returns unless the handler takes it over
+type slot struct {
+	mu      sync.Mutex
+	release func()
+	held    bool
+}
+
+//Hold takes over the in-flight slot of the call, for the work which outlives the call such as long running
+//operations. returned release must be called once the work completes, it is no-op when the call has no slot.
+func Hold(ctx context.Context) (release func()) {
+	s, ok := ctx.Value(slotKey{}).(*slot)
+	if !ok {
+		return func() {}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.held = true
+	return s.release
+}
+
+//Interceptor rejects the unary calls exceeding the limits with ResourceExhausted
+func (l *Limiter) Interceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		release, err := l.acquireFor(getMethod(info.FullMethod), req)
+		if err != nil {
+			return nil, err
+		}
+		s := &slot{release: release}
+		defer func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if !s.held {
+				release()
+			}
+		}()
+		return handler(context.WithValue(ctx, slotKey{}, s), req)
+	}
+}
+
+//limitedStream acquires the limits on the first message received on the stream
+type limitedStream struct {
+	grpc.ServerStream
+	limiter *Limiter
+	method  string
+	release func()
+}
+
+func (s *limitedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.release != nil {
+		return nil
+	}
+	release, err := s.limiter.acquireFor(s.method, m)
+	if err != nil {
+		return err
+	}
+	s.release = release
+	return nil
+}
+
+//StreamInterceptor rejects the streaming calls exceeding the limits, the in-flight slot is held till the stream ends
+func (l *Limiter) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		s := &limitedStream{ServerStream: ss, limiter: l, method: getMethod(info.FullMethod)}
+		defer func() {
+			if s.release != nil {
+				s.release()
+			}
+		}()
+		return handler(srv, s)
+	}
+}
@@ -0,0 +1,102 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_ParseRateLimits(t *testing.T) {
+	limits, err := ParseRateLimits("GetClusters:2/s:5, CreateCluster:6/m,*:20/s")
+	assert.Nil(t, err)
+	assert.Equal(t, RateLimit{Rate: 2, Burst: 5}, limits["GetClusters"])
+	assert.Equal(t, RateLimit{Rate: rate.Limit(0.1), Burst: 6}, limits["CreateCluster"])
+	assert.Equal(t, RateLimit{Rate: 20, Burst: 20}, limits[Default])
+
+	for _, invalid := range []string{"GetClusters", "GetClusters:2", "GetClusters:2/d", "GetClusters:0/s", "GetClusters:2/s:x"} {
+		_, err := ParseRateLimits(invalid)
+		assert.NotNil(t, err, invalid)
+	}
+
+	inFlight, err := ParseInFlightLimits("CreateCluster:2,*:50")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int{"CreateCluster": 2, Default: 50}, inFlight)
+}
+
+func Test_rateLimit(t *testing.T) {
+	l := New(map[string]RateLimit{"GetClusters": {Rate: 1, Burst: 2}}, nil)
+	now := time.Now()
+	l.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		release, err := l.Acquire("team-a", "aws", "GetClusters")
+		assert.Nil(t, err)
+		release()
+	}
+
+	_, err := l.Acquire("team-a", "aws", "GetClusters")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	var retry *errdetails.RetryInfo
+	for _, d := range status.Convert(err).Details() {
+		if r, ok := d.(*errdetails.RetryInfo); ok {
+			retry = r
+		}
+	}
+	assert.NotNil(t, retry)
+	assert.Equal(t, time.Second, retry.RetryDelay.AsDuration())
+
+	_, err = l.Acquire("team-b", "aws", "GetClusters")
+	assert.Nil(t, err, "accounts must be limited independently")
+	_, err = l.Acquire("team-a", "aws", "CreateCluster")
+	assert.Nil(t, err, "methods without limit must not be limited")
+
+	now = now.Add(time.Second)
+	_, err = l.Acquire("team-a", "aws", "GetClusters")
+	assert.Nil(t, err, "bucket must refill")
+}
+
+func Test_inFlightLimit(t *testing.T) {
+	l := New(nil, map[string]int{Default: 1})
+
+	release, err := l.Acquire("team-a", "aws", "CreateCluster")
+	assert.Nil(t, err)
+
+	_, err = l.Acquire("team-a", "aws", "CreateCluster")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	release()
+	release()
+	release, err = l.Acquire("team-a", "aws", "CreateCluster")
+	assert.Nil(t, err, "slot must be freed once, after the call completes")
+	release()
+}
+
+func Test_SetLimits(t *testing.T) {
+	l := New(nil, nil)
+	now := time.Now()
+	l.now = func() time.Time { return now }
+
+	release, err := l.Acquire("team-a", "aws", "GetClusters")
+	assert.Nil(t, err)
+
+	l.SetLimits(map[string]RateLimit{"GetClusters": {Rate: 1, Burst: 1}}, map[string]int{"GetClusters": 1})
+	_, err = l.Acquire("team-a", "aws", "GetClusters")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err), "call in flight must count against the new limit")
+
+	release()
+	_, err = l.Acquire("team-a", "aws", "GetClusters")
+	assert.Nil(t, err)
+	_, err = l.Acquire("team-b", "aws", "GetClusters")
+	assert.Nil(t, err)
+
+	l.SetLimits(nil, nil)
+	for i := 0; i < 3; i++ {
+		_, err = l.Acquire("team-a", "aws", "GetClusters")
+		assert.Nil(t, err, "limits must be removed")
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/pkg/errors"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
@@ -154,7 +153,7 @@ func (ctrl awsController) CreateCluster(ctx context.Context, req *proto.ClusterR
 
 	if exist {
 		ctrl.logger.Info(ctx, "cluster already exist", "cluster", clusterName)
-		return nil, errors.New("cluster already exist")
+		return nil, ERR_CLUSTER_EXIST
 	}
 
 	ctrl.logger.Debug(ctx, "cluster does not exist, creating ...", "cluster", clusterName)
@@ -394,7 +393,6 @@ func (ctrl awsController) DeleteCluster(ctx context.Context, req *proto.ClusterD
 	cluster, err := getClusterSpec(ctx, client, clusterName)
 
 	if err != nil {
-		err := errors.New(err.(awserr.Error).Message())
 		return nil, errors.Wrap(err, "DeleteCluster: ")
 	}
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/netbookai/log"
 	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 )
 
@@ -25,6 +26,7 @@ const (
 )
 
 var (
+	ERR_CLUSTER_EXIST   = errors.New("cluster already exist")
 	ERR_NODEGROUP_EXIST = errors.New("nodegroup already exist")
 	ERR_NO_NODEGROUP    = errors.New("no nodegroup exist in cluster")
 )
@@ -44,3 +46,8 @@ func NewAWSController(logger log.Logger) *awsController {
 func (ctrl awsController) AddToken(ctx context.Context, req *proto.AddTokenRequest) (*proto.AddTokenResponse, error) {
 	return &proto.AddTokenResponse{}, nil
 }
+
+//Ping checks the aws sts endpoint is reachable
+func (ctrl awsController) Ping(ctx context.Context) error {
+	return common.Reachable(ctx, "https://sts.amazonaws.com")
+}
@@ -0,0 +1,165 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/registry"
+)
+
+//scopeFilters filters the ec2 resources tagged by spawner in the current env
+func scopeFilters() []*ec2.Filter {
+	return []*ec2.Filter{
+		{Name: aws.String("tag:" + constants.Scope), Values: aws.StringSlice([]string{labels.ScopeTag()})},
+		{Name: aws.String("tag:" + constants.CreatorLabel), Values: aws.StringSlice([]string{constants.SpawnerServiceLabel})},
+	}
+}
+
+//ListFleet lists the spawner scoped eks clusters along with their node groups, volumes and snapshots
+func (ctrl awsController) ListFleet(ctx context.Context, region, accountName string) (*registry.Fleet, error) {
+	session, err := NewSession(ctx, region, accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	fleet := &registry.Fleet{}
+	fleet.Clusters, err = ctrl.listFleetClusters(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	client := session.getEC2Client()
+	err = client.DescribeVolumesPagesWithContext(ctx, &ec2.DescribeVolumesInput{Filters: scopeFilters()},
+		func(out *ec2.DescribeVolumesOutput, last bool) bool {
+			for _, v := range out.Volumes {
+				fleet.Volumes = append(fleet.Volumes, registry.FleetVolume{
+					ID:        aws.StringValue(v.VolumeId),
+					SizeGB:    aws.Int64Value(v.Size),
+					Attached:  aws.StringValue(v.State) == ec2.VolumeStateInUse,
+					CreatedAt: aws.TimeValue(v.CreateTime),
+				})
+			}
+			return true
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	err = client.DescribeSnapshotsPagesWithContext(ctx, &ec2.DescribeSnapshotsInput{
+		OwnerIds: aws.StringSlice([]string{"self"}),
+		Filters:  scopeFilters(),
+	}, func(out *ec2.DescribeSnapshotsOutput, last bool) bool {
+		for _, s := range out.Snapshots {
+			fleet.Snapshots = append(fleet.Snapshots, registry.FleetSnapshot{
+				ID:        aws.StringValue(s.SnapshotId),
+				SizeGB:    aws.Int64Value(s.VolumeSize),
+				CreatedAt: aws.TimeValue(s.StartTime),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fleet, nil
+}
+
+func (ctrl awsController) listFleetClusters(ctx context.Context, session *Session) ([]registry.FleetCluster, error) {
+	client := session.getEksClient()
+
+	names := []*string{}
+	err := client.ListClustersPagesWithContext(ctx, &eks.ListClustersInput{}, func(out *eks.ListClustersOutput, last bool) bool {
+		names = append(names, out.Clusters...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := []registry.FleetCluster{}
+	for _, name := range names {
+		spec, err := getClusterSpec(ctx, client, *name)
+		if err != nil {
+			ctrl.logger.Error(ctx, "failed to get cluster details", "cluster", *name, "error", err)
+			continue
+		}
+		if creator, ok := spec.Tags[constants.CreatorLabel]; !ok || *creator != constants.SpawnerServiceLabel {
+			continue
+		}
+		if scope, ok := spec.Tags[constants.Scope]; !ok || *scope != labels.ScopeTag() {
+			continue
+		}
+
+		cluster := registry.FleetCluster{Name: *name, Status: strings.ToLower(aws.StringValue(spec.Status))}
+		nodeGroups, err := client.ListNodegroupsWithContext(ctx, &eks.ListNodegroupsInput{ClusterName: name})
+		if err != nil {
+			return nil, err
+		}
+		for _, ng := range nodeGroups.Nodegroups {
+			out, err := client.DescribeNodegroupWithContext(ctx, &eks.DescribeNodegroupInput{ClusterName: name, NodegroupName: ng})
+			if err != nil {
+				ctrl.logger.Error(ctx, "failed to fetch nodegroups details", "nodegroup", *ng, "error", err)
+				continue
+			}
+			nodes, err := inServiceNodes(ctx, session, out.Nodegroup)
+			if err != nil {
+				return nil, err
+			}
+			cluster.NodeGroups = append(cluster.NodeGroups, fleetNodeGroup(out.Nodegroup, nodes))
+		}
+		clusters = append(clusters, cluster)
+	}
+	return clusters, nil
+}
+
+func fleetNodeGroup(ng *eks.Nodegroup, nodes int64) registry.FleetNodeGroup {
+	f := registry.FleetNodeGroup{
+		Name:   aws.StringValue(ng.NodegroupName),
+		Status: strings.ToLower(aws.StringValue(ng.Status)),
+		GPU:    strings.HasSuffix(aws.StringValue(ng.AmiType), "GPU"),
+		Nodes:  nodes,
+	}
+	if len(ng.InstanceTypes) > 0 {
+		f.Instance = aws.StringValue(ng.InstanceTypes[0])
+	}
+	if ng.ScalingConfig != nil {
+		f.Desired = aws.Int64Value(ng.ScalingConfig.DesiredSize)
+	}
+	if ng.Health != nil {
+		f.Issues = len(ng.Health.Issues)
+	}
+	return f
+}
+
+//inServiceNodes number of instances in service in the autoscaling groups backing the node group
+func inServiceNodes(ctx context.Context, session *Session, ng *eks.Nodegroup) (int64, error) {
+	if ng.Resources == nil || len(ng.Resources.AutoScalingGroups) == 0 {
+		return 0, nil
+	}
+	names := make([]*string, 0, len(ng.Resources.AutoScalingGroups))
+	for _, g := range ng.Resources.AutoScalingGroups {
+		names = append(names, g.Name)
+	}
+
+	out, err := session.getAutoScalingClient().DescribeAutoScalingGroupsWithContext(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: names,
+	})
+	if err != nil {
+		return 0, err
+	}
+	var nodes int64
+	for _, g := range out.AutoScalingGroups {
+		for _, i := range g.Instances {
+			if aws.StringValue(i.LifecycleState) == autoscaling.LifecycleStateInService {
+				nodes++
+			}
+		}
+	}
+	return nodes, nil
+}
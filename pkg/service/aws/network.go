@@ -49,6 +49,11 @@ func tagValue(val string) []*string {
 	return aws.StringSlice([]string{val})
 }
 
+//WkspNetworkStackName name of the workspace vpc shared by the clusters in the region
+func WkspNetworkStackName(region string) string {
+	return fmt.Sprintf(vpcNameFmt, region)
+}
+
 func GetRegionWkspNetworkStack(ctx context.Context, session *Session, logger log.Logger) (*AwsWkspRegionNetworkStack, error) {
 	sess := session.getEC2Client()
 	region := session.Region
@@ -0,0 +1,41 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/netbookai/log"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/registry"
+)
+
+func init() {
+	registry.Register(registry.Provider{
+		Name: constants.AwsLabel,
+		Capabilities: []registry.Capability{
+			registry.CapCluster,
+			registry.CapNodePool,
+			registry.CapVolume,
+			registry.CapSnapshot,
+			registry.CapKubeConfig,
+			registry.CapInstanceTag,
+			registry.CapCost,
+			registry.CapContainerRegistry,
+			registry.CapOIDC,
+			registry.CapPresign,
+		},
+		Regions: regions(),
+		New: func(logger log.Logger) registry.Controller {
+			return NewAWSController(logger)
+		},
+	})
+}
+
+//regions of all the aws partitions known to the sdk
+func regions() []string {
+	res := []string{}
+	for _, p := range endpoints.DefaultPartitions() {
+		for id := range p.Regions() {
+			res = append(res, id)
+		}
+	}
+	return res
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/costexplorer"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecr"
@@ -18,6 +19,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/pkg/errors"
 	"gitlab.com/netbook-devs/spawner-service/pkg/config"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/instrument"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
@@ -101,15 +103,13 @@ func NewSession(ctx context.Context, region string, accountName string) (*Sessio
 		}
 
 		return &Session{
-			AwsSession: sess,
+			AwsSession: instrument.AWS(sess),
 			Region:     region,
 			TeamId:     accountName,
 		}, nil
 
 	} else {
-		//secret manager is hosted in particular region, all writes happen to the same region
-		secretHostRegion := config.Get().SecretHostRegion
-		awsCreds, err = system.GetAwsCredentials(ctx, secretHostRegion, accountName)
+		awsCreds, err = system.GetAwsCredentials(ctx, accountName)
 		if err != nil {
 			return nil, err
 		}
@@ -128,7 +128,7 @@ func NewSession(ctx context.Context, region string, accountName string) (*Sessio
 	return &Session{
 		TeamId:     accountName,
 		Region:     region,
-		AwsSession: sess,
+		AwsSession: instrument.AWS(sess),
 	}, nil
 }
 
@@ -180,6 +180,10 @@ func (ses *Session) getEC2Client() *ec2.EC2 {
 	return ec2.New(ses.AwsSession)
 }
 
+func (ses *Session) getAutoScalingClient() *autoscaling.AutoScaling {
+	return autoscaling.New(ses.AwsSession)
+}
+
 func (ses *Session) getCostExplorerClient() *costexplorer.CostExplorer {
 	return costexplorer.New(ses.AwsSession)
 }
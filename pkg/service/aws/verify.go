@@ -0,0 +1,123 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/instrument"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/registry"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
+)
+
+//verifyRegion region of the sts and iam calls verifying the credential
+const verifyRegion = "us-east-1"
+
+//requiredActions iam actions spawner calls on the account
+var requiredActions = []string{
+	"eks:CreateCluster", "eks:DescribeCluster", "eks:DeleteCluster", "eks:ListClusters",
+	"eks:CreateNodegroup", "eks:DescribeNodegroup", "eks:DeleteNodegroup",
+	"ec2:DescribeInstances", "ec2:CreateVolume", "ec2:DeleteVolume", "ec2:CreateSnapshot", "ec2:DeleteSnapshot",
+	"ec2:CreateVpc", "ec2:CreateSubnet", "ec2:CreateTags",
+	"autoscaling:DescribeAutoScalingGroups",
+	"iam:PassRole", "iam:CreateRole",
+}
+
+//authFailures error codes returned by sts when the credential is not valid
+var authFailures = map[string]bool{
+	"InvalidClientTokenId":        true,
+	"SignatureDoesNotMatch":       true,
+	"IncompleteSignature":         true,
+	"ExpiredToken":                true,
+	"UnrecognizedClientException": true,
+	"AccessDenied":                true,
+	"EmptyStaticCreds":            true,
+}
+
+//principalArn iam arn the policies are attached to, the role of an assumed role session
+func principalArn(arn string) string {
+	parts := strings.Split(arn, ":")
+	if len(parts) != 6 || parts[2] != "sts" || !strings.HasPrefix(parts[5], "assumed-role/") {
+		return arn
+	}
+	role := strings.Split(strings.TrimPrefix(parts[5], "assumed-role/"), "/")[0]
+	return fmt.Sprintf("arn:%s:iam::%s:role/%s", parts[1], parts[4], role)
+}
+
+//missingActions simulates the policies of the principal for the required actions
+func (ses *Session) missingActions(ctx context.Context, arn string) ([]string, error) {
+	if strings.HasSuffix(arn, ":root") {
+		return nil, nil
+	}
+	missing := []string{}
+	err := ses.getIAMClient().SimulatePrincipalPolicyPagesWithContext(ctx, &iam.SimulatePrincipalPolicyInput{
+		PolicySourceArn: &arn,
+		ActionNames:     aws.StringSlice(requiredActions),
+	}, func(page *iam.SimulatePolicyResponse, last bool) bool {
+		for _, r := range page.EvaluationResults {
+			if aws.StringValue(r.EvalDecision) != iam.PolicyEvaluationDecisionTypeAllowed {
+				missing = append(missing, aws.StringValue(r.EvalActionName))
+			}
+		}
+		return true
+	})
+	sort.Strings(missing)
+	return missing, err
+}
+
+//VerifyCredential gets the caller identity of the credential from sts, assuming the role for role credentials, and checks the permissions of the
+//principal by simulating its policies. permissions are not reported when the principal cannot simulate them
+func (ctrl awsController) VerifyCredential(ctx context.Context, cred system.Credentials) (*registry.Identity, error) {
+	var (
+		creds   *credentials.Credentials
+		account string
+		err     error
+	)
+	switch {
+	case cred.GetAws() != nil:
+		c := cred.GetAws()
+		creds, account = credentials.NewStaticCredentials(c.Id, c.Secret, c.Token), c.Name
+	case cred.GetAwsRole() != nil:
+		c := cred.GetAwsRole()
+		account = c.Name
+		creds, err = system.AssumeRoleCredentials(c)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("%w: expected aws credential", registry.ErrInvalidCredential)
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Region:      aws.String(verifyRegion),
+		Credentials: creds,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ses := &Session{AwsSession: instrument.AWS(sess), Region: verifyRegion, TeamId: account}
+
+	out, err := ses._getSTSClient().GetCallerIdentityWithContext(ctx, &sts.GetCallerIdentityInput{})
+	if aerr, ok := err.(awserr.Error); ok && authFailures[aerr.Code()] {
+		return nil, fmt.Errorf("%w: %s", registry.ErrInvalidCredential, aerr.Message())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	identity := &registry.Identity{Principal: aws.StringValue(out.Arn), Account: aws.StringValue(out.Account)}
+	identity.MissingPermissions, err = ses.missingActions(ctx, principalArn(identity.Principal))
+	if err != nil {
+		ctrl.logger.Warn(ctx, "unable to check the permissions of the credential", "principal", identity.Principal, "error", err)
+		identity.MissingPermissions = nil
+		identity.PermissionCheckError = err.Error()
+	}
+	return identity, nil
+}
@@ -0,0 +1,12 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_principalArn(t *testing.T) {
+	assert.Equal(t, "arn:aws:iam::123456789012:role/spawner", principalArn("arn:aws:sts::123456789012:assumed-role/spawner/session-1"))
+	assert.Equal(t, "arn:aws:iam::123456789012:user/ci", principalArn("arn:aws:iam::123456789012:user/ci"))
+}
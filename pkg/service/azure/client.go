@@ -8,6 +8,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/costmanagement/mgmt/2019-11-01/costmanagement"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/azure/iam"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/instrument"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
 )
 
@@ -22,6 +23,7 @@ func getAKSClient(c *system.AzureCredential) (*containerservice.ManagedClustersC
 	aksClient.AddToUserAgent(constants.SpawnerServiceLabel)
 	aksClient.PollingDuration = time.Hour * 1
 	aksClient.RetryAttempts = 1
+	instrument.Azure(&aksClient.Client)
 	return &aksClient, nil
 }
 
@@ -35,6 +37,7 @@ func getCostManagementClient(c *system.AzureCredential) (*costmanagement.QueryCl
 	costmgmtClient.Authorizer = auth
 	costmgmtClient.RetryAttempts = 1
 	costmgmtClient.AddToUserAgent(constants.SpawnerServiceLabel)
+	instrument.Azure(&costmgmtClient.Client)
 
 	return &costmgmtClient, nil
 }
@@ -49,6 +52,7 @@ func getAgentPoolClient(c *system.AzureCredential) (*containerservice.AgentPools
 	agentClient.Authorizer = auth
 	agentClient.AddToUserAgent(constants.SpawnerServiceLabel)
 	agentClient.PollingDuration = time.Hour * 1
+	instrument.Azure(&agentClient.Client)
 	return &agentClient, nil
 }
 
@@ -61,6 +65,7 @@ func getDisksClient(c *system.AzureCredential) (*compute.DisksClient, error) {
 	}
 	dc.Authorizer = a
 	dc.AddToUserAgent(constants.SpawnerServiceLabel)
+	instrument.Azure(&dc.Client)
 	return &dc, nil
 }
 
@@ -73,5 +78,6 @@ func getSnapshotClient(c *system.AzureCredential) (*compute.SnapshotsClient, err
 	}
 	sc.Authorizer = a
 	sc.AddToUserAgent(constants.SpawnerServiceLabel)
+	instrument.Azure(&sc.Client)
 	return &sc, nil
 }
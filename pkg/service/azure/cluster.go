@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/containerservice/mgmt/containerservice"
 	"github.com/Azure/go-autorest/autorest/to"
@@ -78,12 +79,17 @@ func (a *azureController) createCluster(ctx context.Context, req *proto.ClusterR
 					//					OrchestratorVersion: &constants.AzureKubeVersion,
 				},
 			},
-			ServicePrincipalProfile: &containerservice.ManagedClusterServicePrincipalProfile{
-				ClientID: to.StringPtr(clientID),
-				Secret:   to.StringPtr(clientSecret),
-			},
 		},
 	}
+	//federated applications have no secret to hand over to the cluster, it runs with its own managed identity
+	if cred.Federated {
+		mc.Identity = &containerservice.ManagedClusterIdentity{Type: containerservice.ResourceIdentityTypeSystemAssigned}
+	} else {
+		mc.ServicePrincipalProfile = &containerservice.ManagedClusterServicePrincipalProfile{
+			ClientID: to.StringPtr(clientID),
+			Secret:   to.StringPtr(clientSecret),
+		}
+	}
 
 	future, err := aksClient.CreateOrUpdate(
 		ctx,
@@ -150,6 +156,9 @@ func (a *azureController) getCluster(ctx context.Context, req *proto.GetClusterR
 			DiskSize: *node.OsDiskSizeGB,
 			State:    state,
 		}
+		if node.Count != nil {
+			nodeSpec.Count = int64(*node.Count)
+		}
 		nodeSpecList = append(nodeSpecList, &nodeSpec)
 	}
 
@@ -290,7 +299,9 @@ func (a *azureController) clusterStatus(ctx context.Context, req *proto.ClusterS
 	}
 
 	state := constants.Inactive
-	if clstr.PowerState.Code == containerservice.CodeRunning {
+	if strings.EqualFold(to.String(clstr.ProvisioningState), "Failed") {
+		state = constants.Failed
+	} else if clstr.PowerState.Code == containerservice.CodeRunning {
 		state = constants.Active
 	}
 	return &proto.ClusterStatusResponse{
@@ -3,7 +3,11 @@ package azure
 import (
 	"context"
 
+	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/netbookai/log"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/config"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 )
 
@@ -112,3 +116,12 @@ func (a *azureController) CopySnapshot(ctx context.Context, in *proto.CopySnapsh
 func (a *azureController) PresignS3Url(ctx context.Context, in *proto.PresignS3UrlRequest) (*proto.PresignS3UrlResponse, error) {
 	return &proto.PresignS3UrlResponse{}, nil
 }
+
+//Ping checks the resource manager endpoint of the configured azure cloud is reachable
+func (a *azureController) Ping(ctx context.Context) error {
+	env, err := azure.EnvironmentFromName(config.Get().AzureCloudProvider)
+	if err != nil {
+		return errors.Wrapf(err, "invalid azure cloud provider '%s'", config.Get().AzureCloudProvider)
+	}
+	return common.Reachable(ctx, env.ResourceManagerEndpoint)
+}
@@ -70,8 +70,8 @@ func (a azureController) getWorkspacesCost(ctx context.Context, req *proto.GetWo
 		Type:      costmanagement.ExportTypeActualCost,
 		Timeframe: costmanagement.TimeframeTypeCustom,
 		TimePeriod: &costmanagement.QueryTimePeriod{
-			From: &date.Time{startDate},
-			To:   &date.Time{endDate},
+			From: &date.Time{Time: startDate},
+			To:   &date.Time{Time: endDate},
 		},
 		Dataset: &costmanagement.QueryDataset{
 			Granularity: costmanagement.GranularityType("None"),
@@ -252,8 +252,8 @@ func (a azureController) getApplicationsCost(ctx context.Context, req *proto.Get
 		Type:      costmanagement.ExportTypeActualCost,
 		Timeframe: costmanagement.TimeframeTypeCustom,
 		TimePeriod: &costmanagement.QueryTimePeriod{
-			From: &date.Time{startDate},
-			To:   &date.Time{endDate},
+			From: &date.Time{Time: startDate},
+			To:   &date.Time{Time: endDate},
 		},
 		Dataset: &costmanagement.QueryDataset{
 			Granularity: costmanagement.GranularityType("None"),
@@ -463,8 +463,8 @@ func (a azureController) getCostByTime(ctx context.Context, req *proto.GetCostBy
 		Type:      costmanagement.ExportTypeActualCost,
 		Timeframe: costmanagement.TimeframeTypeCustom,
 		TimePeriod: &costmanagement.QueryTimePeriod{
-			From: &date.Time{startDate},
-			To:   &date.Time{endDate},
+			From: &date.Time{Time: startDate},
+			To:   &date.Time{Time: endDate},
 		},
 		Dataset: &costmanagement.QueryDataset{
 			Granularity: costmanagement.GranularityTypeDaily,
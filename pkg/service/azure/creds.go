@@ -9,6 +9,7 @@ import (
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
 )
 
+//getCredentials credential of the account, the client secret when stored, the federated one otherwise
 func getCredentials(ctx context.Context, account string) (*system.AzureCredential, error) {
 	env := config.Get().Env
 
@@ -23,7 +24,7 @@ func getCredentials(ctx context.Context, account string) (*system.AzureCredentia
 			Name:           account,
 		}, nil
 	} else {
-		c, err := system.GetCredentials(ctx, config.Get().SecretHostRegion, account, constants.CredAzure)
+		c, err := system.FindCredentials(ctx, account, constants.CredAzure, constants.CredAzureFederated)
 		if err != nil {
 			return nil, errors.Wrap(err, "getCredentials")
 		}
@@ -0,0 +1,135 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/containerservice/mgmt/containerservice"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/registry"
+)
+
+//spawnerScoped resource is created by spawner in the current env and lives in the region
+func spawnerScoped(tags map[string]*string, location *string, region string) bool {
+	if !strings.EqualFold(to.String(location), region) {
+		return false
+	}
+	return to.String(tags[constants.CreatorLabel]) == constants.SpawnerServiceLabel &&
+		to.String(tags[constants.Scope]) == labels.ScopeTag()
+}
+
+//ListFleet lists the spawner scoped aks clusters along with their agent pools, disks and snapshots in the resource group
+func (a *azureController) ListFleet(ctx context.Context, region, accountName string) (*registry.Fleet, error) {
+	cred, err := getCredentials(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+	fleet := &registry.Fleet{}
+
+	aksClient, err := getAKSClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "ListFleet: cannot get AKS client")
+	}
+	clusters, err := aksClient.ListByResourceGroupComplete(ctx, cred.ResourceGroup)
+	if err != nil {
+		return nil, err
+	}
+	for ; clusters.NotDone(); err = clusters.NextWithContext(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		cl := clusters.Value()
+		if !spawnerScoped(cl.Tags, cl.Location, region) {
+			continue
+		}
+		fleet.Clusters = append(fleet.Clusters, fleetCluster(cl))
+	}
+
+	dc, err := getDisksClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "ListFleet: cannot get disks client")
+	}
+	disks, err := dc.ListByResourceGroupComplete(ctx, cred.ResourceGroup)
+	if err != nil {
+		return nil, err
+	}
+	for ; disks.NotDone(); err = disks.NextWithContext(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		d := disks.Value()
+		if !spawnerScoped(d.Tags, d.Location, region) || d.DiskProperties == nil {
+			continue
+		}
+		v := registry.FleetVolume{
+			ID:       to.String(d.Name),
+			SizeGB:   int64(to.Int32(d.DiskSizeGB)),
+			Attached: d.DiskState != compute.DiskStateUnattached,
+		}
+		if d.TimeCreated != nil {
+			v.CreatedAt = d.TimeCreated.Time
+		}
+		fleet.Volumes = append(fleet.Volumes, v)
+	}
+
+	sc, err := getSnapshotClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "ListFleet: cannot get snapshot client")
+	}
+	snapshots, err := sc.ListByResourceGroupComplete(ctx, cred.ResourceGroup)
+	if err != nil {
+		return nil, err
+	}
+	for ; snapshots.NotDone(); err = snapshots.NextWithContext(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		s := snapshots.Value()
+		if !spawnerScoped(s.Tags, s.Location, region) || s.SnapshotProperties == nil {
+			continue
+		}
+		snap := registry.FleetSnapshot{
+			ID:     to.String(s.Name),
+			SizeGB: int64(to.Int32(s.DiskSizeGB)),
+		}
+		if s.TimeCreated != nil {
+			snap.CreatedAt = s.TimeCreated.Time
+		}
+		fleet.Snapshots = append(fleet.Snapshots, snap)
+	}
+	return fleet, nil
+}
+
+func fleetCluster(cl containerservice.ManagedCluster) registry.FleetCluster {
+	c := registry.FleetCluster{Name: to.String(cl.Name)}
+	if cl.ManagedClusterProperties == nil {
+		return c
+	}
+	c.Status = strings.ToLower(to.String(cl.ProvisioningState))
+	if cl.AgentPoolProfiles == nil {
+		return c
+	}
+	for _, app := range *cl.AgentPoolProfiles {
+		ng := registry.FleetNodeGroup{
+			Name:     to.String(app.Name),
+			Status:   strings.ToLower(to.String(app.ProvisioningState)),
+			Instance: to.String(app.VMSize),
+			//N-series are the gpu enabled vm sizes
+			GPU:     strings.HasPrefix(to.String(app.VMSize), "Standard_N"),
+			Desired: int64(to.Int32(app.Count)),
+		}
+		//aks does not report the node count of the pool, all the nodes are up once the pool is provisioned and running
+		if app.PowerState != nil && app.PowerState.Code == containerservice.CodeRunning && ng.Status == "succeeded" {
+			ng.Nodes = ng.Desired
+		}
+		if ng.Status == "failed" {
+			ng.Issues = 1
+		}
+		c.NodeGroups = append(c.NodeGroups, ng)
+	}
+	return c
+}
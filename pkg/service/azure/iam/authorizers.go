@@ -1,6 +1,10 @@
 package iam
 
 import (
+	"net/url"
+	"os"
+	"strings"
+
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/pkg/errors"
@@ -16,8 +20,16 @@ func GetResourceManagementAuthorizer(cred *system.AzureCredential) (autorest.Aut
 }
 
 func getAuthorizerForResource(cred *system.AzureCredential) (autorest.Authorizer, error) {
-	var a autorest.Authorizer
-	var err error
+	token, err := GetServicePrincipalToken(cred)
+	if err != nil {
+		return nil, err
+	}
+	return autorest.NewBearerAuthorizer(token), nil
+}
+
+//GetServicePrincipalToken token of the service principal for the resource manager of the configured azure cloud,
+//the token is acquired on first use
+func GetServicePrincipalToken(cred *system.AzureCredential) (*adal.ServicePrincipalToken, error) {
 	environments, err := azure.EnvironmentFromName(config.Get().AzureCloudProvider)
 
 	if err != nil {
@@ -29,15 +41,31 @@ func getAuthorizerForResource(cred *system.AzureCredential) (autorest.Authorizer
 		return nil, err
 	}
 
-	token, err := adal.NewServicePrincipalToken(*oauthConfig,
+	if cred.Federated {
+		return adal.NewServicePrincipalTokenWithSecret(*oauthConfig,
+			cred.ClientID,
+			environments.ResourceManagerEndpoint,
+			&federatedTokenSecret{file: system.ServiceAccountTokenFile})
+	}
+
+	return adal.NewServicePrincipalToken(*oauthConfig,
 		cred.ClientID,
 		cred.ClientSecret,
 		environments.ResourceManagerEndpoint)
+}
+
+//federatedTokenSecret authenticates the application with the oidc token trusted as its federated credential. the
+//token file is read on every refresh as the projected token is rotated by kubelet
+type federatedTokenSecret struct {
+	file string
+}
 
+func (s *federatedTokenSecret) SetAuthenticationValues(spt *adal.ServicePrincipalToken, v *url.Values) error {
+	token, err := os.ReadFile(s.file)
 	if err != nil {
-		return nil, err
+		return errors.Wrap(err, "error reading federated token")
 	}
-
-	a = autorest.NewBearerAuthorizer(token)
-	return a, nil
+	v.Set("client_assertion", strings.TrimSpace(string(token)))
+	v.Set("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
+	return nil
 }
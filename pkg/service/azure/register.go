@@ -0,0 +1,37 @@
+package azure
+
+import (
+	"github.com/netbookai/log"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/registry"
+)
+
+//regions azure public cloud regions
+var regions = []string{
+	"eastus", "eastus2", "southcentralus", "westus2", "westus3", "australiaeast", "southeastasia",
+	"northeurope", "swedencentral", "uksouth", "westeurope", "centralus", "southafricanorth", "centralindia",
+	"eastasia", "japaneast", "koreacentral", "canadacentral", "francecentral", "germanywestcentral",
+	"italynorth", "norwayeast", "polandcentral", "switzerlandnorth", "uaenorth", "brazilsouth", "israelcentral",
+	"qatarcentral", "northcentralus", "westus", "japanwest", "westcentralus", "southafricawest",
+	"australiacentral", "australiacentral2", "australiasoutheast", "jioindiacentral", "koreasouth",
+	"southindia", "westindia", "canadaeast", "francesouth", "germanynorth", "norwaywest", "switzerlandwest",
+	"ukwest", "uaecentral", "brazilsoutheast",
+}
+
+func init() {
+	registry.Register(registry.Provider{
+		Name: constants.AzureLabel,
+		Capabilities: []registry.Capability{
+			registry.CapCluster,
+			registry.CapNodePool,
+			registry.CapVolume,
+			registry.CapSnapshot,
+			registry.CapKubeConfig,
+			registry.CapCost,
+		},
+		Regions: regions,
+		New: func(logger log.Logger) registry.Controller {
+			return NewController(logger)
+		},
+	})
+}
@@ -0,0 +1,143 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/resources"
+	"github.com/Azure/azure-sdk-for-go/services/authorization/mgmt/2015-07-01/authorization"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/azure/iam"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/instrument"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/registry"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
+)
+
+const resourceGroupRead = "Microsoft.Resources/subscriptions/resourceGroups/read"
+
+//requiredActions resource manager operations spawner performs in the resource group
+var requiredActions = []string{
+	resourceGroupRead,
+	"Microsoft.ContainerService/managedClusters/read",
+	"Microsoft.ContainerService/managedClusters/write",
+	"Microsoft.ContainerService/managedClusters/delete",
+	"Microsoft.ContainerService/managedClusters/agentPools/write",
+	"Microsoft.ContainerService/managedClusters/agentPools/delete",
+	"Microsoft.Compute/disks/write",
+	"Microsoft.Compute/disks/delete",
+	"Microsoft.Compute/snapshots/write",
+	"Microsoft.Compute/snapshots/delete",
+}
+
+//actionPattern matches the operation against the action of the role, '*' matches any part of the operation
+func actionPattern(action string) *regexp.Regexp {
+	return regexp.MustCompile("(?i)^" + strings.ReplaceAll(regexp.QuoteMeta(action), `\*`, ".*") + "$")
+}
+
+func matchesAny(actions *[]string, operation string) bool {
+	if actions == nil {
+		return false
+	}
+	for _, a := range *actions {
+		if actionPattern(a).MatchString(operation) {
+			return true
+		}
+	}
+	return false
+}
+
+//missingActions required actions not granted by any of the permissions
+func missingActions(permissions []authorization.Permission) []string {
+	missing := []string{}
+	for _, operation := range requiredActions {
+		granted := false
+		for _, p := range permissions {
+			if matchesAny(p.Actions, operation) && !matchesAny(p.NotActions, operation) {
+				granted = true
+				break
+			}
+		}
+		if !granted {
+			missing = append(missing, operation)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+func statusCode(err error) int {
+	if derr, ok := err.(autorest.DetailedError); ok {
+		if code, ok := derr.StatusCode.(int); ok {
+			return code
+		}
+	}
+	return 0
+}
+
+//VerifyCredential acquires aad token of the service principal, reads the resource group and checks the
+//permissions granted to the principal on it
+func (a *azureController) VerifyCredential(ctx context.Context, cred system.Credentials) (*registry.Identity, error) {
+	c := cred.GetAzure()
+	if c == nil {
+		return nil, fmt.Errorf("%w: expected azure credential", registry.ErrInvalidCredential)
+	}
+
+	token, err := iam.GetServicePrincipalToken(c)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", registry.ErrInvalidCredential, err)
+	}
+	err = token.EnsureFreshWithContext(ctx)
+	if _, ok := err.(adal.TokenRefreshError); ok {
+		return nil, fmt.Errorf("%w: %v", registry.ErrInvalidCredential, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	authorizer := autorest.NewBearerAuthorizer(token)
+	identity := &registry.Identity{Principal: c.ClientID, Account: c.SubscriptionID}
+
+	groups := resources.NewGroupsClient(c.SubscriptionID)
+	groups.Authorizer = authorizer
+	groups.AddToUserAgent(constants.SpawnerServiceLabel)
+	instrument.Azure(&groups.Client)
+	_, err = groups.Get(ctx, c.ResourceGroup)
+	switch statusCode(err) {
+	case 0, http.StatusOK:
+		if err != nil {
+			return nil, err
+		}
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%w: resource group '%s' not found in subscription '%s'", registry.ErrInvalidCredential, c.ResourceGroup, c.SubscriptionID)
+	case http.StatusUnauthorized:
+		return nil, fmt.Errorf("%w: %v", registry.ErrInvalidCredential, err)
+	case http.StatusForbidden:
+		identity.MissingPermissions = []string{resourceGroupRead}
+		return identity, nil
+	default:
+		return nil, err
+	}
+
+	permissions := authorization.NewPermissionsClient(c.SubscriptionID)
+	permissions.Authorizer = authorizer
+	permissions.AddToUserAgent(constants.SpawnerServiceLabel)
+	instrument.Azure(&permissions.Client)
+	granted := []authorization.Permission{}
+	iter, err := permissions.ListForResourceGroupComplete(ctx, c.ResourceGroup)
+	for err == nil && iter.NotDone() {
+		granted = append(granted, iter.Value())
+		err = iter.NextWithContext(ctx)
+	}
+	if err != nil {
+		a.logger.Warn(ctx, "unable to check the permissions of the credential", "principal", c.ClientID, "error", err)
+		identity.PermissionCheckError = err.Error()
+		return identity, nil
+	}
+	identity.MissingPermissions = missingActions(granted)
+	return identity, nil
+}
@@ -0,0 +1,22 @@
+package azure
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/authorization/mgmt/2015-07-01/authorization"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_missingActions(t *testing.T) {
+	assert.Equal(t, 0, len(missingActions([]authorization.Permission{{Actions: &[]string{"*"}}})), "owner must have all the actions")
+
+	contributor := []authorization.Permission{{
+		Actions:    &[]string{"Microsoft.Resources/*", "microsoft.containerservice/*", "Microsoft.Compute/disks/*"},
+		NotActions: &[]string{"Microsoft.ContainerService/managedClusters/delete"},
+	}}
+	assert.Equal(t, []string{
+		"Microsoft.Compute/snapshots/delete",
+		"Microsoft.Compute/snapshots/write",
+		"Microsoft.ContainerService/managedClusters/delete",
+	}, missingActions(contributor))
+}
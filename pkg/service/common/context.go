@@ -0,0 +1,19 @@
+package common
+
+import "context"
+
+//detachedContext carries the values of the parent but not its deadline and cancellation
+type detachedContext struct {
+	context.Context
+	parent context.Context
+}
+
+func (d detachedContext) Value(key interface{}) interface{} {
+	return d.parent.Value(key)
+}
+
+//Detach returns context with the values of ctx, such as the trace and the principal, which is not cancelled along
+//with ctx. for the work which must outlive the request which started it
+func Detach(ctx context.Context) context.Context {
+	return detachedContext{Context: context.Background(), parent: ctx}
+}
@@ -0,0 +1,21 @@
+package common
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+//Reachable checks the endpoint responds, any http response including errors means the endpoint is reachable
+func Reachable(ctx context.Context, endpoint string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "'%s' is not reachable", endpoint)
+	}
+	return res.Body.Close()
+}
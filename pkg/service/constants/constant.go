@@ -22,6 +22,7 @@ var (
 	WorkspaceId              = "workspaceid"
 	AzureLabel               = "azure"
 	GcpLabel                 = "gcp"
+	FakeLabel                = "fake"
 )
 
 type CloudProvider string
@@ -30,11 +31,14 @@ const (
 	AwsCloud   CloudProvider = "aws"
 	AzureCloud CloudProvider = "azure"
 	GcpCloud   CloudProvider = "gcp"
+	FakeCloud  CloudProvider = "fake"
 )
 
 const (
 	Active   = "active"
 	Inactive = "inactive"
+	//Failed cluster status reported by azure when the provisioning failed, gcp reports 'error' or 'degraded'
+	Failed = "failed"
 )
 
 const ActualCost string = "ActualCost"
@@ -49,9 +53,12 @@ const (
 //cred type
 
 const (
-	CredAws    = "aws"
-	CredAzure  = "azure"
-	CredGitPat = "git-pat"
+	CredAws            = "aws"
+	CredAwsRole        = "aws-role"
+	CredAzure          = "azure"
+	CredAzureFederated = "azure-federated"
+	CredGcpFederated   = "gcp-federated"
+	CredGitPat         = "git-pat"
 )
 
 const (
@@ -6,4 +6,13 @@ import "fmt"
 
 const InvalidInstanceOrMachineType = "invalid instance, must provide valid instance by specifying MachineType or Instance as per provider specification"
 
-var ErrInvalidCredentiualType = fmt.Errorf("invalid credentials type provided, must be one of ['%s', '%s', '%s']", CredAws, CredAzure, CredGitPat)
+var ErrInvalidCredentiualType = fmt.Errorf("invalid credentials type provided, must be one of ['%s', '%s', '%s', '%s', '%s', '%s', '%s']",
+	CredAws, CredAwsRole, CredAzure, CredAzureFederated, GcpLabel, CredGcpFederated, CredGitPat)
+
+var ErrInvalidCredentialStage = fmt.Errorf("invalid credential stage provided, must be one of ['current', 'pending', 'previous']")
+
+var ErrRevealReasonRequired = fmt.Errorf("reason is required to reveal the credential secrets")
+
+var ErrRevealNotAudited = fmt.Errorf("credential secrets can be revealed only when authentication and audit sinks are configured")
+
+var ErrClusterFailed = fmt.Errorf("cluster failed to become active")
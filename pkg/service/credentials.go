@@ -0,0 +1,531 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"gitlab.com/netbook-devs/spawner-service/pkg/audit"
+	"gitlab.com/netbook-devs/spawner-service/pkg/auth"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/registry"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//credTypes types of the credentials stored by spawner
+var credTypes = []string{constants.CredAws, constants.CredAwsRole, constants.CredAzure, constants.CredAzureFederated,
+	constants.GcpLabel, constants.CredGcpFederated, constants.CredGitPat}
+
+func validCredStage(stage string) bool {
+	switch stage {
+	case system.StageCurrent, system.StagePending, system.StagePrevious:
+		return true
+	}
+	return false
+}
+
+//credentialRequest requests carrying the credential, such as write and rotate
+type credentialRequest interface {
+	GetAwsCred() *proto.AwsCredentials
+	GetAwsRoleCred() *proto.AwsRoleCredentials
+	GetAzureCred() *proto.AzureCredentials
+	GetAzureFederatedCred() *proto.AzureFederatedCredentials
+	GetGitPat() *proto.GithubPersonalAccessToken
+	GetGcpCred() *proto.GcpCredentials
+	GetGcpFederatedCred() *proto.GcpFederatedCredentials
+}
+
+//newCredential credential of the type set in the request
+func newCredential(account, credType string, req credentialRequest) (system.Credentials, error) {
+	var cred system.Credentials
+	cred_type := "unknown"
+
+	switch credType {
+
+	case constants.CredAws:
+
+		cred_type = "AwsCredential"
+		if c := req.GetAwsCred(); c != nil {
+			cred = &system.AwsCredential{
+				Name:   account,
+				Id:     c.GetAccessKeyID(),
+				Secret: c.GetSecretAccessKey(),
+				Token:  c.GetToken(),
+			}
+		}
+
+	case constants.CredAwsRole:
+		cred_type = "AwsRoleCredential"
+		if c := req.GetAwsRoleCred(); c != nil {
+			if _, err := arn.Parse(c.GetRoleArn()); err != nil {
+				return nil, fmt.Errorf("invalid role arn '%s'", c.GetRoleArn())
+			}
+			if c.GetSessionPolicy() != "" && !json.Valid([]byte(c.GetSessionPolicy())) {
+				return nil, fmt.Errorf("session policy must be a json document")
+			}
+			cred = &system.AwsRoleCredential{
+				Name:          account,
+				RoleArn:       c.GetRoleArn(),
+				ExternalID:    c.GetExternalID(),
+				SessionPolicy: c.GetSessionPolicy(),
+			}
+		}
+
+	case constants.CredAzure:
+		cred_type = "AzureCredential"
+		if c := req.GetAzureCred(); c != nil {
+			cred = &system.AzureCredential{
+				SubscriptionID: c.GetSubscriptionID(),
+				TenantID:       c.GetTenantID(),
+				ClientID:       c.GetClientID(),
+				ClientSecret:   c.GetClientSecret(),
+				ResourceGroup:  c.GetResourceGroup(),
+				Name:           account,
+			}
+		}
+	case constants.CredAzureFederated:
+		cred_type = "AzureFederatedCredential"
+		if c := req.GetAzureFederatedCred(); c != nil {
+			cred = &system.AzureCredential{
+				SubscriptionID: c.GetSubscriptionID(),
+				TenantID:       c.GetTenantID(),
+				ClientID:       c.GetClientID(),
+				ResourceGroup:  c.GetResourceGroup(),
+				Name:           account,
+				Federated:      true,
+			}
+		}
+	case constants.CredGitPat:
+		cred_type = "GithubPersonalAccessToken"
+		if c := req.GetGitPat(); c != nil {
+			cred = &system.GithubPersonalAccessToken{
+				Name:  account,
+				Token: c.Token,
+			}
+		}
+	case constants.GcpLabel:
+		cred_type = "GcpCredential"
+		if c := req.GetGcpCred(); c != nil {
+
+			cred = &system.GCPCredential{
+				Name:        account,
+				ProjectId:   c.GetProjectID(),
+				Certificate: c.GetCertificate(),
+			}
+		}
+	case constants.CredGcpFederated:
+		cred_type = "GcpFederatedCredential"
+		if c := req.GetGcpFederatedCred(); c != nil {
+			if c.GetAudience() == "" {
+				return nil, fmt.Errorf("audience of the workload identity pool provider must be set")
+			}
+			cred = &system.GCPCredential{
+				Name:           account,
+				ProjectId:      c.GetProjectID(),
+				Audience:       c.GetAudience(),
+				ServiceAccount: c.GetServiceAccount(),
+			}
+		}
+	default:
+		return nil, fmt.Errorf("invalid provider '%s'", credType)
+	}
+
+	if cred == nil {
+		return nil, fmt.Errorf(" %s credentials must be set for type '%s'", cred_type, credType)
+
+	}
+	return cred, nil
+}
+
+func credentialIdentity(i *registry.Identity) *proto.CredentialIdentity {
+	return &proto.CredentialIdentity{
+		Principal:            i.Principal,
+		Account:              i.Account,
+		MissingPermissions:   i.MissingPermissions,
+		PermissionCheckError: i.PermissionCheckError,
+	}
+}
+
+//verifyCredential checks the credential with the provider of its type, the provider is named same as the type
+//except for the aws roles and the federated credentials
+func (s *spawnerService) verifyCredential(ctx context.Context, credType string, cred system.Credentials) (*proto.CredentialIdentity, error) {
+	provider := credType
+	switch credType {
+	case constants.CredAws, constants.CredAzure, constants.GcpLabel:
+	case constants.CredAwsRole:
+		provider = constants.CredAws
+	case constants.CredAzureFederated:
+		provider = constants.CredAzure
+	case constants.CredGcpFederated:
+		provider = constants.GcpLabel
+	default:
+		return nil, fmt.Errorf("%w, '%s' credentials cannot be verified", registry.ErrNotSupported, credType)
+	}
+
+	identity, err := s.providers.VerifyCredential(ctx, provider, cred)
+	if err != nil {
+		return nil, err
+	}
+	return credentialIdentity(identity), nil
+}
+
+//verifyBeforeStore verifies the credential about to be stored, credentials which cannot be verified, such as the
+//git tokens or the ones of the disabled providers, are stored without verification
+func (s *spawnerService) verifyBeforeStore(ctx context.Context, account, credType string, cred system.Credentials) (*proto.CredentialIdentity, error) {
+	identity, err := s.verifyCredential(ctx, credType, cred)
+	if errors.Is(err, registry.ErrNotSupported) || errors.Is(err, registry.ErrProviderNotFound) {
+		s.logger.Warn(ctx, "storing credentials without verification", "account", account, "credential_type", credType, "reason", err)
+		return nil, nil
+	}
+	if err != nil {
+		s.logger.Error(ctx, "credentials verification failed", "account", account, "credential_type", credType, "error", err)
+		return nil, err
+	}
+	if len(identity.MissingPermissions) > 0 {
+		s.logger.Warn(ctx, "credentials are missing permissions", "account", account, "credential_type", credType,
+			"principal", identity.Principal, "missing", identity.MissingPermissions)
+	}
+	return identity, nil
+}
+
+func credentialVersion(v system.SecretVersion) *proto.CredentialVersion {
+	return &proto.CredentialVersion{Id: v.ID, Stages: v.Stages, CreatedAt: v.CreatedAt.Unix()}
+}
+
+//ListCredentials lists the stored credentials of the account and type, all of them when not set
+func (s *spawnerService) ListCredentials(ctx context.Context, req *proto.ListCredentialsRequest) (*proto.ListCredentialsResponse, error) {
+	types := credTypes
+	if req.GetType() != "" {
+		if !validCredType(req.GetType()) {
+			return nil, constants.ErrInvalidCredentiualType
+		}
+		types = []string{req.GetType()}
+	}
+
+	res := &proto.ListCredentialsResponse{Credentials: []*proto.CredentialInfo{}}
+	for _, credType := range types {
+		creds, err := system.ListCredentials(ctx, credType)
+		if err != nil {
+			s.logger.Error(ctx, "failed to list credentials", "credential_type", credType, "error", err)
+			return nil, err
+		}
+		for _, c := range creds {
+			if req.GetAccount() != "" && c.Account != req.GetAccount() {
+				continue
+			}
+			res.Credentials = append(res.Credentials, &proto.CredentialInfo{Account: c.Account, Type: c.Type, UpdatedAt: c.UpdatedAt.Unix()})
+		}
+	}
+	sort.Slice(res.Credentials, func(i, j int) bool {
+		a, b := res.Credentials[i], res.Credentials[j]
+		if a.Account != b.Account {
+			return a.Account < b.Account
+		}
+		return a.Type < b.Type
+	})
+	return res, nil
+}
+
+//DeleteCredential removes the credential of the account along with all its versions
+func (s *spawnerService) DeleteCredential(ctx context.Context, req *proto.DeleteCredentialRequest) (*proto.DeleteCredentialResponse, error) {
+	if !validCredType(req.GetType()) {
+		return nil, constants.ErrInvalidCredentiualType
+	}
+
+	if err := system.DeleteCredential(ctx, req.GetAccount(), req.GetType()); err != nil {
+		s.logger.Error(ctx, "failed to delete credentials", "account", req.GetAccount(), "credential_type", req.GetType(), "error", err)
+		return nil, err
+	}
+	s.logger.Info(ctx, "credentials deleted", "account", req.GetAccount(), "credential_type", req.GetType())
+	return &proto.DeleteCredentialResponse{}, nil
+}
+
+//RotateCredential stages the new credential as pending, and promotes the pending one to current on confirm
+func (s *spawnerService) RotateCredential(ctx context.Context, req *proto.RotateCredentialRequest) (*proto.RotateCredentialResponse, error) {
+	account := req.GetAccount()
+	credType := req.GetType()
+	if !validCredType(credType) {
+		return nil, constants.ErrInvalidCredentiualType
+	}
+	if req.GetCred() == nil && !req.GetConfirm() {
+		return nil, fmt.Errorf("new credential must be set to start the rotation, or confirm to complete it")
+	}
+
+	res := &proto.RotateCredentialResponse{}
+	var version string
+	if req.GetCred() != nil {
+		cred, err := newCredential(account, credType, req)
+		if err != nil {
+			return nil, err
+		}
+		if !req.GetSkipVerification() {
+			res.Identity, err = s.verifyBeforeStore(ctx, account, credType, cred)
+			if err != nil {
+				return nil, err
+			}
+		}
+		version, err = system.StageCredential(ctx, account, credType, cred)
+		if err != nil {
+			s.logger.Error(ctx, "failed to stage credentials", "account", account, "credential_type", credType, "error", err)
+			return nil, err
+		}
+		s.logger.Info(ctx, "credentials staged for rotation", "account", account, "credential_type", credType, "version", version)
+	}
+
+	if req.GetConfirm() {
+		var err error
+		version, err = system.PromoteCredential(ctx, account, credType)
+		if err != nil {
+			s.logger.Error(ctx, "failed to promote credentials", "account", account, "credential_type", credType, "error", err)
+			return nil, err
+		}
+		s.logger.Info(ctx, "credentials rotated", "account", account, "credential_type", credType, "version", version)
+	}
+
+	versions, err := system.CredentialVersions(ctx, account, credType)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range versions {
+		if v.ID == version {
+			res.Version = credentialVersion(v)
+		}
+	}
+	return res, nil
+}
+
+//ListCredentialVersions current, pending and previous versions of the credential, newest first
+func (s *spawnerService) ListCredentialVersions(ctx context.Context, req *proto.ListCredentialVersionsRequest) (*proto.ListCredentialVersionsResponse, error) {
+	if !validCredType(req.GetType()) {
+		return nil, constants.ErrInvalidCredentiualType
+	}
+
+	versions, err := system.CredentialVersions(ctx, req.GetAccount(), req.GetType())
+	if err != nil {
+		s.logger.Error(ctx, "failed to list credential versions", "account", req.GetAccount(), "credential_type", req.GetType(), "error", err)
+		return nil, err
+	}
+	res := &proto.ListCredentialVersionsResponse{Versions: make([]*proto.CredentialVersion, 0, len(versions))}
+	for _, v := range versions {
+		res.Versions = append(res.Versions, credentialVersion(v))
+	}
+	return res, nil
+}
+
+//VerifyCredential checks the stored credential with the provider
+func (s *spawnerService) VerifyCredential(ctx context.Context, req *proto.VerifyCredentialRequest) (*proto.VerifyCredentialResponse, error) {
+	account := req.GetAccount()
+	credType := req.GetType()
+	if !validCredType(credType) {
+		return nil, constants.ErrInvalidCredentiualType
+	}
+	stage := req.GetStage()
+	if stage == "" {
+		stage = system.StageCurrent
+	}
+	if !validCredStage(stage) {
+		return nil, constants.ErrInvalidCredentialStage
+	}
+
+	cred, err := s.getCredentials(ctx, account, credType, stage)
+	if err != nil {
+		return nil, err
+	}
+	identity, err := s.verifyCredential(ctx, credType, cred)
+	if err != nil {
+		s.logger.Error(ctx, "credentials verification failed", "account", account, "credential_type", credType, "stage", stage, "error", err)
+		return nil, err
+	}
+	return &proto.VerifyCredentialResponse{Identity: identity}, nil
+}
+
+//secretMask shown in place of the masked secrets
+const secretMask = "****"
+
+//mask hides the secret, the last visible characters are kept when the secret is long enough for them to not give
+//it away
+func mask(secret string, visible int) string {
+	if secret == "" {
+		return ""
+	}
+	if len(secret) < 4*visible || visible == 0 {
+		return secretMask
+	}
+	return secretMask + secret[len(secret)-visible:]
+}
+
+//fingerprint of the credential, tells if two reads return the same credential without disclosing it
+func fingerprint(cred system.Credentials) string {
+	sum := sha256.Sum256([]byte(cred.AsSecretValue()))
+	return "sha256:" + hex.EncodeToString(sum[:16])
+}
+
+//gcpKeyID id of the service account key
+func gcpKeyID(certificate string) string {
+	key := struct {
+		PrivateKeyID string `json:"private_key_id"`
+	}{}
+	_ = json.Unmarshal([]byte(certificate), &key)
+	return key.PrivateKeyID
+}
+
+//credentialResponse view of the credential, secret fields are masked unless revealed
+func credentialResponse(account, credType string, creds system.Credentials, reveal bool) *proto.ReadCredentialResponse {
+	secret := func(value string, visible int) string {
+		if reveal {
+			return value
+		}
+		return mask(value, visible)
+	}
+
+	p := &proto.ReadCredentialResponse{
+		Account:     account,
+		Type:        credType,
+		Fingerprint: fingerprint(creds),
+		Redacted:    !reveal,
+	}
+
+	switch credType {
+	case constants.CredAws:
+		c := creds.GetAws()
+		p.KeyID = c.Id
+		p.Cred = &proto.ReadCredentialResponse_AwsCred{
+			AwsCred: &proto.AwsCredentials{
+				AccessKeyID:     c.Id,
+				SecretAccessKey: secret(c.Secret, 4),
+				Token:           secret(c.Token, 0),
+			},
+		}
+
+	case constants.CredAwsRole:
+		c := creds.GetAwsRole()
+		p.KeyID = c.RoleArn
+		p.Cred = &proto.ReadCredentialResponse_AwsRoleCred{
+			AwsRoleCred: &proto.AwsRoleCredentials{
+				RoleArn:       c.RoleArn,
+				ExternalID:    secret(c.ExternalID, 4),
+				SessionPolicy: c.SessionPolicy,
+			},
+		}
+
+	case constants.CredAzure:
+		c := creds.GetAzure()
+		p.KeyID = c.ClientID
+		p.Cred = &proto.ReadCredentialResponse_AzureCred{
+			AzureCred: &proto.AzureCredentials{
+				SubscriptionID: c.SubscriptionID,
+				TenantID:       c.TenantID,
+				ClientID:       c.ClientID,
+				ClientSecret:   secret(c.ClientSecret, 4),
+				ResourceGroup:  c.ResourceGroup,
+			},
+		}
+
+	case constants.CredAzureFederated:
+		c := creds.GetAzure()
+		p.KeyID = c.ClientID
+		p.Cred = &proto.ReadCredentialResponse_AzureFederatedCred{
+			AzureFederatedCred: &proto.AzureFederatedCredentials{
+				SubscriptionID: c.SubscriptionID,
+				TenantID:       c.TenantID,
+				ClientID:       c.ClientID,
+				ResourceGroup:  c.ResourceGroup,
+			},
+		}
+
+	case constants.CredGitPat:
+		c := creds.GetGitPAT()
+		p.Cred = &proto.ReadCredentialResponse_GitPat{
+			GitPat: &proto.GithubPersonalAccessToken{
+				Token: secret(c.Token, 4),
+			},
+		}
+	case constants.GcpLabel:
+		c := creds.GetGcp()
+		p.KeyID = gcpKeyID(c.Certificate)
+		p.Cred = &proto.ReadCredentialResponse_GcpCred{
+			GcpCred: &proto.GcpCredentials{
+				ProjectID:   c.ProjectId,
+				Certificate: secret(c.Certificate, 0),
+			},
+		}
+	case constants.CredGcpFederated:
+		c := creds.GetGcp()
+		p.KeyID = c.ServiceAccount
+		if p.KeyID == "" {
+			p.KeyID = c.Audience
+		}
+		p.Cred = &proto.ReadCredentialResponse_GcpFederatedCred{
+			GcpFederatedCred: &proto.GcpFederatedCredentials{
+				ProjectID:      c.ProjectId,
+				Audience:       c.Audience,
+				ServiceAccount: c.ServiceAccount,
+			},
+		}
+	}
+	return p
+}
+
+//readCredential credential in stage along with its version and the time it was written
+func (s *spawnerService) readCredential(ctx context.Context, account, credType, stage string, reveal bool) (*proto.ReadCredentialResponse, error) {
+	if !validCredType(credType) {
+		return nil, constants.ErrInvalidCredentiualType
+	}
+	if stage == "" {
+		stage = system.StageCurrent
+	}
+	if !validCredStage(stage) {
+		return nil, constants.ErrInvalidCredentialStage
+	}
+
+	creds, err := s.getCredentials(ctx, account, credType, stage)
+	if err != nil {
+		s.logger.Error(ctx, "failed to get the credentials", "account", account, "error", err)
+		return nil, err
+	}
+	p := credentialResponse(account, credType, creds, reveal)
+
+	versions, err := system.CredentialVersions(ctx, account, credType)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list credential versions", "account", account, "credential_type", credType, "error", err)
+		return nil, err
+	}
+	for _, v := range versions {
+		for _, st := range v.Stages {
+			if st == stage {
+				p.Version = v.ID
+				p.RotatedAt = v.CreatedAt.Unix()
+			}
+		}
+	}
+
+	s.logger.Debug(ctx, "credentials found", "account", account, "credential_type", credType)
+	return p, nil
+}
+
+//RevealCredential credential along with the secrets, the reason is recorded in the audit log with the call. refused
+//unless the call is authenticated and recorded to an audit sink
+func (s *spawnerService) RevealCredential(ctx context.Context, req *proto.RevealCredentialRequest) (*proto.ReadCredentialResponse, error) {
+	if !auth.Enabled(ctx) || !audit.Audited(ctx) {
+		return nil, constants.ErrRevealNotAudited
+	}
+	if strings.TrimSpace(req.GetReason()) == "" {
+		return nil, constants.ErrRevealReasonRequired
+	}
+
+	res, err := s.readCredential(ctx, req.GetAccount(), req.GetType(), req.GetStage(), true)
+	if err != nil {
+		return nil, err
+	}
+	principal, _ := auth.FromContext(ctx)
+	s.logger.Warn(ctx, "credential secrets revealed", "account", req.GetAccount(), "credential_type", req.GetType(),
+		"principal", principal, "reason", req.GetReason())
+	return res, nil
+}
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/netbookai/log"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/netbook-devs/spawner-service/pkg/audit"
+	"gitlab.com/netbook-devs/spawner-service/pkg/auth"
+	"gitlab.com/netbook-devs/spawner-service/pkg/config"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func Test_RotateCredential(t *testing.T) {
+	store, err := system.NewSecretStore(config.Config{
+		SecretStore:    system.StoreFile,
+		SecretFilePath: filepath.Join(t.TempDir(), "secrets"),
+		SecretFileKey:  base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef")),
+	})
+	assert.Nil(t, err)
+	system.SetStore(store)
+	defer system.SetStore(nil)
+
+	s := &spawnerService{logger: log.GetLogger()}
+	ctx := context.Background()
+	awsCred := func(id string) *proto.AwsCredentials {
+		return &proto.AwsCredentials{AccessKeyID: id, SecretAccessKey: "s3cr3t"}
+	}
+	accessKey := func(stage string) string {
+		res, err := s.ReadCredential(ctx, &proto.ReadCredentialRequest{Account: "team-a", Type: "aws", Stage: stage})
+		if err != nil {
+			return ""
+		}
+		return res.GetAwsCred().AccessKeyID
+	}
+
+	_, err = s.WriteCredential(ctx, &proto.WriteCredentialRequest{Account: "team-a", Type: "aws", Cred: &proto.WriteCredentialRequest_AwsCred{AwsCred: awsCred("AKIA1")}, SkipVerification: true})
+	assert.Nil(t, err)
+
+	res, err := s.RotateCredential(ctx, &proto.RotateCredentialRequest{Account: "team-a", Type: "aws", Cred: &proto.RotateCredentialRequest_AwsCred{AwsCred: awsCred("AKIA2")}, SkipVerification: true})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{system.StagePending}, res.Version.Stages)
+	assert.Equal(t, "AKIA1", accessKey(""), "current credential must be used till the rotation is confirmed")
+	assert.Equal(t, "AKIA2", accessKey(system.StagePending))
+
+	res, err = s.RotateCredential(ctx, &proto.RotateCredentialRequest{Account: "team-a", Type: "aws", Confirm: true})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{system.StageCurrent}, res.Version.Stages)
+	assert.Equal(t, "AKIA2", accessKey(""))
+	assert.Equal(t, "AKIA1", accessKey(system.StagePrevious))
+
+	read, err := s.ReadCredential(ctx, &proto.ReadCredentialRequest{Account: "team-a", Type: "aws"})
+	assert.Nil(t, err)
+	assert.True(t, read.Redacted)
+	assert.Equal(t, "****", read.GetAwsCred().SecretAccessKey, "secret must be masked")
+	assert.Equal(t, "AKIA2", read.KeyID)
+	assert.Equal(t, res.Version.Id, read.Version)
+	assert.Equal(t, res.Version.CreatedAt, read.RotatedAt)
+
+	reveal := &proto.RevealCredentialRequest{Account: "team-a", Type: "aws", Reason: "migrating the account"}
+	_, err = s.RevealCredential(ctx, reveal)
+	assert.Equal(t, constants.ErrRevealNotAudited, err, "secrets must not be revealed without authentication and audit")
+
+	//reveal through the audit and auth interceptors, as served
+	sum := sha256.Sum256([]byte("t0ken"))
+	authenticator, err := auth.New(&auth.Policy{
+		Tokens: []auth.Token{{Principal: "security", Sha256: hex.EncodeToString(sum[:])}},
+		Rules:  []auth.Rule{{Principals: []string{"security"}, Methods: []string{"RevealCredential"}}},
+	})
+	assert.Nil(t, err)
+	var out bytes.Buffer
+	auditor := audit.New(log.GetLogger(), []string{"RevealCredential"}, audit.NewWriterSink(&out)).WithPrincipal(authenticator.Authenticate)
+	info := &grpc.UnaryServerInfo{FullMethod: "/spawner.SpawnerService/RevealCredential"}
+	served := func(req *proto.RevealCredentialRequest) (*proto.ReadCredentialResponse, error) {
+		ctx := metadata.NewIncomingContext(ctx, metadata.Pairs(auth.MetadataKey, "Bearer t0ken"))
+		res, err := auditor.Interceptor()(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return authenticator.Interceptor()(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return s.RevealCredential(ctx, req.(*proto.RevealCredentialRequest))
+			})
+		})
+		if err != nil {
+			return nil, err
+		}
+		return res.(*proto.ReadCredentialResponse), nil
+	}
+
+	_, err = served(&proto.RevealCredentialRequest{Account: "team-a", Type: "aws"})
+	assert.Equal(t, constants.ErrRevealReasonRequired, err)
+	out.Reset()
+	revealed, err := served(reveal)
+	assert.Nil(t, err)
+	record := audit.Record{}
+	assert.Nil(t, json.Unmarshal(out.Bytes(), &record))
+	assert.Equal(t, "security", record.Principal)
+	assert.Equal(t, reveal.Reason, record.Reason)
+	assert.False(t, revealed.Redacted)
+	assert.Equal(t, "s3cr3t", revealed.GetAwsCred().SecretAccessKey)
+	assert.Equal(t, read.Fingerprint, revealed.Fingerprint)
+
+	versions, err := s.ListCredentialVersions(ctx, &proto.ListCredentialVersionsRequest{Account: "team-a", Type: "aws"})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(versions.Versions))
+
+	list, err := s.ListCredentials(ctx, &proto.ListCredentialsRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(list.Credentials))
+	assert.Equal(t, "team-a", list.Credentials[0].Account)
+	assert.Equal(t, "aws", list.Credentials[0].Type)
+
+	_, err = s.DeleteCredential(ctx, &proto.DeleteCredentialRequest{Account: "team-a", Type: "aws"})
+	assert.Nil(t, err)
+	list, _ = s.ListCredentials(ctx, &proto.ListCredentialsRequest{})
+	assert.Equal(t, 0, len(list.Credentials))
+}
+
+func Test_WriteGcpCredential(t *testing.T) {
+	store, err := system.NewSecretStore(config.Config{
+		SecretStore:    system.StoreFile,
+		SecretFilePath: filepath.Join(t.TempDir(), "secrets"),
+		SecretFileKey:  base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef")),
+	})
+	assert.Nil(t, err)
+	system.SetStore(store)
+	defer system.SetStore(nil)
+
+	s := &spawnerService{logger: log.GetLogger()}
+	ctx := context.Background()
+	cert := `{"type":"service_account","private_key_id":"key-1"}`
+
+	_, err = s.WriteCredential(ctx, &proto.WriteCredentialRequest{
+		Account:          "team-a",
+		Type:             "gcp",
+		Cred:             &proto.WriteCredentialRequest_GcpCred{GcpCred: &proto.GcpCredentials{ProjectID: "project", Certificate: cert}},
+		SkipVerification: true,
+	})
+	assert.Nil(t, err)
+
+	read, err := s.ReadCredential(ctx, &proto.ReadCredentialRequest{Account: "team-a", Type: "gcp"})
+	assert.Nil(t, err)
+	assert.Equal(t, "project", read.GetGcpCred().ProjectID)
+	assert.Equal(t, "key-1", read.KeyID)
+
+	list, err := s.ListCredentials(ctx, &proto.ListCredentialsRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(list.Credentials))
+	assert.Equal(t, "gcp", list.Credentials[0].Type)
+}
+
+func Test_mask(t *testing.T) {
+	assert.Equal(t, "", mask("", 4))
+	assert.Equal(t, "****", mask("short", 4))
+	assert.Equal(t, "****cdef", mask("0123456789abcdef", 4))
+	assert.Equal(t, "****", mask("0123456789abcdef", 0))
+}
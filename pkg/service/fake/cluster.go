@@ -0,0 +1,386 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//resource states, same as the one reported by EKS
+const (
+	StatusCreating = "CREATING"
+	StatusActive   = "ACTIVE"
+	StatusDeleting = "DELETING"
+)
+
+var (
+	ErrClusterExist     = errors.New("cluster already exist")
+	ErrClusterNotFound  = errors.New("cluster not found")
+	ErrClusterNotActive = errors.New("cluster is not active")
+	ErrNodegroupExist   = errors.New("nodegroup already exist")
+	ErrNodegroupMissing = errors.New("nodegroup not found")
+	ErrClusterHasNodes  = errors.New("cluster has nodegroups attached, use force delete")
+)
+
+//lifecycle tracks the transition of a resource from CREATING to ACTIVE and DELETING to gone
+type lifecycle struct {
+	readyAt time.Time
+	goneAt  time.Time
+}
+
+func (l *lifecycle) status(now time.Time) string {
+	if !l.goneAt.IsZero() {
+		return StatusDeleting
+	}
+	if now.Before(l.readyAt) {
+		return StatusCreating
+	}
+	return StatusActive
+}
+
+func (l *lifecycle) gone(now time.Time) bool {
+	return !l.goneAt.IsZero() && !now.Before(l.goneAt)
+}
+
+type nodepool struct {
+	lifecycle
+	spec *proto.NodeSpec
+}
+
+type cluster struct {
+	lifecycle
+	id        string
+	name      string
+	account   string
+	region    string
+	labels    map[string]string
+	oidc      bool
+	nodepools map[string]*nodepool
+}
+
+//sweep removes all the resources which completed the deletion, must be called with lock held
+func (f *Controller) sweep() {
+	now := f.now()
+	for k, c := range f.clusters {
+		if c.gone(now) {
+			delete(f.clusters, k)
+			continue
+		}
+		for n, np := range c.nodepools {
+			if np.gone(now) {
+				delete(c.nodepools, n)
+			}
+		}
+	}
+}
+
+//getCluster return cluster if exist, must be called with lock held
+func (f *Controller) getCluster(account, region, name string) (*cluster, error) {
+	f.sweep()
+	c, ok := f.clusters[key(account, region, name)]
+	if !ok {
+		return nil, errors.Wrapf(ErrClusterNotFound, "cluster '%s' in region '%s'", name, region)
+	}
+	return c, nil
+}
+
+func (f *Controller) newLifecycle() lifecycle {
+	return lifecycle{readyAt: f.now().Add(f.opts.TransitionDelay)}
+}
+
+func (f *Controller) markDeleted(l *lifecycle) {
+	if l.goneAt.IsZero() {
+		l.goneAt = f.now().Add(f.opts.TransitionDelay)
+	}
+}
+
+func copyNodeSpec(spec *proto.NodeSpec) *proto.NodeSpec {
+	labels := make(map[string]string, len(spec.Labels))
+	for k, v := range spec.Labels {
+		labels[k] = v
+	}
+	count := spec.Count
+	if count == 0 {
+		count = 1
+	}
+	return &proto.NodeSpec{
+		Name:          spec.Name,
+		Instance:      spec.Instance,
+		MachineType:   spec.MachineType,
+		DiskSize:      spec.DiskSize,
+		Labels:        labels,
+		GpuEnabled:    spec.GpuEnabled,
+		MigProfile:    spec.MigProfile,
+		Count:         count,
+		CapacityType:  spec.CapacityType,
+		SpotInstances: spec.SpotInstances,
+	}
+}
+
+func (f *Controller) CreateCluster(ctx context.Context, req *proto.ClusterRequest) (*proto.ClusterResponse, error) {
+	if err := f.simulate(ctx, "CreateCluster"); err != nil {
+		return nil, err
+	}
+
+	clusterName := req.ClusterName
+	if clusterName == "" {
+		clusterName = fmt.Sprintf("%s-%s", req.Provider, req.Region)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.sweep()
+	k := key(req.AccountName, req.Region, clusterName)
+	if _, ok := f.clusters[k]; ok {
+		f.logger.Info(ctx, "cluster already exist", "cluster", clusterName)
+		return nil, ErrClusterExist
+	}
+
+	labels := make(map[string]string, len(req.Labels))
+	for k, v := range req.Labels {
+		labels[k] = v
+	}
+	c := &cluster{
+		lifecycle: f.newLifecycle(),
+		id:        f.nextID("cluster"),
+		name:      clusterName,
+		account:   req.AccountName,
+		region:    req.Region,
+		labels:    labels,
+		nodepools: make(map[string]*nodepool),
+	}
+
+	resp := &proto.ClusterResponse{ClusterName: clusterName}
+	if req.Node != nil && req.Node.Name != "" {
+		c.nodepools[req.Node.Name] = &nodepool{
+			lifecycle: c.lifecycle,
+			spec:      copyNodeSpec(req.Node),
+		}
+		resp.NodeGroupName = req.Node.Name
+	}
+	f.clusters[k] = c
+
+	f.logger.Info(ctx, "fake: cluster is in creating state", "cluster", clusterName, "region", req.Region)
+	return resp, nil
+}
+
+func (f *Controller) GetCluster(ctx context.Context, req *proto.GetClusterRequest) (*proto.ClusterSpec, error) {
+	if err := f.simulate(ctx, "GetCluster"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, err := f.getCluster(req.AccountName, req.Region, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	now := f.now()
+	nodes := []*proto.NodeSpec{}
+	for _, np := range c.nodepools {
+		state := constants.Inactive
+		if np.status(now) == StatusActive {
+			state = constants.Active
+		}
+		for i := int64(0); i < np.spec.Count; i++ {
+			hostName := fmt.Sprintf("%s-%s-%d", c.name, np.spec.Name, i)
+			nodes = append(nodes, &proto.NodeSpec{
+				Name:             np.spec.Name,
+				Instance:         np.spec.Instance,
+				DiskSize:         np.spec.DiskSize,
+				HostName:         hostName,
+				State:            state,
+				Uuid:             fmt.Sprintf("%s-%s", c.id, hostName),
+				IpAddr:           fmt.Sprintf("10.0.%d.%d", len(np.spec.Name)%255, i+1),
+				Availabilityzone: fmt.Sprintf("%s-a", c.region),
+				ClusterId:        c.id,
+				Labels:           np.spec.Labels,
+				GpuEnabled:       np.spec.GpuEnabled,
+				Health:           &proto.Health{},
+			})
+		}
+	}
+
+	return &proto.ClusterSpec{
+		Name:      c.name,
+		ClusterId: c.id,
+		NodeSpec:  nodes,
+	}, nil
+}
+
+//GetClusters return active clusters in the region
+func (f *Controller) GetClusters(ctx context.Context, req *proto.GetClustersRequest) (*proto.GetClustersResponse, error) {
+	if err := f.simulate(ctx, "GetClusters"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.sweep()
+	now := f.now()
+	resp := &proto.GetClustersResponse{
+		Clusters: []*proto.ClusterSpec{},
+	}
+	for _, c := range f.clusters {
+		if c.account != req.AccountName || c.region != req.Region || c.status(now) != StatusActive {
+			continue
+		}
+		nodes := []*proto.NodeSpec{}
+		for _, np := range c.nodepools {
+			nodes = append(nodes, &proto.NodeSpec{
+				Name:     np.spec.Name,
+				Instance: np.spec.Instance,
+				DiskSize: np.spec.DiskSize,
+				Count:    np.spec.Count,
+				State:    np.status(now),
+				Health:   &proto.Health{},
+			})
+		}
+		resp.Clusters = append(resp.Clusters, &proto.ClusterSpec{
+			Name:      c.name,
+			ClusterId: c.id,
+			NodeSpec:  nodes,
+		})
+	}
+	return resp, nil
+}
+
+func (f *Controller) ClusterStatus(ctx context.Context, req *proto.ClusterStatusRequest) (*proto.ClusterStatusResponse, error) {
+	if err := f.simulate(ctx, "ClusterStatus"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, err := f.getCluster(req.AccountName, req.Region, req.ClusterName)
+	if err != nil {
+		return &proto.ClusterStatusResponse{Error: err.Error()}, err
+	}
+	return &proto.ClusterStatusResponse{
+		Status: c.status(f.now()),
+	}, nil
+}
+
+//DeleteCluster marks cluster for deletion, fails if nodegroups are attached unless force delete is set
+func (f *Controller) DeleteCluster(ctx context.Context, req *proto.ClusterDeleteRequest) (*proto.ClusterDeleteResponse, error) {
+	if err := f.simulate(ctx, "DeleteCluster"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, err := f.getCluster(req.AccountName, req.Region, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(c.nodepools) > 0 && !req.ForceDelete {
+		return nil, ErrClusterHasNodes
+	}
+
+	for _, np := range c.nodepools {
+		f.markDeleted(&np.lifecycle)
+	}
+	f.markDeleted(&c.lifecycle)
+	f.logger.Info(ctx, "fake: cluster is in deleting state", "cluster", c.name, "region", c.region)
+	return &proto.ClusterDeleteResponse{}, nil
+}
+
+func (f *Controller) AddNode(ctx context.Context, req *proto.NodeSpawnRequest) (*proto.NodeSpawnResponse, error) {
+	if err := f.simulate(ctx, "AddNode"); err != nil {
+		return nil, err
+	}
+	if req.NodeSpec == nil || req.NodeSpec.Name == "" {
+		return nil, errors.New("node spec with name must be provided")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, err := f.getCluster(req.AccountName, req.Region, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+	if c.status(f.now()) != StatusActive {
+		return nil, ErrClusterNotActive
+	}
+	if _, ok := c.nodepools[req.NodeSpec.Name]; ok {
+		return nil, ErrNodegroupExist
+	}
+
+	c.nodepools[req.NodeSpec.Name] = &nodepool{
+		lifecycle: f.newLifecycle(),
+		spec:      copyNodeSpec(req.NodeSpec),
+	}
+	f.logger.Info(ctx, "fake: nodegroup is in creating state", "cluster", c.name, "nodegroup", req.NodeSpec.Name)
+	return &proto.NodeSpawnResponse{}, nil
+}
+
+func (f *Controller) DeleteNode(ctx context.Context, req *proto.NodeDeleteRequest) (*proto.NodeDeleteResponse, error) {
+	if err := f.simulate(ctx, "DeleteNode"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, err := f.getCluster(req.AccountName, req.Region, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+	np, ok := c.nodepools[req.NodeGroupName]
+	if !ok {
+		return nil, errors.Wrapf(ErrNodegroupMissing, "nodegroup '%s'", req.NodeGroupName)
+	}
+	f.markDeleted(&np.lifecycle)
+	return &proto.NodeDeleteResponse{}, nil
+}
+
+//TagNodeInstance merge labels into the nodegroup labels
+func (f *Controller) TagNodeInstance(ctx context.Context, req *proto.TagNodeInstanceRequest) (*proto.TagNodeInstanceResponse, error) {
+	if err := f.simulate(ctx, "TagNodeInstance"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, err := f.getCluster(req.AccountName, req.Region, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+	np, ok := c.nodepools[req.NodeGroup]
+	if !ok {
+		return nil, errors.Wrapf(ErrNodegroupMissing, "nodegroup '%s'", req.NodeGroup)
+	}
+	for k, v := range req.Labels {
+		np.spec.Labels[k] = v
+	}
+	return &proto.TagNodeInstanceResponse{}, nil
+}
+
+func (f *Controller) RegisterClusterOIDC(ctx context.Context, req *proto.RegisterClusterOIDCRequest) (*proto.RegisterClusterOIDCResponse, error) {
+	if err := f.simulate(ctx, "RegisterClusterOIDC"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, err := f.getCluster(req.AccountName, req.Region, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+	c.oidc = true
+	return &proto.RegisterClusterOIDCResponse{}, nil
+}
@@ -0,0 +1,168 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/netbookai/log"
+	"gitlab.com/netbook-devs/spawner-service/pkg/config"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//Options controls the behaviour of the fake provider
+type Options struct {
+	//TransitionDelay time a resource stays in CREATING or DELETING before it becomes ACTIVE or is removed
+	TransitionDelay time.Duration
+	//Latency added to every call made to the controller
+	Latency time.Duration
+	//Failures method name to number of times the call must fail, negative count fails forever
+	Failures map[string]int
+}
+
+type failure struct {
+	err       error
+	remaining int
+}
+
+//Controller in-memory provider, implements registry.Controller
+type Controller struct {
+	logger log.Logger
+	opts   Options
+
+	mu         sync.Mutex
+	now        func() time.Time
+	seq        int
+	clusters   map[string]*cluster
+	volumes    map[string]*volume
+	snapshots  map[string]*snapshot
+	registries map[string]*repository
+	failures   map[string]*failure
+}
+
+//NewController creates fake controller configured from the service config
+func NewController(logger log.Logger) *Controller {
+	conf := config.Get()
+	opts := Options{
+		TransitionDelay: time.Duration(conf.FakeTransitionDelay) * time.Second,
+		Latency:         time.Duration(conf.FakeLatency) * time.Millisecond,
+		Failures:        parseFailures(conf.FakeFailures),
+	}
+	return NewControllerWithOptions(logger, opts)
+}
+
+//NewControllerWithOptions creates fake controller with the given options
+func NewControllerWithOptions(logger log.Logger, opts Options) *Controller {
+	f := &Controller{
+		logger:     logger,
+		opts:       opts,
+		now:        time.Now,
+		clusters:   make(map[string]*cluster),
+		volumes:    make(map[string]*volume),
+		snapshots:  make(map[string]*snapshot),
+		registries: make(map[string]*repository),
+		failures:   make(map[string]*failure),
+	}
+	for method, count := range opts.Failures {
+		f.InjectFailure(method, nil, count)
+	}
+	return f
+}
+
+//parseFailures parse 'Method[:count]' list, invalid entries are ignored
+func parseFailures(s string) map[string]int {
+	failures := make(map[string]int)
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		count := -1
+		splits := strings.SplitN(entry, ":", 2)
+		if len(splits) == 2 {
+			c, err := strconv.Atoi(splits[1])
+			if err != nil {
+				continue
+			}
+			count = c
+		}
+		failures[splits[0]] = count
+	}
+	return failures
+}
+
+//InjectFailure makes the next count calls of the method fail with err, negative count fails forever.
+//default error is used when err is nil
+func (f *Controller) InjectFailure(method string, err error, count int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err == nil {
+		err = fmt.Errorf("fake: injected failure for %s", method)
+	}
+	f.failures[method] = &failure{err: err, remaining: count}
+}
+
+//ClearFailures removes all the injected failures
+func (f *Controller) ClearFailures() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.failures = make(map[string]*failure)
+}
+
+//simulate applies the configured latency and injected failures for the method
+func (f *Controller) simulate(ctx context.Context, method string) error {
+	if f.opts.Latency > 0 {
+		select {
+		case <-time.After(f.opts.Latency):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	fail, ok := f.failures[method]
+	if !ok || fail.remaining == 0 {
+		return nil
+	}
+	if fail.remaining > 0 {
+		fail.remaining--
+	}
+	f.logger.Warn(ctx, "fake: failing call", "method", method)
+	return fail.err
+}
+
+//nextID return unique resource id with given prefix
+func (f *Controller) nextID(prefix string) string {
+	f.seq++
+	return fmt.Sprintf("%s-%08d", prefix, f.seq)
+}
+
+func key(account, region, name string) string {
+	return fmt.Sprintf("%s/%s/%s", account, region, name)
+}
+
+//AddToken deprecated
+func (f *Controller) AddToken(ctx context.Context, req *proto.AddTokenRequest) (*proto.AddTokenResponse, error) {
+	return &proto.AddTokenResponse{}, nil
+}
+
+func (f *Controller) PresignS3Url(ctx context.Context, req *proto.PresignS3UrlRequest) (*proto.PresignS3UrlResponse, error) {
+	if err := f.simulate(ctx, "PresignS3Url"); err != nil {
+		return nil, err
+	}
+
+	timeout := int32(10)
+	if req.TimeoutInMinute != 0 {
+		timeout = req.TimeoutInMinute
+	}
+	file := strings.TrimPrefix(req.File, "/")
+	return &proto.PresignS3UrlResponse{
+		SignedUrl: fmt.Sprintf("https://%s.s3.fake.local/%s?expires-in=%dm", req.Bucket, file, timeout),
+	}, nil
+}
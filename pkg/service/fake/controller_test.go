@@ -0,0 +1,137 @@
+package fake
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/netbookai/log"
+	"github.com/stretchr/testify/assert"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+func newTestController() (*Controller, *time.Time) {
+	f := NewControllerWithOptions(log.GetLogger(), Options{TransitionDelay: time.Minute})
+	now := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	f.now = func() time.Time { return now }
+	return f, &now
+}
+
+func Test_clusterLifecycle(t *testing.T) {
+	ctx := context.Background()
+	f, now := newTestController()
+
+	_, err := f.CreateCluster(ctx, &proto.ClusterRequest{
+		Provider:    "fake",
+		Region:      "local",
+		AccountName: "acc",
+		ClusterName: "c1",
+		Node:        &proto.NodeSpec{Name: "np1", Instance: "small", Count: 2},
+	})
+	assert.Nil(t, err, "CreateCluster")
+
+	_, err = f.CreateCluster(ctx, &proto.ClusterRequest{Region: "local", AccountName: "acc", ClusterName: "c1"})
+	assert.Equal(t, ErrClusterExist, err, "CreateCluster: duplicate")
+
+	statusReq := &proto.ClusterStatusRequest{Region: "local", AccountName: "acc", ClusterName: "c1"}
+	stat, err := f.ClusterStatus(ctx, statusReq)
+	assert.Nil(t, err)
+	assert.Equal(t, StatusCreating, stat.Status)
+
+	_, err = f.AddNode(ctx, &proto.NodeSpawnRequest{Region: "local", AccountName: "acc", ClusterName: "c1", NodeSpec: &proto.NodeSpec{Name: "np2"}})
+	assert.Equal(t, ErrClusterNotActive, err, "AddNode: cluster in creating state")
+
+	*now = now.Add(time.Minute)
+	stat, _ = f.ClusterStatus(ctx, statusReq)
+	assert.Equal(t, StatusActive, stat.Status)
+
+	spec, err := f.GetCluster(ctx, &proto.GetClusterRequest{Region: "local", AccountName: "acc", ClusterName: "c1"})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(spec.NodeSpec), "one node spec per node")
+
+	_, err = f.DeleteCluster(ctx, &proto.ClusterDeleteRequest{Region: "local", AccountName: "acc", ClusterName: "c1"})
+	assert.Equal(t, ErrClusterHasNodes, err)
+
+	_, err = f.DeleteCluster(ctx, &proto.ClusterDeleteRequest{Region: "local", AccountName: "acc", ClusterName: "c1", ForceDelete: true})
+	assert.Nil(t, err)
+	stat, _ = f.ClusterStatus(ctx, statusReq)
+	assert.Equal(t, StatusDeleting, stat.Status)
+
+	*now = now.Add(time.Minute)
+	_, err = f.ClusterStatus(ctx, statusReq)
+	assert.True(t, errors.Is(err, ErrClusterNotFound), "cluster must be removed after deletion")
+}
+
+func Test_volumeAndSnapshot(t *testing.T) {
+	ctx := context.Background()
+	f, _ := newTestController()
+
+	vol, err := f.CreateVolume(ctx, &proto.CreateVolumeRequest{Region: "local", AccountName: "acc", Size: 10})
+	assert.Nil(t, err)
+
+	snap, err := f.CreateSnapshotAndDelete(ctx, &proto.CreateSnapshotAndDeleteRequest{Region: "local", AccountName: "acc", Volumeid: vol.Volumeid})
+	assert.Nil(t, err)
+	assert.True(t, snap.Deleted)
+
+	_, err = f.DeleteVolume(ctx, &proto.DeleteVolumeRequest{Region: "local", AccountName: "acc", Volumeid: vol.Volumeid})
+	assert.True(t, errors.Is(err, ErrVolumeNotFound))
+
+	restored, err := f.CreateVolume(ctx, &proto.CreateVolumeRequest{Region: "local", AccountName: "acc", Snapshotid: snap.Snapshotid, DeleteSnapshot: true})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), f.volumes[key("acc", "local", restored.Volumeid)].size, "volume size must be taken from snapshot")
+
+	_, err = f.DeleteSnapshot(ctx, &proto.DeleteSnapshotRequest{Region: "local", AccountName: "acc", SnapshotId: snap.Snapshotid})
+	assert.True(t, errors.Is(err, ErrSnapshotNotFound), "snapshot must be deleted with DeleteSnapshot flag")
+}
+
+func Test_listFleet(t *testing.T) {
+	ctx := context.Background()
+	f, now := newTestController()
+
+	_, err := f.CreateCluster(ctx, &proto.ClusterRequest{
+		Region:      "local",
+		AccountName: "acc",
+		ClusterName: "c1",
+		Node:        &proto.NodeSpec{Name: "np1", Instance: "large", Count: 2, GpuEnabled: true},
+	})
+	assert.Nil(t, err)
+	_, err = f.CreateVolume(ctx, &proto.CreateVolumeRequest{Region: "local", AccountName: "acc", Size: 10})
+	assert.Nil(t, err)
+	_, err = f.CreateVolume(ctx, &proto.CreateVolumeRequest{Region: "local", AccountName: "other", Size: 10})
+	assert.Nil(t, err)
+
+	fleet, err := f.ListFleet(ctx, "local", "acc")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(fleet.Clusters))
+	assert.Equal(t, "creating", fleet.Clusters[0].Status)
+	assert.Equal(t, int64(0), fleet.Clusters[0].NodeGroups[0].Nodes, "nodes are not up till the nodepool is active")
+	assert.Equal(t, 1, len(fleet.Volumes))
+	assert.Equal(t, *now, fleet.Volumes[0].CreatedAt)
+
+	*now = now.Add(2 * time.Minute)
+	fleet, _ = f.ListFleet(ctx, "local", "acc")
+	ng := fleet.Clusters[0].NodeGroups[0]
+	assert.Equal(t, int64(2), ng.Desired)
+	assert.Equal(t, int64(2), ng.Nodes)
+	assert.True(t, ng.GPU)
+}
+
+func Test_injectFailure(t *testing.T) {
+	ctx := context.Background()
+	f, _ := newTestController()
+
+	injected := errors.New("boom")
+	f.InjectFailure("GetClusters", injected, 1)
+
+	_, err := f.GetClusters(ctx, &proto.GetClustersRequest{})
+	assert.Equal(t, injected, err)
+
+	_, err = f.GetClusters(ctx, &proto.GetClustersRequest{})
+	assert.Nil(t, err, "failure must be consumed after count")
+}
+
+func Test_parseFailures(t *testing.T) {
+	got := parseFailures("CreateCluster, AddNode:2,DeleteNode:x,")
+	assert.Equal(t, map[string]int{"CreateCluster": -1, "AddNode": 2}, got)
+}
@@ -0,0 +1,112 @@
+package fake
+
+import (
+	"context"
+	"hash/fnv"
+	"time"
+
+	"github.com/pkg/errors"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+const dateLayout = "2006-01-02"
+
+//dailyCost deterministic cost in 1/100 of cents for the given id and day
+func dailyCost(id string, day time.Time) int64 {
+	h := fnv.New32a()
+	h.Write([]byte(id))
+	h.Write([]byte(day.Format(dateLayout)))
+	//somewhere between $0 and $100 a day
+	return int64(h.Sum32() % 1000000)
+}
+
+//days return all the days in [start, end)
+func days(start, end string) ([]time.Time, error) {
+	s, err := time.Parse(dateLayout, start)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid start date")
+	}
+	e, err := time.Parse(dateLayout, end)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid end date")
+	}
+
+	d := []time.Time{}
+	for t := s; t.Before(e); t = t.AddDate(0, 0, 1) {
+		d = append(d, t)
+	}
+	return d, nil
+}
+
+//groupedCost total cost of each id in given period
+func groupedCost(ids []string, start, end string) (int64, map[string]int64, error) {
+	period, err := days(start, end)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	total := int64(0)
+	grouped := make(map[string]int64, len(ids))
+	for _, id := range ids {
+		for _, d := range period {
+			c := dailyCost(id, d)
+			grouped[id] += c
+			total += c
+		}
+	}
+	return total, grouped, nil
+}
+
+func (f *Controller) GetWorkspacesCost(ctx context.Context, req *proto.GetWorkspacesCostRequest) (*proto.GetWorkspacesCostResponse, error) {
+	if err := f.simulate(ctx, "GetWorkspacesCost"); err != nil {
+		return nil, err
+	}
+
+	total, grouped, err := groupedCost(req.WorkspaceIds, req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetWorkspacesCost")
+	}
+	return &proto.GetWorkspacesCostResponse{
+		TotalCost:   total,
+		GroupedCost: grouped,
+	}, nil
+}
+
+func (f *Controller) GetApplicationsCost(ctx context.Context, req *proto.GetApplicationsCostRequest) (*proto.GetApplicationsCostResponse, error) {
+	if err := f.simulate(ctx, "GetApplicationsCost"); err != nil {
+		return nil, err
+	}
+
+	total, grouped, err := groupedCost(req.ApplicationIds, req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetApplicationsCost")
+	}
+	return &proto.GetApplicationsCostResponse{
+		TotalCost:   total,
+		GroupedCost: grouped,
+	}, nil
+}
+
+//GetCostByTime returns daily cost of each id, keyed with yyyyMMdd same as aws
+func (f *Controller) GetCostByTime(ctx context.Context, req *proto.GetCostByTimeRequest) (*proto.GetCostByTimeResponse, error) {
+	if err := f.simulate(ctx, "GetCostByTime"); err != nil {
+		return nil, err
+	}
+
+	period, err := days(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetCostByTime")
+	}
+
+	resMap := make(map[string]*proto.CostMap, len(req.Ids))
+	for _, id := range req.Ids {
+		cost := make(map[string]int64, len(period))
+		for _, d := range period {
+			cost[d.Format("20060102")] = dailyCost(id, d)
+		}
+		resMap[id] = &proto.CostMap{Cost: cost}
+	}
+	return &proto.GetCostByTimeResponse{
+		GroupedCost: resMap,
+	}, nil
+}
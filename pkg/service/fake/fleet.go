@@ -0,0 +1,55 @@
+package fake
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/registry"
+)
+
+//ListFleet lists the clusters, volumes and snapshots of the account in the region, fake volumes are never attached
+func (f *Controller) ListFleet(ctx context.Context, region, accountName string) (*registry.Fleet, error) {
+	if err := f.simulate(ctx, "ListFleet"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sweep()
+	now := f.now()
+
+	fleet := &registry.Fleet{}
+	for _, c := range f.clusters {
+		if c.account != accountName || c.region != region {
+			continue
+		}
+		cluster := registry.FleetCluster{Name: c.name, Status: strings.ToLower(c.status(now))}
+		for name, np := range c.nodepools {
+			ng := registry.FleetNodeGroup{
+				Name:     name,
+				Status:   strings.ToLower(np.status(now)),
+				Instance: np.spec.Instance,
+				GPU:      np.spec.GpuEnabled,
+				Desired:  np.spec.Count,
+			}
+			if np.status(now) == StatusActive {
+				ng.Nodes = np.spec.Count
+			}
+			cluster.NodeGroups = append(cluster.NodeGroups, ng)
+		}
+		sort.Slice(cluster.NodeGroups, func(i, j int) bool { return cluster.NodeGroups[i].Name < cluster.NodeGroups[j].Name })
+		fleet.Clusters = append(fleet.Clusters, cluster)
+	}
+	for _, v := range f.volumes {
+		if v.account == accountName && v.region == region {
+			fleet.Volumes = append(fleet.Volumes, registry.FleetVolume{ID: v.id, SizeGB: v.size, CreatedAt: v.createdAt})
+		}
+	}
+	for _, s := range f.snapshots {
+		if s.account == accountName && s.region == region {
+			fleet.Snapshots = append(fleet.Snapshots, registry.FleetSnapshot{ID: s.id, SizeGB: s.size, CreatedAt: s.createdAt})
+		}
+	}
+	return fleet, nil
+}
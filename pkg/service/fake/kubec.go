@@ -0,0 +1,91 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+//fakeCA is not a valid certificate, clients must not try to talk to the fake endpoint
+const fakeCA = "fake-certificate-authority"
+
+func endpoint(c *cluster) string {
+	return fmt.Sprintf("https://%s.%s.k8s.fake.local", c.id, c.region)
+}
+
+func token(c *cluster) string {
+	return fmt.Sprintf("fake-token-%s", c.id)
+}
+
+func (f *Controller) GetToken(ctx context.Context, req *proto.GetTokenRequest) (*proto.GetTokenResponse, error) {
+	if err := f.simulate(ctx, "GetToken"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, err := f.getCluster(req.AccountName, req.Region, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.GetTokenResponse{
+		Token:    token(c),
+		Endpoint: endpoint(c),
+		CaData:   fakeCA,
+		Status:   c.status(f.now()),
+	}, nil
+}
+
+func (f *Controller) GetKubeConfig(ctx context.Context, req *proto.GetKubeConfigRequest) (*proto.GetKubeConfigResponse, error) {
+	if err := f.simulate(ctx, "GetKubeConfig"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	c, err := f.getCluster(req.AccountName, req.Region, req.ClusterName)
+	if err != nil {
+		f.mu.Unlock()
+		return nil, err
+	}
+	name := c.name
+	server := endpoint(c)
+	tok := token(c)
+	f.mu.Unlock()
+
+	clusters := map[string]*clientcmdapi.Cluster{
+		name: {
+			Server:                   server,
+			CertificateAuthorityData: []byte(fakeCA),
+		},
+	}
+	contexts := map[string]*clientcmdapi.Context{
+		name: {
+			Cluster:  name,
+			AuthInfo: name,
+		},
+	}
+	authinfos := map[string]*clientcmdapi.AuthInfo{
+		name: {
+			Token: tok,
+		},
+	}
+
+	b, err := clientcmd.Write(clientcmdapi.Config{
+		Kind:       "Config",
+		APIVersion: "v1",
+		Clusters:   clusters,
+		Contexts:   contexts,
+		AuthInfos:  authinfos,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &proto.GetKubeConfigResponse{
+		ClusterName: name,
+		Config:      b,
+	}, nil
+}
@@ -0,0 +1,32 @@
+package fake
+
+import (
+	"github.com/netbookai/log"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/registry"
+)
+
+var _ registry.Controller = (*Controller)(nil)
+
+func init() {
+	registry.Register(registry.Provider{
+		Name: constants.FakeLabel,
+		Capabilities: []registry.Capability{
+			registry.CapCluster,
+			registry.CapNodePool,
+			registry.CapVolume,
+			registry.CapSnapshot,
+			registry.CapKubeConfig,
+			registry.CapInstanceTag,
+			registry.CapCost,
+			registry.CapContainerRegistry,
+			registry.CapOIDC,
+			registry.CapPresign,
+		},
+		//serves from memory, must not be enabled unless asked for
+		OptIn: true,
+		New: func(logger log.Logger) registry.Controller {
+			return NewController(logger)
+		},
+	})
+}
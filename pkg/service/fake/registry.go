@@ -0,0 +1,56 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+type repository struct {
+	id   string
+	name string
+	url  string
+	tags map[string]string
+}
+
+func registryHost(account, region string) string {
+	return fmt.Sprintf("%s.registry.%s.fake.local", account, region)
+}
+
+//CreateContainerRegistryRepo creates repo, returns the existing one if it is already created
+func (f *Controller) CreateContainerRegistryRepo(ctx context.Context, req *proto.CreateContainerRegistryRepoRequest) (*proto.CreateContainerRegistryRepoResponse, error) {
+	if err := f.simulate(ctx, "CreateContainerRegistryRepo"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	k := key(req.AccountName, req.Region, req.Name)
+	r, ok := f.registries[k]
+	if !ok {
+		r = &repository{
+			id:   f.nextID("registry"),
+			name: req.Name,
+			url:  fmt.Sprintf("%s/%s", registryHost(req.AccountName, req.Region), req.Name),
+			tags: copyLabels(req.Tags),
+		}
+		f.registries[k] = r
+	}
+	return &proto.CreateContainerRegistryRepoResponse{
+		RegistryId: r.id,
+		Url:        r.url,
+	}, nil
+}
+
+func (f *Controller) GetContainerRegistryAuth(ctx context.Context, req *proto.GetContainerRegistryAuthRequest) (*proto.GetContainerRegistryAuthResponse, error) {
+	if err := f.simulate(ctx, "GetContainerRegistryAuth"); err != nil {
+		return nil, err
+	}
+
+	return &proto.GetContainerRegistryAuthResponse{
+		Url:   fmt.Sprintf("https://%s", registryHost(req.AccountName, req.Region)),
+		Token: fmt.Sprintf("fake-registry-token-%s", req.AccountName),
+	}, nil
+}
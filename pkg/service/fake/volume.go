@@ -0,0 +1,233 @@
+package fake
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+var (
+	ErrVolumeNotFound   = errors.New("volume not found")
+	ErrSnapshotNotFound = errors.New("snapshot not found")
+)
+
+type volume struct {
+	id         string
+	name       string
+	account    string
+	region     string
+	zone       string
+	typ        string
+	size       int64
+	snapshotId string
+	labels     map[string]string
+	createdAt  time.Time
+}
+
+type snapshot struct {
+	id        string
+	name      string
+	account   string
+	region    string
+	volumeId  string
+	size      int64
+	labels    map[string]string
+	createdAt time.Time
+}
+
+func copyLabels(l map[string]string) map[string]string {
+	labels := make(map[string]string, len(l))
+	for k, v := range l {
+		labels[k] = v
+	}
+	return labels
+}
+
+func snapshotUri(s *snapshot) string {
+	return "fake://snapshots/" + s.id
+}
+
+//getVolume must be called with lock held
+func (f *Controller) getVolume(account, region, id string) (*volume, error) {
+	v, ok := f.volumes[key(account, region, id)]
+	if !ok {
+		return nil, errors.Wrapf(ErrVolumeNotFound, "volume '%s'", id)
+	}
+	return v, nil
+}
+
+//getSnapshot must be called with lock held
+func (f *Controller) getSnapshot(account, region, id string) (*snapshot, error) {
+	s, ok := f.snapshots[key(account, region, id)]
+	if !ok {
+		return nil, errors.Wrapf(ErrSnapshotNotFound, "snapshot '%s'", id)
+	}
+	return s, nil
+}
+
+func (f *Controller) CreateVolume(ctx context.Context, req *proto.CreateVolumeRequest) (*proto.CreateVolumeResponse, error) {
+	if err := f.simulate(ctx, "CreateVolume"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	size := req.Size
+	if req.Snapshotid != "" {
+		s, err := f.getSnapshot(req.AccountName, req.Region, req.Snapshotid)
+		if err != nil {
+			return nil, err
+		}
+		if size < s.size {
+			size = s.size
+		}
+		if req.DeleteSnapshot {
+			delete(f.snapshots, key(req.AccountName, req.Region, s.id))
+		}
+	}
+
+	v := &volume{
+		id:         f.nextID("vol"),
+		name:       common.VolumeName(size),
+		account:    req.AccountName,
+		region:     req.Region,
+		zone:       req.Availabilityzone,
+		typ:        req.Volumetype,
+		size:       size,
+		snapshotId: req.Snapshotid,
+		labels:     copyLabels(req.Labels),
+		createdAt:  f.now(),
+	}
+	f.volumes[key(v.account, v.region, v.id)] = v
+
+	return &proto.CreateVolumeResponse{
+		Volumeid:    v.id,
+		ResourceUri: "fake://volumes/" + v.id,
+	}, nil
+}
+
+func (f *Controller) DeleteVolume(ctx context.Context, req *proto.DeleteVolumeRequest) (*proto.DeleteVolumeResponse, error) {
+	if err := f.simulate(ctx, "DeleteVolume"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	v, err := f.getVolume(req.AccountName, req.Region, req.Volumeid)
+	if err != nil {
+		return nil, err
+	}
+	delete(f.volumes, key(v.account, v.region, v.id))
+	return &proto.DeleteVolumeResponse{Deleted: true}, nil
+}
+
+//snapshotOf must be called with lock held
+func (f *Controller) snapshotOf(v *volume, labels map[string]string) *snapshot {
+	s := &snapshot{
+		id:        f.nextID("snap"),
+		name:      common.SnapshotDisplayName(v.id),
+		account:   v.account,
+		region:    v.region,
+		volumeId:  v.id,
+		size:      v.size,
+		labels:    copyLabels(labels),
+		createdAt: f.now(),
+	}
+	f.snapshots[key(s.account, s.region, s.id)] = s
+	return s
+}
+
+func (f *Controller) CreateSnapshot(ctx context.Context, req *proto.CreateSnapshotRequest) (*proto.CreateSnapshotResponse, error) {
+	if err := f.simulate(ctx, "CreateSnapshot"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	v, err := f.getVolume(req.AccountName, req.Region, req.Volumeid)
+	if err != nil {
+		return nil, err
+	}
+	s := f.snapshotOf(v, req.Labels)
+	return &proto.CreateSnapshotResponse{
+		Snapshotid:  s.id,
+		SnapshotUri: snapshotUri(s),
+	}, nil
+}
+
+func (f *Controller) CreateSnapshotAndDelete(ctx context.Context, req *proto.CreateSnapshotAndDeleteRequest) (*proto.CreateSnapshotAndDeleteResponse, error) {
+	if err := f.simulate(ctx, "CreateSnapshotAndDelete"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	v, err := f.getVolume(req.AccountName, req.Region, req.Volumeid)
+	if err != nil {
+		return nil, err
+	}
+	s := f.snapshotOf(v, req.Labels)
+	delete(f.volumes, key(v.account, v.region, v.id))
+	return &proto.CreateSnapshotAndDeleteResponse{
+		Snapshotid:  s.id,
+		Deleted:     true,
+		SnapshotUri: snapshotUri(s),
+	}, nil
+}
+
+func (f *Controller) DeleteSnapshot(ctx context.Context, req *proto.DeleteSnapshotRequest) (*proto.DeleteSnapshotResponse, error) {
+	if err := f.simulate(ctx, "DeleteSnapshot"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	s, err := f.getSnapshot(req.AccountName, req.Region, req.SnapshotId)
+	if err != nil {
+		return nil, err
+	}
+	delete(f.snapshots, key(s.account, s.region, s.id))
+	return &proto.DeleteSnapshotResponse{}, nil
+}
+
+func (f *Controller) CopySnapshot(ctx context.Context, req *proto.CopySnapshotRequest) (*proto.CopySnapshotResponse, error) {
+	if err := f.simulate(ctx, "CopySnapshot"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	s, err := f.getSnapshot(req.AccountName, req.Region, req.SnapshotId)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := copyLabels(s.labels)
+	for k, v := range req.Labels {
+		labels[k] = v
+	}
+	c := &snapshot{
+		id:        f.nextID("snap"),
+		name:      common.CopySnapshotName(s.id),
+		account:   s.account,
+		region:    s.region,
+		volumeId:  s.volumeId,
+		size:      s.size,
+		labels:    labels,
+		createdAt: f.now(),
+	}
+	f.snapshots[key(c.account, c.region, c.id)] = c
+	return &proto.CopySnapshotResponse{
+		NewSnapshotId:  c.id,
+		NewSnapshotUri: snapshotUri(c),
+	}, nil
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/config"
+	"gitlab.com/netbook-devs/spawner-service/pkg/fleet"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/registry"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//FleetTargets accounts to scan for the fleet gauges, the ones listed in FLEET_TARGETS along with the
+//provider, account and region of the resources in inventory. targets of the disabled providers are skipped
+func (s *spawnerService) FleetTargets(ctx context.Context) ([]fleet.Target, error) {
+	targets, err := fleet.ParseTargets(config.Get().FleetTargets)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.inventory != nil {
+		resources, err := s.inventory.List(&proto.ListResourcesRequest{})
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range resources {
+			targets = append(targets, fleet.Target{Provider: r.Provider, Account: r.AccountName, Region: r.Region})
+		}
+	}
+
+	enabled := map[string]bool{}
+	for _, name := range s.providers.Enabled() {
+		enabled[name] = true
+	}
+	seen := map[fleet.Target]bool{}
+	unique := []fleet.Target{}
+	for _, t := range targets {
+		if !enabled[t.Provider] || seen[t] {
+			continue
+		}
+		seen[t] = true
+		unique = append(unique, t)
+	}
+	sort.Slice(unique, func(i, j int) bool { return unique[i].String() < unique[j].String() })
+	return unique, nil
+}
+
+//ListFleet lists the spawner resources of the target, registry.ErrNotSupported is returned for the providers
+//which cannot list them
+func (s *spawnerService) ListFleet(ctx context.Context, t fleet.Target) (*registry.Fleet, error) {
+	return s.providers.ListFleet(ctx, t.Provider, t.Region, t.Account)
+}
@@ -2,42 +2,63 @@ package gcp
 
 import (
 	"context"
+	"net/http"
 
 	compute "cloud.google.com/go/compute/apiv1"
 	container "cloud.google.com/go/container/apiv1"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/instrument"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
 	auth "golang.org/x/oauth2/google"
 	"google.golang.org/api/option"
+	htransport "google.golang.org/api/transport/http"
+	"google.golang.org/grpc"
 )
 
+const cloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
 func getClusterManagerClient(ctx context.Context, cred *system.GCPCredential) (*container.ClusterManagerClient, error) {
 
-	sa_cred := []byte(cred.Certificate)
+	sa_cred := cred.CredentialsJSON()
 	opt := option.WithCredentialsJSON(sa_cred)
-	c, err := container.NewClusterManagerClient(ctx, opt)
+	c, err := container.NewClusterManagerClient(ctx, opt,
+		option.WithGRPCDialOption(grpc.WithChainUnaryInterceptor(instrument.GCPUnaryClientInterceptor())))
 	return c, err
 }
 
+//getRESTOption authenticated http client instrumenting the rest calls
+func getRESTOption(ctx context.Context, cred *system.GCPCredential) (option.ClientOption, error) {
+	sa_cred := cred.CredentialsJSON()
+	t, err := htransport.NewTransport(ctx, instrument.GCPTransport(nil), option.WithCredentialsJSON(sa_cred), option.WithScopes(cloudPlatformScope))
+	if err != nil {
+		return nil, err
+	}
+	return option.WithHTTPClient(&http.Client{Transport: t}), nil
+}
+
 //getDiskClient
 func getDiskClient(ctx context.Context, cred *system.GCPCredential) (*compute.DisksClient, error) {
 
-	sa_cred := []byte(cred.Certificate)
-	opt := option.WithCredentialsJSON(sa_cred)
+	opt, err := getRESTOption(ctx, cred)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewDisksRESTClient(ctx, opt)
 }
 
 //getSnapshotClient
 func getSnapshotClient(ctx context.Context, cred *system.GCPCredential) (*compute.SnapshotsClient, error) {
 
-	sa_cred := []byte(cred.Certificate)
-	opt := option.WithCredentialsJSON(sa_cred)
+	opt, err := getRESTOption(ctx, cred)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewSnapshotsRESTClient(ctx, opt)
 }
 
 //getAuthClient
 func getAuthClient(ctx context.Context, cred *system.GCPCredential, scopes []string) (*auth.Credentials, error) {
 
-	sa_cred := []byte(cred.Certificate)
-	scopes = append(scopes, "https://www.googleapis.com/auth/cloud-platform")
+	sa_cred := cred.CredentialsJSON()
+	scopes = append(scopes, cloudPlatformScope)
 	return auth.CredentialsFromJSON(ctx, sa_cred, scopes...)
 }
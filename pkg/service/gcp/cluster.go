@@ -3,6 +3,7 @@ package gcp
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	container_proto "google.golang.org/genproto/googleapis/container/v1"
 	"google.golang.org/grpc/codes"
@@ -15,6 +16,8 @@ import (
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 )
 
+var ErrClusterNotFound = errors.New("cluster not found")
+
 //getParent retrive getParent path for clusters
 func getParent(projectId, location string) string {
 	return fmt.Sprintf("projects/%s/locations/%s", projectId, location)
@@ -111,7 +114,7 @@ func (g *gcpController) getClusterInternal(ctx context.Context, cred *system.GCP
 			st := e.GRPCStatus()
 			g.logger.Error(ctx, "cluster not found", "cluster", clusterName, "code", st.Code(), "reason", st.Details())
 			if st.Code() == codes.NotFound {
-				return nil, errors.New("cluster not found")
+				return nil, ErrClusterNotFound
 			}
 			//TODO: might be some other rpc error
 			return nil, errors.Wrap(st.Err(), "cluster not found")
@@ -138,10 +141,42 @@ func (g *gcpController) getCluster(ctx context.Context, req *proto.GetClusterReq
 	return &proto.ClusterSpec{
 		Name:      cluster.GetName(),
 		ClusterId: cluster.Id,
-		NodeSpec:  []*proto.NodeSpec{},
+		NodeSpec:  nodeSpecs(cluster),
 	}, nil
 }
 
+//nodeSpecs node spec for each node pool in the cluster
+func nodeSpecs(cluster *container_proto.Cluster) []*proto.NodeSpec {
+	nodes := make([]*proto.NodeSpec, 0, len(cluster.NodePools))
+	for _, pool := range cluster.NodePools {
+		state := constants.Inactive
+		if pool.Status == container_proto.NodePool_RUNNING {
+			state = constants.Active
+		}
+
+		health := &proto.Health{}
+		for _, cond := range pool.Conditions {
+			health.Issue = append(health.Issue, &proto.Issue{
+				Code:        cond.CanonicalCode.String(),
+				Description: cond.Message,
+			})
+		}
+
+		nodes = append(nodes, &proto.NodeSpec{
+			Name:        pool.Name,
+			Instance:    pool.GetConfig().GetMachineType(),
+			MachineType: pool.GetConfig().GetMachineType(),
+			DiskSize:    pool.GetConfig().GetDiskSizeGb(),
+			Labels:      pool.GetConfig().GetLabels(),
+			State:       state,
+			Count:       int64(pool.InitialNodeCount),
+			Health:      health,
+			ClusterId:   cluster.Id,
+		})
+	}
+	return nodes
+}
+
 func (g *gcpController) getClusters(ctx context.Context, req *proto.GetClustersRequest) (*proto.GetClustersResponse, error) {
 	cred, err := getCredentials(ctx, req.AccountName)
 	if err != nil {
@@ -167,15 +202,11 @@ func (g *gcpController) getClusters(ctx context.Context, req *proto.GetClustersR
 	clusters := make([]*proto.ClusterSpec, 0, len(resp.GetClusters()))
 	for _, cluster := range resp.GetClusters() {
 
-		nodes := make([]*proto.NodeSpec, 0, len(cluster.NodePools))
 		spec := &proto.ClusterSpec{
 			Name:      cluster.Name,
 			ClusterId: cluster.Id,
-			NodeSpec:  nodes,
+			NodeSpec:  nodeSpecs(cluster),
 		}
-
-		//TODO: populate node spec
-
 		clusters = append(clusters, spec)
 	}
 	return &proto.GetClustersResponse{
@@ -208,7 +239,7 @@ func (g *gcpController) clusterStatus(ctx context.Context, req *proto.ClusterSta
 			st := e.GRPCStatus()
 			g.logger.Error(ctx, "cluster not found", "cluster", req.ClusterName, "code", st.Code(), "reason", st.Details())
 			if st.Code() == codes.NotFound {
-				return nil, errors.New("cluster not found")
+				return nil, ErrClusterNotFound
 			}
 
 			//TODO: might be some other rpc error
@@ -219,15 +250,23 @@ func (g *gcpController) clusterStatus(ctx context.Context, req *proto.ClusterSta
 		return nil, errors.Wrap(err, "clusterStatus:")
 	}
 	g.logger.Info(ctx, "cluster status", "status", cluster.Status, "name", cluster.Name)
-	stat := constants.Inactive
-	if cluster.Status == container_proto.Cluster_RUNNING {
-		stat = constants.Active
-	}
 	return &proto.ClusterStatusResponse{
-		Status: stat,
+		Status: clusterState(cluster.Status),
 	}, nil
 }
 
+//clusterState active when running, 'error' and 'degraded' are reported as they are so the callers can stop waiting
+//on the cluster
+func clusterState(status container_proto.Cluster_Status) string {
+	switch status {
+	case container_proto.Cluster_RUNNING:
+		return constants.Active
+	case container_proto.Cluster_ERROR, container_proto.Cluster_DEGRADED:
+		return strings.ToLower(status.String())
+	}
+	return constants.Inactive
+}
+
 func (g *gcpController) deleteCluster(ctx context.Context, req *proto.ClusterDeleteRequest) (*proto.ClusterDeleteResponse, error) {
 	cred, err := getCredentials(ctx, req.AccountName)
 	if err != nil {
@@ -253,7 +292,7 @@ func (g *gcpController) deleteCluster(ctx context.Context, req *proto.ClusterDel
 			g.logger.Error(ctx, "cluster not found", "cluster", req.ClusterName, "code", st.Code(), "reason", st.Details())
 
 			if st.Code() == codes.NotFound {
-				return nil, errors.New("cluster not found")
+				return nil, ErrClusterNotFound
 			}
 
 			//TODO: might be some other rpc error
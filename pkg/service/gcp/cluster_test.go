@@ -6,6 +6,8 @@ import (
 
 	"github.com/netbookai/log"
 	"github.com/stretchr/testify/assert"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	container_proto "google.golang.org/genproto/googleapis/container/v1"
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 )
 
@@ -32,3 +34,10 @@ func Test_createCluster(t *testing.T) {
 	assert.Nil(t, err, "create Cluster failed")
 	assert.NotNil(t, resp, "create Cluster response is nil")
 }
+
+func Test_clusterState(t *testing.T) {
+	assert.Equal(t, constants.Active, clusterState(container_proto.Cluster_RUNNING))
+	assert.Equal(t, constants.Inactive, clusterState(container_proto.Cluster_PROVISIONING))
+	assert.Equal(t, "error", clusterState(container_proto.Cluster_ERROR))
+	assert.Equal(t, "degraded", clusterState(container_proto.Cluster_DEGRADED))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/netbookai/log"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 )
 
@@ -105,3 +106,8 @@ func (g *gcpController) CopySnapshot(ctx context.Context, in *proto.CopySnapshot
 func (g *gcpController) PresignS3Url(ctx context.Context, in *proto.PresignS3UrlRequest) (*proto.PresignS3UrlResponse, error) {
 	return &proto.PresignS3UrlResponse{}, nil
 }
+
+//Ping checks the gke api endpoint is reachable
+func (g *gcpController) Ping(ctx context.Context) error {
+	return common.Reachable(ctx, "https://container.googleapis.com")
+}
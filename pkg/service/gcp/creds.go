@@ -9,6 +9,7 @@ import (
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
 )
 
+//getCredentials credential of the account, the service account key when stored, the federated one otherwise
 func getCredentials(ctx context.Context, account string) (*system.GCPCredential, error) {
 	env := config.Get().Env
 
@@ -24,7 +25,7 @@ func getCredentials(ctx context.Context, account string) (*system.GCPCredential,
 			Certificate: conf.GcpCertificate,
 		}, nil
 	} else {
-		c, err := system.GetCredentials(ctx, config.Get().SecretHostRegion, account, constants.GcpLabel)
+		c, err := system.FindCredentials(ctx, account, constants.GcpLabel, constants.CredGcpFederated)
 		if err != nil {
 			return nil, errors.Wrap(err, "getCredentials")
 		}
@@ -114,7 +114,7 @@ func (g *gcpController) AddNode(ctx context.Context, req *proto.NodeSpawnRequest
 				st := e.GRPCStatus()
 				g.logger.Error(ctx, "cluster not found", "cluster", req.ClusterName, "code", st.Code(), "reason", st.Details())
 				if st.Code() == codes.NotFound {
-					return nil, errors.New("cluster not found")
+					return nil, ErrClusterNotFound
 				}
 				//TODO: might be some other rpc error
 				return nil, errors.Wrap(st.Err(), "cluster not found")
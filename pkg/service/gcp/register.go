@@ -0,0 +1,35 @@
+package gcp
+
+import (
+	"github.com/netbookai/log"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/registry"
+)
+
+//regions gcp regions, zones of these regions are known as well
+var regions = []string{
+	"africa-south1", "asia-east1", "asia-east2", "asia-northeast1", "asia-northeast2", "asia-northeast3",
+	"asia-south1", "asia-south2", "asia-southeast1", "asia-southeast2", "australia-southeast1",
+	"australia-southeast2", "europe-central2", "europe-north1", "europe-southwest1", "europe-west1",
+	"europe-west10", "europe-west12", "europe-west2", "europe-west3", "europe-west4", "europe-west6",
+	"europe-west8", "europe-west9", "me-central1", "me-central2", "me-west1", "northamerica-northeast1",
+	"northamerica-northeast2", "southamerica-east1", "southamerica-west1", "us-central1", "us-east1",
+	"us-east4", "us-east5", "us-south1", "us-west1", "us-west2", "us-west3", "us-west4",
+}
+
+func init() {
+	registry.Register(registry.Provider{
+		Name: constants.GcpLabel,
+		Capabilities: []registry.Capability{
+			registry.CapCluster,
+			registry.CapNodePool,
+			registry.CapVolume,
+			registry.CapSnapshot,
+			registry.CapKubeConfig,
+		},
+		Regions: regions,
+		New: func(logger log.Logger) registry.Controller {
+			return NewController(logger)
+		},
+	})
+}
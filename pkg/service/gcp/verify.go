@@ -0,0 +1,113 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/registry"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
+	"golang.org/x/oauth2"
+	auth "golang.org/x/oauth2/google"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/googleapi"
+)
+
+const projectGet = "resourcemanager.projects.get"
+
+//requiredPermissions iam permissions spawner uses in the project
+var requiredPermissions = []string{
+	projectGet,
+	"container.clusters.create",
+	"container.clusters.get",
+	"container.clusters.delete",
+	"container.clusters.list",
+	"container.nodePools.create",
+	"container.nodePools.delete",
+	"compute.disks.create",
+	"compute.disks.delete",
+	"compute.snapshots.create",
+	"compute.snapshots.delete",
+	"iam.serviceAccounts.actAs",
+}
+
+//VerifyCredential exchanges the service account key, or the federated token, for a token, gets the project and tests
+//the permissions granted to the service account on it
+func (g *gcpController) VerifyCredential(ctx context.Context, cred system.Credentials) (*registry.Identity, error) {
+	c := cred.GetGcp()
+	if c == nil {
+		return nil, fmt.Errorf("%w: expected gcp credential", registry.ErrInvalidCredential)
+	}
+
+	principal := c.ServiceAccount
+	if principal == "" {
+		principal = c.Audience
+	}
+	if !c.Federated() {
+		key := struct {
+			ClientEmail string `json:"client_email"`
+		}{}
+		if err := json.Unmarshal([]byte(c.Certificate), &key); err != nil {
+			return nil, fmt.Errorf("%w: certificate is not a service account key: %v", registry.ErrInvalidCredential, err)
+		}
+		principal = key.ClientEmail
+	}
+	creds, err := auth.CredentialsFromJSON(ctx, c.CredentialsJSON(), cloudPlatformScope)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", registry.ErrInvalidCredential, err)
+	}
+	_, err = creds.TokenSource.Token()
+	var rerr *oauth2.RetrieveError
+	if errors.As(err, &rerr) {
+		return nil, fmt.Errorf("%w: %v", registry.ErrInvalidCredential, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	identity := &registry.Identity{Principal: principal, Account: c.ProjectId}
+
+	opt, err := getRESTOption(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := cloudresourcemanager.NewService(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	missing := []string{}
+	_, err = svc.Projects.Get(c.ProjectId).Context(ctx).Do()
+	var gerr *googleapi.Error
+	switch {
+	case errors.As(err, &gerr) && gerr.Code == http.StatusNotFound:
+		return nil, fmt.Errorf("%w: project '%s' not found", registry.ErrInvalidCredential, c.ProjectId)
+	case errors.As(err, &gerr) && gerr.Code == http.StatusForbidden:
+		missing = append(missing, projectGet)
+	case err != nil:
+		return nil, err
+	}
+
+	res, err := svc.Projects.TestIamPermissions(c.ProjectId, &cloudresourcemanager.TestIamPermissionsRequest{Permissions: requiredPermissions}).Context(ctx).Do()
+	if err != nil {
+		g.logger.Warn(ctx, "unable to check the permissions of the credential", "principal", principal, "error", err)
+		identity.MissingPermissions = missing
+		identity.PermissionCheckError = err.Error()
+		return identity, nil
+	}
+	granted := map[string]bool{}
+	for _, p := range res.Permissions {
+		granted[p] = true
+	}
+	missing = []string{}
+	for _, p := range requiredPermissions {
+		if !granted[p] {
+			missing = append(missing, p)
+		}
+	}
+	sort.Strings(missing)
+	identity.MissingPermissions = missing
+	return identity, nil
+}
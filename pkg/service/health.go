@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/config"
+	"gitlab.com/netbook-devs/spawner-service/pkg/health"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
+)
+
+//RegisterHealthChecks adds the readiness checks of the service: config is loaded, system credentials can be
+//obtained and each enabled provider is reachable
+func (s *spawnerService) RegisterHealthChecks(c *health.Checker) {
+	c.Add("config", func(ctx context.Context) error {
+		return config.Loaded()
+	})
+	c.Add("system-credentials", system.CheckCredentials)
+
+	for _, name := range s.providers.Enabled() {
+		name := name
+		c.Add("provider-"+name, func(ctx context.Context) error {
+			return s.providers.Ping(ctx, name)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package instrument
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+)
+
+const awsHandlerName = "spawner.instrument"
+
+//awsStatus http status of the response, error code when the request failed without response
+func awsStatus(r *request.Request) string {
+	if r.HTTPResponse != nil && r.HTTPResponse.StatusCode != 0 {
+		return strconv.Itoa(r.HTTPResponse.StatusCode)
+	}
+	if aerr, ok := r.Error.(awserr.Error); ok {
+		return aerr.Code()
+	}
+	if r.Error != nil {
+		return "error"
+	}
+	return "200"
+}
+
+//AWS instruments all the calls made by the clients created from the session
+func AWS(sess *session.Session) *session.Session {
+	sess.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: awsHandlerName,
+		Fn: func(r *request.Request) {
+			operation := ""
+			if r.Operation != nil {
+				operation = r.Operation.Name
+			}
+			observe(r.Context(), call{
+				provider:  constants.AwsLabel,
+				service:   r.ClientInfo.ServiceName,
+				operation: operation,
+				status:    awsStatus(r),
+				retries:   r.RetryCount,
+				latency:   time.Since(r.Time),
+				err:       r.Error,
+			})
+		},
+	})
+	return sess
+}
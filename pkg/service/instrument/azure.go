@@ -0,0 +1,99 @@
+package instrument
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+)
+
+type attemptsKey struct{}
+
+//azureOperation resource provider and resource type of the arm request url, such as
+//'/subscriptions/s/resourceGroups/rg/providers/Microsoft.ContainerService/managedClusters/c/agentPools/p'
+func azureOperation(r *http.Request) (service, operation string) {
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	service, resource := "arm", ""
+	for i := 0; i < len(segments); i++ {
+		if strings.EqualFold(segments[i], "providers") && i+1 < len(segments) {
+			service = segments[i+1]
+			//resource types and names alternate after the provider namespace
+			for j := i + 2; j < len(segments); j += 2 {
+				resource = segments[j]
+			}
+			break
+		}
+	}
+	if resource == "" && len(segments) > 0 {
+		resource = segments[len(segments)-1]
+	}
+	return service, r.Method + " " + resource
+}
+
+func httpStatus(resp *http.Response, err error) string {
+	if resp != nil {
+		return strconv.Itoa(resp.StatusCode)
+	}
+	if err != nil {
+		return "error"
+	}
+	return ""
+}
+
+//countAttempts counts the attempts made by the retry decorator
+func countAttempts() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if attempts, ok := r.Context().Value(attemptsKey{}).(*int); ok {
+				*attempts++
+			}
+			return s.Do(r)
+		})
+	}
+}
+
+//observeAzure observes the call including its retries
+func observeAzure() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			attempts := 0
+			r = r.WithContext(context.WithValue(r.Context(), attemptsKey{}, &attempts))
+
+			start := time.Now()
+			resp, err := s.Do(r)
+
+			service, operation := azureOperation(r)
+			retries := attempts - 1
+			if retries < 0 {
+				retries = 0
+			}
+			observe(r.Context(), call{
+				provider:  constants.AzureLabel,
+				service:   service,
+				operation: operation,
+				status:    httpStatus(resp, err),
+				retries:   retries,
+				latency:   time.Since(start),
+				err:       err,
+			})
+			return resp, err
+		})
+	}
+}
+
+//Azure instruments the calls made by the arm client.
+//
+//SendDecorators replace the default decorators of the client, the arm retry decorator is set along with the
+//instrumentation, client must be configured before calling this.
+func Azure(c *autorest.Client) {
+	c.SendDecorators = []autorest.SendDecorator{
+		countAttempts(),
+		azure.DoRetryWithRegistration(*c),
+		observeAzure(),
+	}
+}
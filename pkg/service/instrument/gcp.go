@@ -0,0 +1,99 @@
+package instrument
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+//gcpMethod service and operation of the grpc method such as '/google.container.v1.ClusterManager/GetCluster'
+func gcpMethod(fullMethod string) (service, operation string) {
+	splits := strings.Split(strings.TrimPrefix(fullMethod, "/"), "/")
+	if len(splits) != 2 {
+		return "", fullMethod
+	}
+	parts := strings.Split(splits[0], ".")
+	if len(parts) > 1 && parts[0] == "google" {
+		return parts[1], splits[1]
+	}
+	return splits[0], splits[1]
+}
+
+//GCPUnaryClientInterceptor instruments the grpc calls, each attempt made by the client library is observed
+//as a separate call. retries are made by gax above the grpc client, so unlike aws and azure the retries are not
+//counted and the latency is of the single attempt
+func GCPUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		service, operation := gcpMethod(method)
+		observe(ctx, call{
+			provider:  constants.GcpLabel,
+			service:   service,
+			operation: operation,
+			status:    status.Code(err).String(),
+			latency:   time.Since(start),
+			err:       err,
+		})
+		return err
+	}
+}
+
+//gcpOperation service and operation of the rest request url such as
+//'https://compute.googleapis.com/compute/v1/projects/p/zones/z/disks/d/createSnapshot'
+func gcpOperation(r *http.Request) (service, operation string) {
+	service = strings.TrimSuffix(r.URL.Host, ".googleapis.com")
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	for i, s := range segments {
+		if len(s) > 1 && s[0] == 'v' && s[1] >= '0' && s[1] <= '9' {
+			segments = segments[i+1:]
+			break
+		}
+	}
+	if len(segments) == 0 {
+		return service, r.Method
+	}
+
+	//collections and names alternate, a trailing segment is a custom method
+	resource := segments[len(segments)-1]
+	if len(segments)%2 == 0 {
+		resource = segments[len(segments)-2]
+	}
+	return service, r.Method + " " + resource
+}
+
+//gcpTransport instruments the rest calls
+type gcpTransport struct {
+	base http.RoundTripper
+}
+
+func (t gcpTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.base.RoundTrip(r)
+
+	service, operation := gcpOperation(r)
+	observe(r.Context(), call{
+		provider:  constants.GcpLabel,
+		service:   service,
+		operation: operation,
+		status:    httpStatus(resp, err),
+		latency:   time.Since(start),
+		err:       err,
+	})
+	return resp, err
+}
+
+//GCPTransport instruments the rest calls made through base
+func GCPTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return gcpTransport{base: base}
+}
@@ -0,0 +1,56 @@
+package instrument
+
+import (
+	"context"
+	"time"
+
+	"github.com/netbookai/log"
+	"gitlab.com/netbook-devs/spawner-service/pkg/metrics"
+	"gitlab.com/netbook-devs/spawner-service/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var logger = log.GetLogger()
+
+//SetLogger sets the logger of the outbound call logs, defaults to the global logger
+func SetLogger(l log.Logger) {
+	logger = l
+}
+
+//call outbound cloud api call
+type call struct {
+	provider  string
+	service   string
+	operation string
+	status    string
+	retries   int
+	latency   time.Duration
+	err       error
+}
+
+//observe records the call metrics and span, and logs it
+func observe(ctx context.Context, c call) {
+	metrics.ObserveCloudCall(c.provider, c.service, c.operation, c.status, c.retries, c.latency)
+
+	end := time.Now()
+	tracing.Record(ctx, c.provider+"."+c.service+"/"+c.operation, end.Add(-c.latency), end, c.err,
+		attribute.String("cloud.provider", c.provider),
+		attribute.String("cloud.service", c.service),
+		attribute.String("cloud.operation", c.operation),
+		attribute.String("cloud.status", c.status),
+		attribute.Int("cloud.retries", c.retries))
+
+	args := []interface{}{
+		"cloud api call",
+		"provider", c.provider,
+		"service", c.service,
+		"operation", c.operation,
+		"status", c.status,
+		"retries", c.retries,
+		"latency", c.latency,
+	}
+	if c.err != nil {
+		args = append(args, "error", c.err)
+	}
+	logger.Debug(ctx, args...)
+}
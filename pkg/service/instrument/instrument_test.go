@@ -0,0 +1,66 @@
+package instrument
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/mocks"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_operations(t *testing.T) {
+	r, _ := http.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions/s/resourceGroups/rg/providers/Microsoft.ContainerService/managedClusters/c/agentPools/p?api-version=2021-03-01", nil)
+	service, operation := azureOperation(r)
+	assert.Equal(t, "Microsoft.ContainerService", service)
+	assert.Equal(t, "PUT agentPools", operation)
+
+	r, _ = http.NewRequest(http.MethodPost, "https://compute.googleapis.com/compute/v1/projects/p/zones/z/disks/d/createSnapshot", nil)
+	service, operation = gcpOperation(r)
+	assert.Equal(t, "compute", service)
+	assert.Equal(t, "POST createSnapshot", operation)
+
+	r, _ = http.NewRequest(http.MethodGet, "https://compute.googleapis.com/compute/v1/projects/p/zones/z/disks/d", nil)
+	_, operation = gcpOperation(r)
+	assert.Equal(t, "GET disks", operation)
+
+	service, operation = gcpMethod("/google.container.v1.ClusterManager/GetCluster")
+	assert.Equal(t, "container", service)
+	assert.Equal(t, "GetCluster", operation)
+}
+
+func retries(t *testing.T, operation string) float64 {
+	families, err := prometheus.DefaultGatherer.Gather()
+	assert.Nil(t, err)
+	for _, f := range families {
+		if f.GetName() != "cloud_api_retries_total" {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "operation" && l.GetValue() == operation {
+					return m.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	return 0
+}
+
+func Test_Azure(t *testing.T) {
+	sender := mocks.NewSender()
+	sender.AppendResponse(mocks.NewResponseWithStatus("503", http.StatusServiceUnavailable))
+	sender.AppendResponse(mocks.NewResponseWithStatus("200", http.StatusOK))
+
+	c := autorest.Client{Sender: sender, RetryAttempts: 3, RetryDuration: time.Millisecond}
+	Azure(&c)
+
+	r, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/s/providers/Microsoft.Compute/disks/d", nil)
+	resp, err := c.Send(r)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "retry decorator must be kept")
+	assert.Equal(t, 2, sender.Attempts())
+	assert.Equal(t, float64(1), retries(t, "GET disks"))
+}
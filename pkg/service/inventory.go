@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/auth"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/aws"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/registry"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+var errInventoryDisabled = errors.New("resource inventory is disabled, set INVENTORY_DB_PATH to enable")
+
+//record saves the resource in inventory.
+//
+//Inventory is best effort, provider call has already succeeded by now so failures are only logged.
+func (s *spawnerService) record(ctx context.Context, r *proto.Resource) {
+	if s.inventory == nil {
+		return
+	}
+	_, err := s.inventory.Put(r)
+	if err != nil {
+		s.logger.Error(ctx, "failed to record resource in inventory", "kind", r.Kind, "name", r.Name, "error", err)
+	}
+}
+
+//recordDeleted marks the resource and its children deleted in inventory
+func (s *spawnerService) recordDeleted(ctx context.Context, r *proto.Resource) {
+	if s.inventory == nil {
+		return
+	}
+	id := inventory.ID(r)
+	err := s.inventory.MarkDeleted(id)
+	if errors.Is(err, inventory.ErrNotFound) {
+		//resources created before inventory was enabled
+		s.logger.Debug(ctx, "deleted resource is not in inventory", "id", id)
+		return
+	}
+	if err != nil {
+		s.logger.Warn(ctx, "failed to mark resource deleted in inventory", "id", id, "error", err)
+	}
+}
+
+func resource(kind, provider, region, account, name string, labels map[string]string) *proto.Resource {
+	return &proto.Resource{
+		Kind:        kind,
+		Name:        name,
+		Provider:    provider,
+		Region:      region,
+		AccountName: account,
+		Labels:      labels,
+		State:       inventory.StateCreated,
+	}
+}
+
+func clusterResource(provider, region, account, name string) *proto.Resource {
+	return resource(inventory.KindCluster, provider, region, account, name, nil)
+}
+
+func nodeGroupResource(cluster *proto.Resource, name string) *proto.Resource {
+	r := resource(inventory.KindNodeGroup, cluster.Provider, cluster.Region, cluster.AccountName, name, nil)
+	r.ParentId = inventory.ID(cluster)
+	return r
+}
+
+//dnsRecordResource dns records are created in the system account
+func dnsRecordResource(recordType, name string) *proto.Resource {
+	return resource(inventory.KindDNSRecord, string(constants.AwsCloud), "", "", fmt.Sprintf("%s/%s", recordType, name), nil)
+}
+
+//recordClusterCreated records the cluster and the network stack created along with it
+func (s *spawnerService) recordClusterCreated(ctx context.Context, req *proto.ClusterRequest) {
+	r := clusterResource(req.Provider, req.Region, req.AccountName, req.ClusterName)
+	r.Labels = req.Labels
+	s.record(ctx, r)
+
+	if req.Provider == string(constants.AwsCloud) {
+		s.record(ctx, resource(inventory.KindNetwork, req.Provider, req.Region, req.AccountName, aws.WkspNetworkStackName(req.Region), nil))
+	}
+}
+
+//recordClusterActive marks cluster active and records the node groups reported by the provider
+func (s *spawnerService) recordClusterActive(ctx context.Context, provider registry.Controller, req *proto.ClusterRequest) {
+	if s.inventory == nil {
+		return
+	}
+
+	cluster := clusterResource(req.Provider, req.Region, req.AccountName, req.ClusterName)
+	cluster.Labels = req.Labels
+	cluster.State = inventory.StateActive
+	s.record(ctx, cluster)
+
+	spec, err := provider.GetCluster(ctx, &proto.GetClusterRequest{
+		Provider:    req.Provider,
+		Region:      req.Region,
+		AccountName: req.AccountName,
+		ClusterName: req.ClusterName,
+	})
+	if err != nil {
+		s.logger.Warn(ctx, "failed to get node groups of the cluster for inventory", "cluster", req.ClusterName, "error", err)
+		return
+	}
+
+	for _, g := range nodeGroupStatus(spec.NodeSpec) {
+		r := nodeGroupResource(cluster, g.Name)
+		if isActive(g.Status) {
+			r.State = inventory.StateActive
+		}
+		s.record(ctx, r)
+	}
+}
+
+//ListResources list resources provisioned by spawner
+func (s *spawnerService) ListResources(ctx context.Context, req *proto.ListResourcesRequest) (*proto.ListResourcesResponse, error) {
+	if s.inventory == nil {
+		return nil, errInventoryDisabled
+	}
+	resources, err := s.inventory.List(req)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.ListResourcesResponse{Resources: resources}, nil
+}
+
+//GetResource checks the principal is allowed on the account owning the resource
+func (s *spawnerService) GetResource(ctx context.Context, req *proto.GetResourceRequest) (*proto.Resource, error) {
+	if s.inventory == nil {
+		return nil, errInventoryDisabled
+	}
+	//authorized on the owner encoded in the id, a principal of another account must not learn the resource exists
+	provider, account := inventory.Owner(req.Id)
+	if err := auth.AuthorizeResource(ctx, "GetResource", provider, account); err != nil {
+		return nil, err
+	}
+	return s.inventory.Get(req.Id)
+}
@@ -0,0 +1,225 @@
+package inventory
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	bolt "go.etcd.io/bbolt"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+//resource kinds recorded in the inventory
+const (
+	KindCluster           = "cluster"
+	KindNodeGroup         = "nodegroup"
+	KindVolume            = "volume"
+	KindSnapshot          = "snapshot"
+	KindContainerRegistry = "container-registry"
+	KindDNSRecord         = "dns-record"
+	KindNetwork           = "network"
+)
+
+//resource states
+const (
+	//StateCreated provider accepted the resource creation
+	StateCreated = "created"
+	//StateActive provider reported the resource to be ready
+	StateActive  = "active"
+	StateDeleted = "deleted"
+)
+
+var (
+	ErrNotFound = errors.New("resource not found")
+
+	resourceBucket = []byte("resources")
+)
+
+//ID returns the inventory id of the resource,
+//resource id is scoped by its parent, node group names are unique only within the cluster.
+func ID(r *proto.Resource) string {
+	parent := ""
+	if r.ParentId != "" {
+		parent = r.ParentId[strings.LastIndex(r.ParentId, "/")+1:] + "/"
+	}
+	return strings.Join([]string{r.Provider, r.AccountName, r.Region, r.Kind, parent + r.Name}, "/")
+}
+
+//Owner returns the provider and the account of the resource from its inventory id, empty when the id is malformed
+func Owner(id string) (provider, account string) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) < 3 {
+		return "", ""
+	}
+	return parts[0], parts[1]
+}
+
+//Store persists the resources provisioned by spawner
+type Store struct {
+	db  *bolt.DB
+	now func() time.Time
+}
+
+//Open opens the inventory database at path, creates one if it does not exist
+func Open(path string) (*Store, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open inventory '%s'", path)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(resourceBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, errors.Wrap(err, "failed to create inventory bucket")
+	}
+	return &Store{db: db, now: time.Now}, nil
+}
+
+//Close closes the database
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+func get(b *bolt.Bucket, id string) (*proto.Resource, error) {
+	v := b.Get([]byte(id))
+	if v == nil {
+		return nil, errors.Wrapf(ErrNotFound, "resource '%s'", id)
+	}
+	r := &proto.Resource{}
+	if err := gproto.Unmarshal(v, r); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode resource '%s'", id)
+	}
+	return r, nil
+}
+
+func put(b *bolt.Bucket, r *proto.Resource) error {
+	v, err := gproto.Marshal(r)
+	if err != nil {
+		return errors.Wrapf(err, "failed to encode resource '%s'", r.Id)
+	}
+	return b.Put([]byte(r.Id), v)
+}
+
+//Put creates or updates the resource, creation time is retained unless the existing record is deleted.
+//Returns the stored resource.
+func (s *Store) Put(r *proto.Resource) (*proto.Resource, error) {
+	r = gproto.Clone(r).(*proto.Resource)
+	r.Id = ID(r)
+	now := s.now().Unix()
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(resourceBucket)
+
+		r.CreatedAt = now
+		r.UpdatedAt = now
+		r.DeletedAt = 0
+		if existing, err := get(b, r.Id); err == nil && existing.State != StateDeleted {
+			r.CreatedAt = existing.CreatedAt
+			if len(r.Labels) == 0 {
+				r.Labels = existing.Labels
+			}
+		}
+		return put(b, r)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+//MarkDeleted marks the resource and its children as deleted, records are retained for history
+func (s *Store) MarkDeleted(id string) error {
+	now := s.now().Unix()
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(resourceBucket)
+
+		r, err := get(b, id)
+		if err != nil {
+			return err
+		}
+
+		deleted := []*proto.Resource{r}
+		err = b.ForEach(func(k, v []byte) error {
+			c := &proto.Resource{}
+			if err := gproto.Unmarshal(v, c); err != nil {
+				return errors.Wrapf(err, "failed to decode resource '%s'", k)
+			}
+			if c.ParentId == id && c.State != StateDeleted {
+				deleted = append(deleted, c)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, d := range deleted {
+			d.State = StateDeleted
+			d.UpdatedAt = now
+			d.DeletedAt = now
+			if err := put(b, d); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+//Get returns the resource
+func (s *Store) Get(id string) (*proto.Resource, error) {
+	var r *proto.Resource
+	err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		r, err = get(tx.Bucket(resourceBucket), id)
+		return err
+	})
+	return r, err
+}
+
+func match(r *proto.Resource, req *proto.ListResourcesRequest) bool {
+	if req.Provider != "" && req.Provider != r.Provider {
+		return false
+	}
+	if req.Region != "" && req.Region != r.Region {
+		return false
+	}
+	if req.AccountName != "" && req.AccountName != r.AccountName {
+		return false
+	}
+	if req.Kind != "" && req.Kind != r.Kind {
+		return false
+	}
+	if req.ParentId != "" && req.ParentId != r.ParentId {
+		return false
+	}
+	if !req.IncludeDeleted && r.State == StateDeleted {
+		return false
+	}
+	return true
+}
+
+//List returns resources matching the request filters, bolt iterates the keys in order so the result is sorted by id
+func (s *Store) List(req *proto.ListResourcesRequest) ([]*proto.Resource, error) {
+	resources := []*proto.Resource{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(resourceBucket).ForEach(func(k, v []byte) error {
+			r := &proto.Resource{}
+			if err := gproto.Unmarshal(v, r); err != nil {
+				return errors.Wrapf(err, "failed to decode resource '%s'", k)
+			}
+			if match(r, req) {
+				resources = append(resources, r)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
@@ -0,0 +1,73 @@
+package inventory
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+func openTestStore(t *testing.T) (*Store, *time.Time) {
+	s, err := Open(filepath.Join(t.TempDir(), "inventory.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+
+	now := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	s.now = func() time.Time { return now }
+	return s, &now
+}
+
+func Test_putAndGet(t *testing.T) {
+	s, now := openTestStore(t)
+
+	cluster := &proto.Resource{Kind: KindCluster, Provider: "aws", AccountName: "acc", Region: "us-west-2", Name: "c1", State: StateCreated, Labels: map[string]string{"team": "ml"}}
+	stored, err := s.Put(cluster)
+	assert.Nil(t, err)
+	assert.Equal(t, "aws/acc/us-west-2/cluster/c1", stored.Id)
+	provider, account := Owner(stored.Id)
+	assert.Equal(t, []string{"aws", "acc"}, []string{provider, account})
+	created := now.Unix()
+
+	*now = now.Add(time.Hour)
+	cluster.State = StateActive
+	cluster.Labels = nil
+	_, err = s.Put(cluster)
+	assert.Nil(t, err)
+
+	got, err := s.Get(stored.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, StateActive, got.State)
+	assert.Equal(t, created, got.CreatedAt, "creation time must be retained on update")
+	assert.Equal(t, now.Unix(), got.UpdatedAt)
+	assert.Equal(t, "ml", got.Labels["team"], "labels must be retained when not set in update")
+
+	_, err = s.Get("aws/acc/us-west-2/cluster/missing")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func Test_markDeleted(t *testing.T) {
+	s, _ := openTestStore(t)
+
+	cluster, _ := s.Put(&proto.Resource{Kind: KindCluster, Provider: "azure", AccountName: "acc", Region: "eastus", Name: "c1"})
+	np, _ := s.Put(&proto.Resource{Kind: KindNodeGroup, Provider: "azure", AccountName: "acc", Region: "eastus", Name: "np1", ParentId: cluster.Id})
+	assert.Equal(t, "azure/acc/eastus/nodegroup/c1/np1", np.Id, "nodegroup id must be scoped by cluster")
+	_, _ = s.Put(&proto.Resource{Kind: KindVolume, Provider: "azure", AccountName: "acc", Region: "eastus", Name: "vol-1"})
+
+	err := s.MarkDeleted(cluster.Id)
+	assert.Nil(t, err)
+
+	res, err := s.List(&proto.ListResourcesRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(res), "deleted cluster and its nodegroups must not be listed")
+	assert.Equal(t, KindVolume, res[0].Kind)
+
+	res, _ = s.List(&proto.ListResourcesRequest{IncludeDeleted: true, ParentId: cluster.Id})
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, StateDeleted, res[0].State)
+	assert.NotZero(t, res[0].DeletedAt)
+}
@@ -0,0 +1,278 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbookai/log"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/ratelimit"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc/status"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+var (
+	ErrNotFound = errors.New("operation not found")
+	ErrDone     = errors.New("operation is already completed")
+)
+
+//Meta describes the operation being started
+type Meta struct {
+	Kind        string
+	Provider    string
+	Region      string
+	AccountName string
+	Resource    string
+}
+
+//Func is the long running work of the operation, returned message is set as the operation result
+type Func func(ctx context.Context, p Progress) (gproto.Message, error)
+
+//Progress reports the progress of the running operation
+type Progress interface {
+	Update(percent int32, message string)
+}
+
+type entry struct {
+	op     *proto.Operation
+	cancel context.CancelFunc
+}
+
+//Manager runs and tracks the long running operations
+type Manager struct {
+	logger log.Logger
+
+	mu sync.RWMutex
+	//timeout max duration of an operation
+	timeout time.Duration
+	//retention time to keep completed operations around
+	retention time.Duration
+	ops       map[string]*entry
+	//errorStatus translates the error of failed operation to grpc status
+	errorStatus func(err error) *status.Status
+}
+
+//NewManager creates operation manager
+func NewManager(logger log.Logger, timeout, retention time.Duration) *Manager {
+	return &Manager{
+		logger:      logger,
+		timeout:     timeout,
+		retention:   retention,
+		ops:         make(map[string]*entry),
+		errorStatus: status.Convert,
+	}
+}
+
+//SetErrorStatus sets the translation of the errors of failed operations to grpc status, errors are reported
+//as unknown unless they are grpc status already
+func (m *Manager) SetErrorStatus(fn func(err error) *status.Status) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.errorStatus = fn
+}
+
+//SetTimeout changes the timeout of the operations started from now on
+func (m *Manager) SetTimeout(timeout time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.timeout = timeout
+}
+
+//SetRetention changes the time completed operations are kept
+func (m *Manager) SetRetention(retention time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.retention = retention
+}
+
+//Done returns true if operation reached the terminal state
+func Done(op *proto.Operation) bool {
+	switch op.State {
+	case proto.OperationState_OPERATION_SUCCEEDED, proto.OperationState_OPERATION_FAILED, proto.OperationState_OPERATION_CANCELLED:
+		return true
+	}
+	return false
+}
+
+type progress struct {
+	m  *Manager
+	id string
+}
+
+func (p progress) Update(percent int32, message string) {
+	p.m.update(p.id, func(op *proto.Operation) {
+		op.Progress = percent
+		op.Message = message
+	})
+}
+
+//Start runs fn in background and returns the operation tracking it. in-flight slot of the call starting the
+//operation is held till the operation completes
+func (m *Manager) Start(ctx context.Context, meta Meta, fn Func) *proto.Operation {
+	release := ratelimit.Hold(ctx)
+	now := time.Now().Unix()
+	op := &proto.Operation{
+		Id:          uuid.NewString(),
+		Kind:        meta.Kind,
+		State:       proto.OperationState_OPERATION_PENDING,
+		Provider:    meta.Provider,
+		Region:      meta.Region,
+		AccountName: meta.AccountName,
+		Resource:    meta.Resource,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	m.mu.Lock()
+	timeout := m.timeout
+	//operation must outlive the request which started it
+	opCtx, cancel := context.WithTimeout(common.Detach(ctx), timeout)
+	m.prune()
+	m.ops[op.Id] = &entry{op: op, cancel: cancel}
+	started := gproto.Clone(op).(*proto.Operation)
+	m.mu.Unlock()
+
+	m.logger.Info(ctx, "operation started", "operation", op.Id, "kind", meta.Kind, "resource", meta.Resource)
+
+	go func() {
+		defer release()
+		defer cancel()
+
+		m.update(op.Id, func(op *proto.Operation) {
+			op.State = proto.OperationState_OPERATION_RUNNING
+		})
+
+		res, err := fn(opCtx, progress{m: m, id: op.Id})
+		m.complete(opCtx, op.Id, timeout, res, err)
+	}()
+	return started
+}
+
+//update apply the change to operation unless it is already completed
+func (m *Manager) update(id string, change func(op *proto.Operation)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.ops[id]
+	if !ok || Done(e.op) {
+		return
+	}
+	change(e.op)
+	e.op.UpdatedAt = time.Now().Unix()
+}
+
+func (m *Manager) complete(ctx context.Context, id string, timeout time.Duration, res gproto.Message, err error) {
+	m.update(id, func(op *proto.Operation) {
+		if err != nil {
+			op.State = proto.OperationState_OPERATION_FAILED
+			op.Error = err.Error()
+			if errors.Is(err, context.DeadlineExceeded) {
+				op.Error = fmt.Sprintf("operation timed out after %s: %s", timeout, err.Error())
+			}
+			op.Status = m.errorStatus(err).Proto()
+			op.Status.Message = op.Error
+			m.logger.Error(ctx, "operation failed", "operation", id, "kind", op.Kind, "error", err)
+			return
+		}
+		op.State = proto.OperationState_OPERATION_SUCCEEDED
+		op.Progress = 100
+		setResult(op, res)
+		m.logger.Info(ctx, "operation succeeded", "operation", id, "kind", op.Kind)
+	})
+}
+
+func setResult(op *proto.Operation, res gproto.Message) {
+	switch r := res.(type) {
+	case *proto.ClusterResponse:
+		op.Result = &proto.Operation_CreateCluster{CreateCluster: r}
+	case *proto.NodeSpawnResponse:
+		op.Result = &proto.Operation_AddNode{AddNode: r}
+	case *proto.ClusterDeleteResponse:
+		op.Result = &proto.Operation_DeleteCluster{DeleteCluster: r}
+	case *proto.NodeDeleteResponse:
+		op.Result = &proto.Operation_DeleteNode{DeleteNode: r}
+	case *proto.CreateVolumeResponse:
+		op.Result = &proto.Operation_CreateVolume{CreateVolume: r}
+	case *proto.CreateSnapshotAndDeleteResponse:
+		op.Result = &proto.Operation_CreateSnapshotAndDelete{CreateSnapshotAndDelete: r}
+	}
+}
+
+//prune removes completed operations older than retention, must be called with lock held
+func (m *Manager) prune() {
+	expiry := time.Now().Add(-m.retention).Unix()
+	for id, e := range m.ops {
+		if Done(e.op) && e.op.UpdatedAt < expiry {
+			delete(m.ops, id)
+		}
+	}
+}
+
+//Get return the copy of the operation
+func (m *Manager) Get(id string) (*proto.Operation, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	e, ok := m.ops[id]
+	if !ok {
+		return nil, errors.Wrapf(ErrNotFound, "operation '%s'", id)
+	}
+	return gproto.Clone(e.op).(*proto.Operation), nil
+}
+
+//List return operations matching the request filters, latest first
+func (m *Manager) List(req *proto.ListOperationsRequest) []*proto.Operation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.prune()
+	ops := []*proto.Operation{}
+	for _, e := range m.ops {
+		op := e.op
+		if req.Provider != "" && req.Provider != op.Provider {
+			continue
+		}
+		if req.Region != "" && req.Region != op.Region {
+			continue
+		}
+		if req.AccountName != "" && req.AccountName != op.AccountName {
+			continue
+		}
+		if req.Kind != "" && req.Kind != op.Kind {
+			continue
+		}
+		if !req.IncludeDone && Done(op) {
+			continue
+		}
+		ops = append(ops, gproto.Clone(op).(*proto.Operation))
+	}
+	sort.Slice(ops, func(i, j int) bool {
+		return ops[i].CreatedAt > ops[j].CreatedAt
+	})
+	return ops
+}
+
+//Cancel stops waiting on the operation and marks it cancelled.
+//Work already accepted by the provider is not rolled back.
+func (m *Manager) Cancel(id string) (*proto.Operation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.ops[id]
+	if !ok {
+		return nil, errors.Wrapf(ErrNotFound, "operation '%s'", id)
+	}
+	if Done(e.op) {
+		return nil, errors.Wrapf(ErrDone, "operation '%s'", id)
+	}
+	e.op.State = proto.OperationState_OPERATION_CANCELLED
+	e.op.UpdatedAt = time.Now().Unix()
+	e.cancel()
+	return gproto.Clone(e.op).(*proto.Operation), nil
+}
@@ -0,0 +1,156 @@
+package operation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/netbookai/log"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/netbook-devs/spawner-service/pkg/ratelimit"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+func waitDone(t *testing.T, m *Manager, id string) *proto.Operation {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		op, err := m.Get(id)
+		assert.Nil(t, err)
+		if Done(op) {
+			return op
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("operation %s did not complete", id)
+	return nil
+}
+
+func Test_operationSucceeded(t *testing.T) {
+	m := NewManager(log.GetLogger(), time.Minute, time.Hour)
+
+	op := m.Start(context.Background(), Meta{Kind: "CreateCluster", Provider: "fake", Resource: "c1"}, func(ctx context.Context, p Progress) (gproto.Message, error) {
+		p.Update(50, "halfway")
+		return &proto.ClusterResponse{ClusterName: "c1"}, nil
+	})
+	assert.Equal(t, proto.OperationState_OPERATION_PENDING, op.State)
+
+	op = waitDone(t, m, op.Id)
+	assert.Equal(t, proto.OperationState_OPERATION_SUCCEEDED, op.State)
+	assert.Equal(t, int32(100), op.Progress)
+	assert.Equal(t, "halfway", op.Message)
+	assert.Equal(t, "c1", op.GetCreateCluster().GetClusterName())
+}
+
+func Test_operationFailed(t *testing.T) {
+	m := NewManager(log.GetLogger(), time.Minute, time.Hour)
+
+	op := m.Start(context.Background(), Meta{Kind: "AddNode"}, func(ctx context.Context, p Progress) (gproto.Message, error) {
+		return nil, errors.New("boom")
+	})
+
+	op = waitDone(t, m, op.Id)
+	assert.Equal(t, proto.OperationState_OPERATION_FAILED, op.State)
+	assert.Equal(t, "boom", op.Error)
+	assert.Equal(t, int32(codes.Unknown), op.Status.Code)
+
+	m.SetErrorStatus(func(err error) *status.Status {
+		return status.New(codes.NotFound, err.Error())
+	})
+	op = m.Start(context.Background(), Meta{Kind: "AddNode"}, func(ctx context.Context, p Progress) (gproto.Message, error) {
+		return nil, errors.New("cluster not found")
+	})
+	op = waitDone(t, m, op.Id)
+	assert.Equal(t, int32(codes.NotFound), op.Status.Code)
+	assert.Equal(t, "cluster not found", op.Status.Message)
+}
+
+func Test_operationCancel(t *testing.T) {
+	m := NewManager(log.GetLogger(), time.Minute, time.Hour)
+
+	stopped := make(chan struct{})
+	op := m.Start(context.Background(), Meta{Kind: "DeleteCluster"}, func(ctx context.Context, p Progress) (gproto.Message, error) {
+		<-ctx.Done()
+		close(stopped)
+		return nil, ctx.Err()
+	})
+
+	cancelled, err := m.Cancel(op.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, proto.OperationState_OPERATION_CANCELLED, cancelled.State)
+
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("operation context must be cancelled")
+	}
+
+	op, _ = m.Get(op.Id)
+	assert.Equal(t, proto.OperationState_OPERATION_CANCELLED, op.State, "cancelled operation must not be marked failed")
+
+	_, err = m.Cancel(op.Id)
+	assert.True(t, errors.Is(err, ErrDone))
+
+	_, err = m.Cancel("missing")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func Test_operationList(t *testing.T) {
+	m := NewManager(log.GetLogger(), time.Minute, time.Hour)
+
+	block := make(chan struct{})
+	defer close(block)
+	running := m.Start(context.Background(), Meta{Kind: "CreateCluster", Provider: "aws"}, func(ctx context.Context, p Progress) (gproto.Message, error) {
+		<-block
+		return nil, nil
+	})
+	done := m.Start(context.Background(), Meta{Kind: "AddNode", Provider: "azure"}, func(ctx context.Context, p Progress) (gproto.Message, error) {
+		return nil, nil
+	})
+	waitDone(t, m, done.Id)
+
+	ops := m.List(&proto.ListOperationsRequest{})
+	assert.Equal(t, 1, len(ops), "completed operations are excluded by default")
+	assert.Equal(t, running.Id, ops[0].Id)
+
+	ops = m.List(&proto.ListOperationsRequest{IncludeDone: true})
+	assert.Equal(t, 2, len(ops))
+
+	ops = m.List(&proto.ListOperationsRequest{IncludeDone: true, Provider: "azure"})
+	assert.Equal(t, 1, len(ops))
+	assert.Equal(t, done.Id, ops[0].Id)
+}
+
+func Test_operationHoldsInFlightSlot(t *testing.T) {
+	m := NewManager(log.GetLogger(), time.Minute, time.Hour)
+	in := ratelimit.New(nil, map[string]int{"CreateCluster": 2}).Interceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/spawner.SpawnerService/CreateCluster"}
+
+	finish := make(chan struct{})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return m.Start(ctx, Meta{Kind: "CreateCluster"}, func(ctx context.Context, p Progress) (gproto.Message, error) {
+			<-finish
+			return &proto.ClusterResponse{}, nil
+		}), nil
+	}
+	req := &proto.ClusterRequest{Provider: "aws", AccountName: "team-a", ClusterName: "c1"}
+
+	first, err := in(context.Background(), req, info, handler)
+	assert.Nil(t, err)
+	_, err = in(context.Background(), req, info, handler)
+	assert.Nil(t, err)
+	_, err = in(context.Background(), req, info, handler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err), "running operations must hold the in-flight slots")
+
+	close(finish)
+	waitDone(t, m, first.(*proto.Operation).Id)
+	assert.Eventually(t, func() bool {
+		_, err := in(context.Background(), req, info, func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil })
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond, "slot must be released once the operation completes")
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/auth"
+	"gitlab.com/netbook-devs/spawner-service/pkg/errmap"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/operation"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/registry"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc/codes"
+)
+
+//pollInterval interval between provider status checks while operation waits on the resource
+var pollInterval = 15 * time.Second
+
+const (
+	defaultOperationTimeout   = time.Hour
+	defaultOperationRetention = 24 * time.Hour
+)
+
+//waitUntil polls done till it returns true, error or context is done
+func waitUntil(ctx context.Context, done func() (bool, error)) error {
+	for {
+		ok, err := done()
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+		select {
+		case <-time.After(pollInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+//isActive providers report either 'ACTIVE' or 'active'
+func isActive(status string) bool {
+	return strings.EqualFold(status, "active")
+}
+
+//isClusterFailed cluster is not going to become active, aws reports 'FAILED', azure 'failed', gcp 'error' or 'degraded'
+func isClusterFailed(status string) bool {
+	for _, s := range []string{constants.Failed, "error", "degraded"} {
+		if strings.EqualFold(status, s) {
+			return true
+		}
+	}
+	return false
+}
+
+//isNotFound provider reports the resource does not exist
+func isNotFound(err error) bool {
+	return errmap.ToStatus(err).Code() == codes.NotFound
+}
+
+//waitForClusterActive wait till cluster status becomes active, fails as soon as the provider reports the cluster failed
+func (s *spawnerService) waitForClusterActive(ctx context.Context, provider registry.Controller, req *proto.ClusterStatusRequest) error {
+	return waitUntil(ctx, func() (bool, error) {
+		stat, err := provider.ClusterStatus(ctx, req)
+		if err != nil {
+			return false, err
+		}
+		s.logger.Debug(ctx, "waiting for cluster to be active", "cluster", req.ClusterName, "status", stat.Status)
+		if isClusterFailed(stat.Status) {
+			return false, fmt.Errorf("%w: cluster '%s' is %s %s", constants.ErrClusterFailed, req.ClusterName, stat.Status, stat.Error)
+		}
+		return isActive(stat.Status), nil
+	})
+}
+
+//waitForClusterDeletion wait till provider reports the cluster is not found
+func (s *spawnerService) waitForClusterDeletion(ctx context.Context, provider registry.Controller, req *proto.ClusterStatusRequest) error {
+	return waitUntil(ctx, func() (bool, error) {
+		stat, err := provider.ClusterStatus(ctx, req)
+		if err != nil {
+			if isNotFound(err) {
+				s.logger.Debug(ctx, "cluster not found, deleted", "cluster", req.ClusterName)
+				return true, nil
+			}
+			return false, err
+		}
+		s.logger.Debug(ctx, "waiting for cluster to be deleted", "cluster", req.ClusterName, "status", stat.Status)
+		return false, nil
+	})
+}
+
+//getOperation returns the operation if the principal is allowed on its account
+func (s *spawnerService) getOperation(ctx context.Context, method, id string) (*proto.Operation, error) {
+	op, err := s.operations.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := auth.AuthorizeResource(ctx, method, op.Provider, op.AccountName); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+//GetOperation
+func (s *spawnerService) GetOperation(ctx context.Context, req *proto.GetOperationRequest) (*proto.Operation, error) {
+	return s.getOperation(ctx, "GetOperation", req.Id)
+}
+
+//ListOperations list running operations, completed operations are included when requested
+func (s *spawnerService) ListOperations(ctx context.Context, req *proto.ListOperationsRequest) (*proto.ListOperationsResponse, error) {
+	return &proto.ListOperationsResponse{
+		Operations: s.operations.List(req),
+	}, nil
+}
+
+//CancelOperation stops the operation, changes already made on the provider are not reverted
+func (s *spawnerService) CancelOperation(ctx context.Context, req *proto.CancelOperationRequest) (*proto.Operation, error) {
+	if _, err := s.getOperation(ctx, "CancelOperation", req.Id); err != nil {
+		return nil, err
+	}
+	op, err := s.operations.Cancel(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info(ctx, "operation cancelled", "operation", op.Id, "kind", op.Kind)
+	return op, nil
+}
+
+func operationMeta(kind, provider, region, account, resource string) operation.Meta {
+	return operation.Meta{
+		Kind:        kind,
+		Provider:    provider,
+		Region:      region,
+		AccountName: account,
+		Resource:    resource,
+	}
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/netbookai/log"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/fake"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/registry"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//failedCluster reports every cluster with the failed status of the provider
+type failedCluster struct {
+	registry.Controller
+	status string
+}
+
+func (f failedCluster) ClusterStatus(ctx context.Context, req *proto.ClusterStatusRequest) (*proto.ClusterStatusResponse, error) {
+	return &proto.ClusterStatusResponse{Status: f.status}, nil
+}
+
+func (failedCluster) GetCluster(ctx context.Context, req *proto.GetClusterRequest) (*proto.ClusterSpec, error) {
+	return nil, errors.New("cluster is not reachable")
+}
+
+func Test_waitForCluster(t *testing.T) {
+	s := &spawnerService{logger: log.GetLogger()}
+	ctx := context.Background()
+	req := &proto.ClusterStatusRequest{Provider: constants.FakeLabel, AccountName: "team-a", ClusterName: "c1"}
+
+	f := fake.NewControllerWithOptions(log.GetLogger(), fake.Options{})
+	assert.Nil(t, s.waitForClusterDeletion(ctx, f, req), "missing cluster is deleted")
+
+	f.InjectFailure("ClusterStatus", errors.New("connection reset"), 1)
+	assert.NotNil(t, s.waitForClusterDeletion(ctx, f, req), "provider error must not be taken as deleted")
+
+	//aws, gcp and azure
+	for _, status := range []string{"FAILED", "error", "degraded", constants.Failed} {
+		err := s.waitForClusterActive(ctx, failedCluster{status: status}, req)
+		assert.True(t, errors.Is(err, constants.ErrClusterFailed), "cluster in '%s' must not be waited on", status)
+	}
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/config"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/registry"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+
+	//providers register themselves with the registry on init
+	_ "gitlab.com/netbook-devs/spawner-service/pkg/service/aws"
+	_ "gitlab.com/netbook-devs/spawner-service/pkg/service/azure"
+	_ "gitlab.com/netbook-devs/spawner-service/pkg/service/fake"
+	_ "gitlab.com/netbook-devs/spawner-service/pkg/service/gcp"
+)
+
+//enabledProviders providers listed in config, FAKE_PROVIDER_ENABLED adds the fake provider to the list
+func enabledProviders(conf config.Config) []string {
+	names := []string{}
+	for _, name := range strings.Split(conf.Providers, ",") {
+		name = strings.TrimSpace(name)
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+
+	if !conf.FakeProviderEnabled {
+		return names
+	}
+
+	if len(names) == 0 {
+		for _, p := range registry.Providers() {
+			if !p.OptIn {
+				names = append(names, p.Name)
+			}
+		}
+	}
+	return append(names, constants.FakeLabel)
+}
+
+//ListProviders list registered providers, whether they are enabled and the capabilities they support
+func (s *spawnerService) ListProviders(ctx context.Context, req *proto.ListProvidersRequest) (*proto.ListProvidersResponse, error) {
+	return &proto.ListProvidersResponse{
+		Providers: s.providers.List(),
+	}, nil
+}
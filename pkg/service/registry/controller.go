@@ -1,4 +1,4 @@
-package service
+package registry
 
 import (
 	"context"
@@ -36,3 +36,8 @@ type Controller interface {
 
 	PresignS3Url(ctx context.Context, in *proto.PresignS3UrlRequest) (*proto.PresignS3UrlResponse, error)
 }
+
+//Pinger implemented by the controllers which can check the provider api is reachable
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
@@ -0,0 +1,51 @@
+package registry
+
+import (
+	"context"
+	"time"
+)
+
+//FleetLister implemented by the controllers which can list the spawner scoped resources of an account,
+//used by the fleet metrics collector
+type FleetLister interface {
+	ListFleet(ctx context.Context, region, accountName string) (*Fleet, error)
+}
+
+//Fleet spawner scoped resources of an account in a region
+type Fleet struct {
+	Clusters  []FleetCluster
+	Volumes   []FleetVolume
+	Snapshots []FleetSnapshot
+}
+
+type FleetCluster struct {
+	Name       string
+	Status     string
+	NodeGroups []FleetNodeGroup
+}
+
+type FleetNodeGroup struct {
+	Name     string
+	Status   string
+	Instance string
+	GPU      bool
+	//Desired number of nodes the node group is scaled to
+	Desired int64
+	//Nodes number of nodes running
+	Nodes int64
+	//Issues health issues reported by the provider
+	Issues int
+}
+
+type FleetVolume struct {
+	ID        string
+	SizeGB    int64
+	Attached  bool
+	CreatedAt time.Time
+}
+
+type FleetSnapshot struct {
+	ID        string
+	SizeGB    int64
+	CreatedAt time.Time
+}
@@ -0,0 +1,213 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/netbookai/log"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//Capability group of rpcs a provider supports
+type Capability string
+
+const (
+	//CapCluster cluster create, delete, status and token
+	CapCluster Capability = "cluster"
+	//CapNodePool add and delete nodepool
+	CapNodePool Capability = "nodepool"
+	CapVolume   Capability = "volume"
+	//CapSnapshot create, copy and delete snapshot
+	CapSnapshot   Capability = "snapshot"
+	CapKubeConfig Capability = "kubeconfig"
+	//CapInstanceTag tag instances of the cluster nodes
+	CapInstanceTag Capability = "instance-tag"
+	//CapCost workspace, application and time based cost
+	CapCost              Capability = "cost"
+	CapContainerRegistry Capability = "container-registry"
+	CapOIDC              Capability = "oidc"
+	//CapPresign presigned object storage urls
+	CapPresign Capability = "presign"
+)
+
+var (
+	ErrProviderNotFound = errors.New("provider not found")
+	ErrNotSupported     = errors.New("operation not supported by provider")
+)
+
+//Factory creates the provider controller
+type Factory func(logger log.Logger) Controller
+
+//Provider describes the provider registered with spawner
+type Provider struct {
+	Name         string
+	Capabilities []Capability
+	//OptIn provider is enabled only when it is listed in config
+	OptIn bool
+	//Regions offered by the provider, calls to the other regions are labelled 'other' in the metrics
+	Regions []string
+	New     Factory
+}
+
+var (
+	mu        sync.RWMutex
+	providers = map[string]Provider{}
+)
+
+//Register makes the provider available to spawner, providers register themselves on init.
+//Panics when the provider is registered twice.
+func Register(p Provider) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if p.Name == "" || p.New == nil {
+		panic("registry: provider must have a name and factory")
+	}
+	if _, ok := providers[p.Name]; ok {
+		panic(fmt.Sprintf("registry: provider '%s' is already registered", p.Name))
+	}
+	providers[p.Name] = p
+}
+
+//Providers returns the registered providers sorted by name
+func Providers() []Provider {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	ps := make([]Provider, 0, len(providers))
+	for _, p := range providers {
+		ps = append(ps, p)
+	}
+	sort.Slice(ps, func(i, j int) bool {
+		return ps[i].Name < ps[j].Name
+	})
+	return ps
+}
+
+type entry struct {
+	provider     Provider
+	controller   Controller
+	capabilities map[Capability]bool
+}
+
+//Registry holds the controllers of enabled providers
+type Registry struct {
+	enabled map[string]*entry
+	//names of enabled providers, sorted
+	names []string
+}
+
+//New creates controllers for the enabled providers.
+//
+//When enabled is empty all providers except opt-in ones are enabled.
+func New(logger log.Logger, enabled []string) *Registry {
+	want := map[string]bool{}
+	for _, name := range enabled {
+		want[name] = true
+	}
+
+	r := &Registry{
+		enabled: map[string]*entry{},
+	}
+	for _, p := range Providers() {
+		if len(want) > 0 && !want[p.Name] {
+			continue
+		}
+		if len(want) == 0 && p.OptIn {
+			continue
+		}
+
+		caps := map[Capability]bool{}
+		for _, c := range p.Capabilities {
+			caps[c] = true
+		}
+		r.enabled[p.Name] = &entry{
+			provider:     p,
+			controller:   &tracedController{provider: p.Name, controller: p.New(logger)},
+			capabilities: caps,
+		}
+		r.names = append(r.names, p.Name)
+	}
+	return r
+}
+
+//Get returns the controller of the enabled provider which supports the capability
+func (r *Registry) Get(name string, capability Capability) (Controller, error) {
+	e, ok := r.enabled[name]
+	if !ok {
+		return nil, fmt.Errorf("%w, must be one of ['%s'], got '%s'", ErrProviderNotFound, strings.Join(r.names, "', '"), name)
+	}
+	if !e.capabilities[capability] {
+		return nil, fmt.Errorf("%w, '%s' does not support '%s'", ErrNotSupported, name, capability)
+	}
+	return e.controller, nil
+}
+
+//Ping checks the enabled provider is reachable, providers without Pinger are assumed to be reachable
+func (r *Registry) Ping(ctx context.Context, name string) error {
+	e, ok := r.enabled[name]
+	if !ok {
+		return fmt.Errorf("%w, got '%s'", ErrProviderNotFound, name)
+	}
+	if p, ok := e.controller.(Pinger); ok {
+		return p.Ping(ctx)
+	}
+	return nil
+}
+
+//ListFleet lists the spawner scoped resources of the account, ErrNotSupported is returned when the provider
+//does not implement FleetLister
+func (r *Registry) ListFleet(ctx context.Context, name, region, accountName string) (*Fleet, error) {
+	e, ok := r.enabled[name]
+	if !ok {
+		return nil, fmt.Errorf("%w, got '%s'", ErrProviderNotFound, name)
+	}
+	l, ok := e.controller.(FleetLister)
+	if !ok {
+		return nil, fmt.Errorf("%w, '%s' does not list fleet", ErrNotSupported, name)
+	}
+	return l.ListFleet(ctx, region, accountName)
+}
+
+//VerifyCredential authenticates with the credential and checks the permissions granted to it,
+//ErrNotSupported is returned when the provider does not implement CredentialVerifier
+func (r *Registry) VerifyCredential(ctx context.Context, name string, cred system.Credentials) (*Identity, error) {
+	e, ok := r.enabled[name]
+	if !ok {
+		return nil, fmt.Errorf("%w, got '%s'", ErrProviderNotFound, name)
+	}
+	v, ok := e.controller.(CredentialVerifier)
+	if !ok {
+		return nil, fmt.Errorf("%w, '%s' does not verify credentials", ErrNotSupported, name)
+	}
+	return v.VerifyCredential(ctx, cred)
+}
+
+//Enabled names of the enabled providers
+func (r *Registry) Enabled() []string {
+	return r.names
+}
+
+//List describes all the registered providers
+func (r *Registry) List() []*proto.ProviderInfo {
+	ps := Providers()
+	infos := make([]*proto.ProviderInfo, 0, len(ps))
+	for _, p := range ps {
+		caps := make([]string, 0, len(p.Capabilities))
+		for _, c := range p.Capabilities {
+			caps = append(caps, string(c))
+		}
+		_, enabled := r.enabled[p.Name]
+		infos = append(infos, &proto.ProviderInfo{
+			Name:         p.Name,
+			Enabled:      enabled,
+			Capabilities: caps,
+		})
+	}
+	return infos
+}
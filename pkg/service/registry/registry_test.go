@@ -0,0 +1,102 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/netbookai/log"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
+)
+
+type testController struct {
+	Controller
+}
+
+type unreachableController struct {
+	Controller
+}
+
+func (unreachableController) Ping(ctx context.Context) error {
+	return errors.New("unreachable")
+}
+
+func (unreachableController) VerifyCredential(ctx context.Context, cred system.Credentials) (*Identity, error) {
+	return nil, errors.New("unreachable")
+}
+
+func init() {
+	Register(Provider{
+		Name:         "test-cloud",
+		Capabilities: []Capability{CapCluster, CapNodePool},
+		New:          func(logger log.Logger) Controller { return testController{} },
+	})
+	Register(Provider{
+		Name:         "test-optin",
+		Capabilities: []Capability{CapCluster},
+		OptIn:        true,
+		New:          func(logger log.Logger) Controller { return testController{} },
+	})
+	Register(Provider{
+		Name:  "test-unreachable",
+		OptIn: true,
+		New:   func(logger log.Logger) Controller { return unreachableController{} },
+	})
+}
+
+func Test_registryDefaults(t *testing.T) {
+	r := New(log.GetLogger(), nil)
+
+	_, err := r.Get("test-cloud", CapCluster)
+	assert.Nil(t, err)
+
+	_, err = r.Get("test-cloud", CapCost)
+	assert.True(t, errors.Is(err, ErrNotSupported))
+
+	_, err = r.Get("test-optin", CapCluster)
+	assert.True(t, errors.Is(err, ErrProviderNotFound), "opt-in provider must be disabled by default")
+	assert.Equal(t, "provider not found, must be one of ['test-cloud'], got 'test-optin'", err.Error())
+}
+
+func Test_registryEnabled(t *testing.T) {
+	r := New(log.GetLogger(), []string{"test-optin"})
+
+	_, err := r.Get("test-optin", CapCluster)
+	assert.Nil(t, err)
+	_, err = r.Get("test-cloud", CapCluster)
+	assert.True(t, errors.Is(err, ErrProviderNotFound), "provider not listed must be disabled")
+
+	infos := r.List()
+	assert.Equal(t, 3, len(infos), "disabled providers must be listed")
+	assert.Equal(t, "test-cloud", infos[0].Name)
+	assert.False(t, infos[0].Enabled)
+	assert.Equal(t, []string{"cluster", "nodepool"}, infos[0].Capabilities)
+	assert.True(t, infos[1].Enabled)
+}
+
+func Test_registerTwice(t *testing.T) {
+	assert.Panics(t, func() {
+		Register(Provider{Name: "test-cloud", New: func(logger log.Logger) Controller { return nil }})
+	})
+}
+
+func Test_registryPing(t *testing.T) {
+	r := New(log.GetLogger(), []string{"test-cloud", "test-unreachable"})
+
+	assert.Nil(t, r.Ping(context.Background(), "test-cloud"), "providers without Pinger must be reachable")
+	assert.EqualError(t, r.Ping(context.Background(), "test-unreachable"), "unreachable")
+	assert.True(t, errors.Is(r.Ping(context.Background(), "test-optin"), ErrProviderNotFound))
+}
+
+func Test_registryVerifyCredential(t *testing.T) {
+	r := New(log.GetLogger(), []string{"test-cloud", "test-unreachable"})
+	cred := &system.AwsCredential{Id: "id", Secret: "s3cr3t"}
+
+	_, err := r.VerifyCredential(context.Background(), "test-cloud", cred)
+	assert.True(t, errors.Is(err, ErrNotSupported))
+	_, err = r.VerifyCredential(context.Background(), "test-unreachable", cred)
+	assert.EqualError(t, err, "unreachable")
+	_, err = r.VerifyCredential(context.Background(), "test-optin", cred)
+	assert.True(t, errors.Is(err, ErrProviderNotFound))
+}
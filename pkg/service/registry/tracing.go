@@ -0,0 +1,226 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
+	"gitlab.com/netbook-devs/spawner-service/pkg/tracing"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.opentelemetry.io/otel/trace"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+//tracedController records a span for every call made to the provider controller
+type tracedController struct {
+	provider   string
+	controller Controller
+}
+
+//Ping checks the provider is reachable when the controller implements Pinger
+func (t *tracedController) Ping(ctx context.Context) error {
+	p, ok := t.controller.(Pinger)
+	if !ok {
+		return nil
+	}
+	ctx, span := tracing.Start(ctx, t.provider+".Ping")
+	err := p.Ping(ctx)
+	tracing.End(span, err)
+	return err
+}
+
+//ListFleet lists the fleet when the controller implements FleetLister
+func (t *tracedController) ListFleet(ctx context.Context, region, accountName string) (*Fleet, error) {
+	l, ok := t.controller.(FleetLister)
+	if !ok {
+		return nil, fmt.Errorf("%w, '%s' does not list fleet", ErrNotSupported, t.provider)
+	}
+	ctx, span := tracing.Start(ctx, t.provider+".ListFleet")
+	fleet, err := l.ListFleet(ctx, region, accountName)
+	tracing.End(span, err)
+	return fleet, err
+}
+
+//VerifyCredential verifies the credential when the controller implements CredentialVerifier
+func (t *tracedController) VerifyCredential(ctx context.Context, cred system.Credentials) (*Identity, error) {
+	v, ok := t.controller.(CredentialVerifier)
+	if !ok {
+		return nil, fmt.Errorf("%w, '%s' does not verify credentials", ErrNotSupported, t.provider)
+	}
+	ctx, span := tracing.Start(ctx, t.provider+".VerifyCredential")
+	identity, err := v.VerifyCredential(ctx, cred)
+	tracing.End(span, err)
+	return identity, err
+}
+
+func (t *tracedController) start(ctx context.Context, method string, req gproto.Message) (context.Context, trace.Span) {
+	return tracing.Start(ctx, t.provider+"."+method, tracing.RequestAttributes(req)...)
+}
+
+func (t *tracedController) CreateCluster(ctx context.Context, req *proto.ClusterRequest) (*proto.ClusterResponse, error) {
+	ctx, span := t.start(ctx, "CreateCluster", req)
+	res, err := t.controller.CreateCluster(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedController) GetCluster(ctx context.Context, req *proto.GetClusterRequest) (*proto.ClusterSpec, error) {
+	ctx, span := t.start(ctx, "GetCluster", req)
+	res, err := t.controller.GetCluster(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedController) GetClusters(ctx context.Context, req *proto.GetClustersRequest) (*proto.GetClustersResponse, error) {
+	ctx, span := t.start(ctx, "GetClusters", req)
+	res, err := t.controller.GetClusters(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedController) AddToken(ctx context.Context, req *proto.AddTokenRequest) (*proto.AddTokenResponse, error) {
+	ctx, span := t.start(ctx, "AddToken", req)
+	res, err := t.controller.AddToken(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedController) GetToken(ctx context.Context, req *proto.GetTokenRequest) (*proto.GetTokenResponse, error) {
+	ctx, span := t.start(ctx, "GetToken", req)
+	res, err := t.controller.GetToken(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedController) ClusterStatus(ctx context.Context, req *proto.ClusterStatusRequest) (*proto.ClusterStatusResponse, error) {
+	ctx, span := t.start(ctx, "ClusterStatus", req)
+	res, err := t.controller.ClusterStatus(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedController) AddNode(ctx context.Context, req *proto.NodeSpawnRequest) (*proto.NodeSpawnResponse, error) {
+	ctx, span := t.start(ctx, "AddNode", req)
+	res, err := t.controller.AddNode(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedController) DeleteCluster(ctx context.Context, req *proto.ClusterDeleteRequest) (*proto.ClusterDeleteResponse, error) {
+	ctx, span := t.start(ctx, "DeleteCluster", req)
+	res, err := t.controller.DeleteCluster(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedController) DeleteNode(ctx context.Context, req *proto.NodeDeleteRequest) (*proto.NodeDeleteResponse, error) {
+	ctx, span := t.start(ctx, "DeleteNode", req)
+	res, err := t.controller.DeleteNode(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedController) CreateVolume(ctx context.Context, req *proto.CreateVolumeRequest) (*proto.CreateVolumeResponse, error) {
+	ctx, span := t.start(ctx, "CreateVolume", req)
+	res, err := t.controller.CreateVolume(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedController) DeleteVolume(ctx context.Context, req *proto.DeleteVolumeRequest) (*proto.DeleteVolumeResponse, error) {
+	ctx, span := t.start(ctx, "DeleteVolume", req)
+	res, err := t.controller.DeleteVolume(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedController) CreateSnapshot(ctx context.Context, req *proto.CreateSnapshotRequest) (*proto.CreateSnapshotResponse, error) {
+	ctx, span := t.start(ctx, "CreateSnapshot", req)
+	res, err := t.controller.CreateSnapshot(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedController) DeleteSnapshot(ctx context.Context, req *proto.DeleteSnapshotRequest) (*proto.DeleteSnapshotResponse, error) {
+	ctx, span := t.start(ctx, "DeleteSnapshot", req)
+	res, err := t.controller.DeleteSnapshot(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedController) CreateSnapshotAndDelete(ctx context.Context, req *proto.CreateSnapshotAndDeleteRequest) (*proto.CreateSnapshotAndDeleteResponse, error) {
+	ctx, span := t.start(ctx, "CreateSnapshotAndDelete", req)
+	res, err := t.controller.CreateSnapshotAndDelete(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedController) CopySnapshot(ctx context.Context, req *proto.CopySnapshotRequest) (*proto.CopySnapshotResponse, error) {
+	ctx, span := t.start(ctx, "CopySnapshot", req)
+	res, err := t.controller.CopySnapshot(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedController) GetKubeConfig(ctx context.Context, req *proto.GetKubeConfigRequest) (*proto.GetKubeConfigResponse, error) {
+	ctx, span := t.start(ctx, "GetKubeConfig", req)
+	res, err := t.controller.GetKubeConfig(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedController) TagNodeInstance(ctx context.Context, req *proto.TagNodeInstanceRequest) (*proto.TagNodeInstanceResponse, error) {
+	ctx, span := t.start(ctx, "TagNodeInstance", req)
+	res, err := t.controller.TagNodeInstance(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedController) GetWorkspacesCost(ctx context.Context, req *proto.GetWorkspacesCostRequest) (*proto.GetWorkspacesCostResponse, error) {
+	ctx, span := t.start(ctx, "GetWorkspacesCost", req)
+	res, err := t.controller.GetWorkspacesCost(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedController) GetApplicationsCost(ctx context.Context, req *proto.GetApplicationsCostRequest) (*proto.GetApplicationsCostResponse, error) {
+	ctx, span := t.start(ctx, "GetApplicationsCost", req)
+	res, err := t.controller.GetApplicationsCost(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedController) GetCostByTime(ctx context.Context, req *proto.GetCostByTimeRequest) (*proto.GetCostByTimeResponse, error) {
+	ctx, span := t.start(ctx, "GetCostByTime", req)
+	res, err := t.controller.GetCostByTime(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedController) GetContainerRegistryAuth(ctx context.Context, req *proto.GetContainerRegistryAuthRequest) (*proto.GetContainerRegistryAuthResponse, error) {
+	ctx, span := t.start(ctx, "GetContainerRegistryAuth", req)
+	res, err := t.controller.GetContainerRegistryAuth(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedController) CreateContainerRegistryRepo(ctx context.Context, req *proto.CreateContainerRegistryRepoRequest) (*proto.CreateContainerRegistryRepoResponse, error) {
+	ctx, span := t.start(ctx, "CreateContainerRegistryRepo", req)
+	res, err := t.controller.CreateContainerRegistryRepo(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedController) RegisterClusterOIDC(ctx context.Context, req *proto.RegisterClusterOIDCRequest) (*proto.RegisterClusterOIDCResponse, error) {
+	ctx, span := t.start(ctx, "RegisterClusterOIDC", req)
+	res, err := t.controller.RegisterClusterOIDC(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedController) PresignS3Url(ctx context.Context, req *proto.PresignS3UrlRequest) (*proto.PresignS3UrlResponse, error) {
+	ctx, span := t.start(ctx, "PresignS3Url", req)
+	res, err := t.controller.PresignS3Url(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
@@ -0,0 +1,30 @@
+package registry
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
+)
+
+//ErrInvalidCredential credential was rejected by the provider, such as a wrong secret or malformed key
+var ErrInvalidCredential = errors.New("credential is not valid")
+
+//Identity principal the credential authenticates as
+type Identity struct {
+	//Principal such as the aws arn, azure client id or gcp service account email
+	Principal string
+	//Account aws account id, azure subscription or gcp project
+	Account string
+	//MissingPermissions permissions required by spawner which are not granted to the principal
+	MissingPermissions []string
+	//PermissionCheckError set when the permissions could not be checked, the principal may lack the permission
+	//to inspect its own policies
+	PermissionCheckError string
+}
+
+//CredentialVerifier implemented by the controllers which can prove the account credential works before it is
+//stored. invalid credentials are reported with ErrInvalidCredential
+type CredentialVerifier interface {
+	VerifyCredential(ctx context.Context, cred system.Credentials) (*Identity, error)
+}
@@ -6,9 +6,9 @@ import (
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
 )
 
-func (svc *spawnerService) getCredentials(ctx context.Context, region, account, credType string) (system.Credentials, error) {
+func (svc *spawnerService) getCredentials(ctx context.Context, account, credType, stage string) (system.Credentials, error) {
 
-	creds, err := system.GetCredentials(ctx, region, account, credType)
+	creds, err := system.GetCredentialsInStage(ctx, account, credType, stage)
 	if err != nil {
 		svc.logger.Error(ctx, "failed to get the credentials", "account", account)
 		return nil, err
@@ -17,9 +17,9 @@ func (svc *spawnerService) getCredentials(ctx context.Context, region, account,
 }
 
 //writeCredentials just a wrapper over system func
-func (svc *spawnerService) writeCredentials(ctx context.Context, region, account, credType string, cred system.Credentials) error {
+func (svc *spawnerService) writeCredentials(ctx context.Context, account, credType string, cred system.Credentials) error {
 
-	update, err := system.WriteOrUpdateCredential(ctx, region, account, credType, cred)
+	update, err := system.WriteOrUpdateCredential(ctx, account, credType, cred)
 	svc.logger.Info(ctx, "Secrets written successfully", "update", update)
 	return err
 }
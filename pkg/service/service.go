@@ -2,25 +2,27 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"time"
 
 	"github.com/netbookai/log"
 
 	rnchrClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
-	aws "gitlab.com/netbook-devs/spawner-service/pkg/service/aws"
-	"gitlab.com/netbook-devs/spawner-service/pkg/service/azure"
+	"gitlab.com/netbook-devs/spawner-service/pkg/errmap"
+	"gitlab.com/netbook-devs/spawner-service/pkg/fleet"
+	"gitlab.com/netbook-devs/spawner-service/pkg/health"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
-	"gitlab.com/netbook-devs/spawner-service/pkg/service/gcp"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/instrument"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/operation"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/rancher"
-	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/registry"
 	"gitlab.com/netbook-devs/spawner-service/pkg/types"
 
 	"gitlab.com/netbook-devs/spawner-service/pkg/config"
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	gproto "google.golang.org/protobuf/proto"
 )
 
-const ProviderNotFound = "provider not found, must be one of ['aws', 'azure'], got %s"
-
 type SpawnerService interface {
 	CreateCluster(ctx context.Context, req *proto.ClusterRequest) (*proto.ClusterResponse, error)
 	GetCluster(ctx context.Context, req *proto.GetClusterRequest) (*proto.ClusterSpec, error)
@@ -44,6 +46,12 @@ type SpawnerService interface {
 	RegisterWithRancher(context.Context, *proto.RancherRegistrationRequest) (*proto.RancherRegistrationResponse, error)
 	WriteCredential(context.Context, *proto.WriteCredentialRequest) (*proto.WriteCredentialResponse, error)
 	ReadCredential(context.Context, *proto.ReadCredentialRequest) (*proto.ReadCredentialResponse, error)
+	RevealCredential(context.Context, *proto.RevealCredentialRequest) (*proto.ReadCredentialResponse, error)
+	ListCredentials(context.Context, *proto.ListCredentialsRequest) (*proto.ListCredentialsResponse, error)
+	DeleteCredential(context.Context, *proto.DeleteCredentialRequest) (*proto.DeleteCredentialResponse, error)
+	RotateCredential(context.Context, *proto.RotateCredentialRequest) (*proto.RotateCredentialResponse, error)
+	ListCredentialVersions(context.Context, *proto.ListCredentialVersionsRequest) (*proto.ListCredentialVersionsResponse, error)
+	VerifyCredential(context.Context, *proto.VerifyCredentialRequest) (*proto.VerifyCredentialResponse, error)
 	AddRoute53Record(ctx context.Context, req *proto.AddRoute53RecordRequest) (*proto.AddRoute53RecordResponse, error)
 	GetCostByTime(ctx context.Context, req *proto.GetCostByTimeRequest) (*proto.GetCostByTimeResponse, error)
 
@@ -57,54 +65,120 @@ type SpawnerService interface {
 	CopySnapshot(ctx context.Context, in *proto.CopySnapshotRequest) (*proto.CopySnapshotResponse, error)
 
 	PresignS3Url(ctx context.Context, in *proto.PresignS3UrlRequest) (*proto.PresignS3UrlResponse, error)
+
+	GetOperation(ctx context.Context, req *proto.GetOperationRequest) (*proto.Operation, error)
+	ListOperations(ctx context.Context, req *proto.ListOperationsRequest) (*proto.ListOperationsResponse, error)
+	CancelOperation(ctx context.Context, req *proto.CancelOperationRequest) (*proto.Operation, error)
+	WatchClusterStatus(req *proto.WatchClusterStatusRequest, stream proto.SpawnerService_WatchClusterStatusServer) error
+	ListResources(ctx context.Context, req *proto.ListResourcesRequest) (*proto.ListResourcesResponse, error)
+	GetResource(ctx context.Context, req *proto.GetResourceRequest) (*proto.Resource, error)
+	ListProviders(ctx context.Context, req *proto.ListProvidersRequest) (*proto.ListProvidersResponse, error)
+
+	RegisterHealthChecks(c *health.Checker)
+	FleetTargets(ctx context.Context) ([]fleet.Target, error)
+	ListFleet(ctx context.Context, t fleet.Target) (*registry.Fleet, error)
 }
 
 //spawnerService manage provider and clusters
 type spawnerService struct {
-	awsController   Controller
-	azureController Controller
-	gcpController   Controller
-	logger          log.Logger
+	providers  *registry.Registry
+	operations *operation.Manager
+	inventory  *inventory.Store
+	logger     log.Logger
 	proto.UnimplementedSpawnerServiceServer
 }
 
 //New return ClusterController
 func New(logger log.Logger) SpawnerService {
 
+	conf := config.Get()
+	timeout, retention := operationTimeouts(conf)
+
+	instrument.SetLogger(logger)
+
+	providers := registry.New(logger, enabledProviders(conf))
+	logger.Info(context.Background(), "providers enabled", "providers", providers.Enabled())
+
 	svc := &spawnerService{
-		awsController:   aws.NewAWSController(logger),
-		azureController: azure.NewController(logger),
-		gcpController:   gcp.NewController(logger),
-		logger:          logger,
+		providers:  providers,
+		operations: operation.NewManager(logger, timeout, retention),
+		logger:     logger,
+	}
+	svc.operations.SetErrorStatus(errmap.ToStatus)
+
+	if conf.InventoryPath != "" {
+		store, err := inventory.Open(conf.InventoryPath)
+		if err != nil {
+			logger.Error(context.Background(), "failed to open resource inventory, resources will not be recorded", "error", err)
+		} else {
+			svc.inventory = store
+		}
 	}
-	return svc
+	config.OnReload(func(c config.Config) {
+		timeout, retention := operationTimeouts(c)
+		svc.operations.SetTimeout(timeout)
+		svc.operations.SetRetention(retention)
+	})
+	return &tracedService{next: svc}
 }
 
-func (s *spawnerService) controller(provider string) (Controller, error) {
-	switch provider {
-	case "aws":
-		return s.awsController, nil
-	case "azure":
-		return s.azureController, nil
-	case "gcp":
-		return s.gcpController, nil
+//operationTimeouts max duration of the operations and the time they are kept once completed
+func operationTimeouts(conf config.Config) (timeout, retention time.Duration) {
+	timeout = defaultOperationTimeout
+	if conf.OperationTimeout > 0 {
+		timeout = time.Duration(conf.OperationTimeout) * time.Minute
 	}
-	return nil, fmt.Errorf(ProviderNotFound, provider)
+	retention = defaultOperationRetention
+	if conf.OperationRetention > 0 {
+		retention = time.Duration(conf.OperationRetention) * time.Hour
+	}
+	return timeout, retention
+}
+
+//controller returns the provider controller if it is enabled and supports the capability
+func (s *spawnerService) controller(provider string, capability registry.Capability) (registry.Controller, error) {
+	return s.providers.Get(provider, capability)
 }
 
-//CreateCluster create cluster on the provider specified in request
+//CreateCluster starts cluster creation on the provider specified in request,
+//operation completes when the cluster becomes active
 func (s *spawnerService) CreateCluster(ctx context.Context, req *proto.ClusterRequest) (*proto.ClusterResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, registry.CapCluster)
 	if err != nil {
 		return nil, err
 	}
 
-	return provider.CreateCluster(ctx, req)
+	meta := operationMeta("CreateCluster", req.Provider, req.Region, req.AccountName, req.ClusterName)
+	op := s.operations.Start(ctx, meta, func(ctx context.Context, p operation.Progress) (gproto.Message, error) {
+		res, err := provider.CreateCluster(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		s.recordClusterCreated(ctx, req)
+
+		p.Update(50, "waiting for cluster to be active")
+		err = s.waitForClusterActive(ctx, provider, &proto.ClusterStatusRequest{
+			Provider:    req.Provider,
+			Region:      req.Region,
+			AccountName: req.AccountName,
+			ClusterName: res.ClusterName,
+		})
+		if err != nil {
+			return nil, err
+		}
+		s.recordClusterActive(ctx, provider, req)
+		return res, nil
+	})
+
+	return &proto.ClusterResponse{
+		ClusterName: req.ClusterName,
+		Operation:   op,
+	}, nil
 }
 
 //GetCluster get cluster on the providerr specified in request
 func (s *spawnerService) GetCluster(ctx context.Context, req *proto.GetClusterRequest) (*proto.ClusterSpec, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, registry.CapCluster)
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +188,7 @@ func (s *spawnerService) GetCluster(ctx context.Context, req *proto.GetClusterRe
 //GetClusters get the available clusters in the given provider
 func (s *spawnerService) GetClusters(ctx context.Context, req *proto.GetClustersRequest) (*proto.GetClustersResponse, error) {
 
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, registry.CapCluster)
 	if err != nil {
 		return nil, err
 	}
@@ -124,7 +198,7 @@ func (s *spawnerService) GetClusters(ctx context.Context, req *proto.GetClusters
 //AddToken deprecated as of now
 func (s *spawnerService) AddToken(ctx context.Context, req *proto.AddTokenRequest) (*proto.AddTokenResponse, error) {
 
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, registry.CapCluster)
 	if err != nil {
 		return nil, err
 	}
@@ -133,7 +207,7 @@ func (s *spawnerService) AddToken(ctx context.Context, req *proto.AddTokenReques
 
 //GetToken return the kube token for the cluster in given provider
 func (s *spawnerService) GetToken(ctx context.Context, req *proto.GetTokenRequest) (*proto.GetTokenResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, registry.CapCluster)
 	if err != nil {
 		return nil, err
 	}
@@ -142,79 +216,162 @@ func (s *spawnerService) GetToken(ctx context.Context, req *proto.GetTokenReques
 
 //ClusterStatus get cluster status in given provider
 func (s *spawnerService) ClusterStatus(ctx context.Context, req *proto.ClusterStatusRequest) (*proto.ClusterStatusResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, registry.CapCluster)
 	if err != nil {
 		return nil, err
 	}
 	return provider.ClusterStatus(ctx, req)
 }
 
-//AddNode adds new node to the cluster on the provider
+//AddNode starts adding new node to the cluster on the provider,
+//operation completes when provider accepts the nodepool
 func (s *spawnerService) AddNode(ctx context.Context, req *proto.NodeSpawnRequest) (*proto.NodeSpawnResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, registry.CapNodePool)
 	if err != nil {
 		return nil, err
 	}
-	return provider.AddNode(ctx, req)
+
+	meta := operationMeta("AddNode", req.Provider, req.Region, req.AccountName, req.NodeSpec.GetName())
+	op := s.operations.Start(ctx, meta, func(ctx context.Context, p operation.Progress) (gproto.Message, error) {
+		res, err := provider.AddNode(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		cluster := clusterResource(req.Provider, req.Region, req.AccountName, req.ClusterName)
+		r := nodeGroupResource(cluster, req.NodeSpec.GetName())
+		r.Labels = req.NodeSpec.GetLabels()
+		s.record(ctx, r)
+		return res, nil
+	})
+	return &proto.NodeSpawnResponse{Operation: op}, nil
 }
 
-//DeleteCluster deletes empty cluster on the provider, fails when cluster has nodegroup
+//DeleteCluster starts deleting empty cluster on the provider, fails when cluster has nodegroup.
+//operation completes when provider no longer reports the cluster
 func (s *spawnerService) DeleteCluster(ctx context.Context, req *proto.ClusterDeleteRequest) (*proto.ClusterDeleteResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, registry.CapCluster)
 	if err != nil {
 		return nil, err
 	}
-	return provider.DeleteCluster(ctx, req)
+
+	meta := operationMeta("DeleteCluster", req.Provider, req.Region, req.AccountName, req.ClusterName)
+	op := s.operations.Start(ctx, meta, func(ctx context.Context, p operation.Progress) (gproto.Message, error) {
+		res, err := provider.DeleteCluster(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		p.Update(50, "waiting for cluster to be deleted")
+		err = s.waitForClusterDeletion(ctx, provider, &proto.ClusterStatusRequest{
+			Provider:    req.Provider,
+			Region:      req.Region,
+			AccountName: req.AccountName,
+			ClusterName: req.ClusterName,
+		})
+		if err != nil {
+			return nil, err
+		}
+		s.recordDeleted(ctx, clusterResource(req.Provider, req.Region, req.AccountName, req.ClusterName))
+		return res, nil
+	})
+	return &proto.ClusterDeleteResponse{Operation: op}, nil
 }
 
-//DeleteNode deletes node on the given provider cluster
+//DeleteNode starts deleting node on the given provider cluster
 func (s *spawnerService) DeleteNode(ctx context.Context, req *proto.NodeDeleteRequest) (*proto.NodeDeleteResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, registry.CapNodePool)
 	if err != nil {
 		return nil, err
 	}
-	return provider.DeleteNode(ctx, req)
+
+	meta := operationMeta("DeleteNode", req.Provider, req.Region, req.AccountName, req.NodeGroupName)
+	op := s.operations.Start(ctx, meta, func(ctx context.Context, p operation.Progress) (gproto.Message, error) {
+		res, err := provider.DeleteNode(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		cluster := clusterResource(req.Provider, req.Region, req.AccountName, req.ClusterName)
+		s.recordDeleted(ctx, nodeGroupResource(cluster, req.NodeGroupName))
+		return res, nil
+	})
+	return &proto.NodeDeleteResponse{Operation: op}, nil
 }
 
-//CreateVolume create new volume on the provider
+//CreateVolume starts creating new volume on the provider, volume id is available in operation result
 func (s *spawnerService) CreateVolume(ctx context.Context, req *proto.CreateVolumeRequest) (*proto.CreateVolumeResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, registry.CapVolume)
 	if err != nil {
 		return nil, err
 	}
-	return provider.CreateVolume(ctx, req)
+
+	meta := operationMeta("CreateVolume", req.Provider, req.Region, req.AccountName, "")
+	op := s.operations.Start(ctx, meta, func(ctx context.Context, p operation.Progress) (gproto.Message, error) {
+		res, err := provider.CreateVolume(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		s.record(ctx, resource(inventory.KindVolume, req.Provider, req.Region, req.AccountName, res.Volumeid, req.Labels))
+		if req.DeleteSnapshot && req.Snapshotid != "" {
+			s.recordDeleted(ctx, resource(inventory.KindSnapshot, req.Provider, req.Region, req.AccountName, req.Snapshotid, nil))
+		}
+		return res, nil
+	})
+	return &proto.CreateVolumeResponse{Operation: op}, nil
 }
 
 //DeleteVolume delete the volumne on the provider
 func (s *spawnerService) DeleteVolume(ctx context.Context, req *proto.DeleteVolumeRequest) (*proto.DeleteVolumeResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, registry.CapVolume)
+	if err != nil {
+		return nil, err
+	}
+	res, err := provider.DeleteVolume(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	return provider.DeleteVolume(ctx, req)
+	s.recordDeleted(ctx, resource(inventory.KindVolume, req.Provider, req.Region, req.AccountName, req.Volumeid, nil))
+	return res, nil
 }
 
 //CreateSnapshot
 func (s *spawnerService) CreateSnapshot(ctx context.Context, req *proto.CreateSnapshotRequest) (*proto.CreateSnapshotResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, registry.CapSnapshot)
 	if err != nil {
 		return nil, err
 	}
-	return provider.CreateSnapshot(ctx, req)
+	res, err := provider.CreateSnapshot(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	s.record(ctx, resource(inventory.KindSnapshot, req.Provider, req.Region, req.AccountName, res.Snapshotid, req.Labels))
+	return res, nil
 }
 
-//CreateSnapshotAndDelete
+//CreateSnapshotAndDelete starts snapshot of the volume and deletes the volume, snapshot id is available in operation result
 func (s *spawnerService) CreateSnapshotAndDelete(ctx context.Context, req *proto.CreateSnapshotAndDeleteRequest) (*proto.CreateSnapshotAndDeleteResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, registry.CapSnapshot)
 	if err != nil {
 		return nil, err
 	}
-	return provider.CreateSnapshotAndDelete(ctx, req)
+
+	meta := operationMeta("CreateSnapshotAndDelete", req.Provider, req.Region, req.AccountName, req.Volumeid)
+	op := s.operations.Start(ctx, meta, func(ctx context.Context, p operation.Progress) (gproto.Message, error) {
+		res, err := provider.CreateSnapshotAndDelete(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		s.record(ctx, resource(inventory.KindSnapshot, req.Provider, req.Region, req.AccountName, res.Snapshotid, req.Labels))
+		if res.Deleted {
+			s.recordDeleted(ctx, resource(inventory.KindVolume, req.Provider, req.Region, req.AccountName, req.Volumeid, nil))
+		}
+		return res, nil
+	})
+	return &proto.CreateSnapshotAndDeleteResponse{Operation: op}, nil
 }
 
 //GetWorkspaceCost returns workspace cost grouped by given group
 func (s *spawnerService) GetWorkspacesCost(ctx context.Context, req *proto.GetWorkspacesCostRequest) (*proto.GetWorkspacesCostResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, registry.CapCost)
 	if err != nil {
 		return nil, err
 	}
@@ -223,7 +380,7 @@ func (s *spawnerService) GetWorkspacesCost(ctx context.Context, req *proto.GetWo
 
 //GetApplicationsCost returns workspace cost grouped by given group
 func (s *spawnerService) GetApplicationsCost(ctx context.Context, req *proto.GetApplicationsCostRequest) (*proto.GetApplicationsCostResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, registry.CapCost)
 	if err != nil {
 		return nil, err
 	}
@@ -283,7 +440,8 @@ func (s *spawnerService) RegisterWithRancher(ctx context.Context, req *proto.Ran
 
 func validCredType(ct string) bool {
 	switch ct {
-	case constants.CredAws, constants.CredAzure, constants.CredGitPat:
+	case constants.CredAws, constants.CredAwsRole, constants.CredAzure, constants.CredAzureFederated,
+		constants.GcpLabel, constants.CredGcpFederated, constants.CredGitPat:
 		return true
 	}
 	return false
@@ -293,141 +451,37 @@ func validCredType(ct string) bool {
 func (s *spawnerService) WriteCredential(ctx context.Context, req *proto.WriteCredentialRequest) (*proto.WriteCredentialResponse, error) {
 
 	account := req.GetAccount()
-	region := config.Get().SecretHostRegion
-
 	credType := req.GetType()
 
 	if !validCredType(credType) {
 		return nil, constants.ErrInvalidCredentiualType
 	}
 
-	var cred system.Credentials
-	cred_type := "unknown"
-
-	switch credType {
-
-	case constants.CredAws:
-
-		cred_type = "AwsCredential"
-		if c := req.GetAwsCred(); c != nil {
-			cred = &system.AwsCredential{
-				Name:   account,
-				Id:     c.GetAccessKeyID(),
-				Secret: c.GetSecretAccessKey(),
-				Token:  c.GetToken(),
-			}
-		}
-
-	case constants.CredAzure:
-		cred_type = "AzureCredential"
-		if c := req.GetAzureCred(); c != nil {
-			cred = &system.AzureCredential{
-				SubscriptionID: c.GetSubscriptionID(),
-				TenantID:       c.GetTenantID(),
-				ClientID:       c.GetClientID(),
-				ClientSecret:   c.GetClientSecret(),
-				ResourceGroup:  c.GetResourceGroup(),
-				Name:           account,
-			}
-		}
-	case constants.CredGitPat:
-		cred_type = "GithubPersonalAccessToken"
-		if c := req.GetGitPat(); c != nil {
-			cred = &system.GithubPersonalAccessToken{
-				Name:  account,
-				Token: c.Token,
-			}
-		}
-	case constants.GcpLabel:
-		cred_type = "GcpCredential"
-		if c := req.GetGcpCred(); c != nil {
-
-			cred = &system.GCPCredential{
-				Name:        account,
-				ProjectId:   c.GetProjectID(),
-				Certificate: c.GetCertificate(),
-			}
-		}
-	default:
-		return nil, fmt.Errorf("invalid provider '%s'", credType)
+	cred, err := newCredential(account, credType, req)
+	if err != nil {
+		return nil, err
 	}
 
-	if cred == nil {
-		return nil, fmt.Errorf(" %s credentials must be set for type '%s'", cred_type, credType)
-
+	res := &proto.WriteCredentialResponse{}
+	if !req.GetSkipVerification() {
+		res.Identity, err = s.verifyBeforeStore(ctx, account, credType, cred)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	err := s.writeCredentials(ctx, region, account, credType, cred)
+	err = s.writeCredentials(ctx, account, credType, cred)
 	if err != nil {
 		s.logger.Error(ctx, "failed to save credentials", "error", err, "account", account)
 		return nil, err
 	}
-	return &proto.WriteCredentialResponse{}, nil
+	return res, nil
 
 }
 
-//ReadCredential
+//ReadCredential metadata of the credential with the secrets masked
 func (s *spawnerService) ReadCredential(ctx context.Context, req *proto.ReadCredentialRequest) (*proto.ReadCredentialResponse, error) {
-
-	region := config.Get().SecretHostRegion
-	account := req.GetAccount()
-	credType := req.GetType()
-
-	if !validCredType(credType) {
-		return nil, constants.ErrInvalidCredentiualType
-	}
-
-	creds, err := s.getCredentials(ctx, region, account, credType)
-	if err != nil {
-		s.logger.Error(ctx, "failed to get the credentials", "account", account, "error", err)
-		return nil, err
-	}
-	p := &proto.ReadCredentialResponse{
-		Account: account,
-	}
-
-	switch credType {
-	case constants.CredAws:
-		c := creds.GetAws()
-		p.Cred = &proto.ReadCredentialResponse_AwsCred{
-			AwsCred: &proto.AwsCredentials{
-				AccessKeyID:     c.Id,
-				SecretAccessKey: c.Secret,
-				Token:           c.Token,
-			},
-		}
-
-	case constants.CredAzure:
-		c := creds.GetAzure()
-		p.Cred = &proto.ReadCredentialResponse_AzureCred{
-			AzureCred: &proto.AzureCredentials{
-				SubscriptionID: c.SubscriptionID,
-				TenantID:       c.TenantID,
-				ClientID:       c.ClientID,
-				ClientSecret:   c.ClientSecret,
-				ResourceGroup:  c.ResourceGroup,
-			},
-		}
-
-	case constants.CredGitPat:
-		c := creds.GetGitPAT()
-		p.Cred = &proto.ReadCredentialResponse_GitPat{
-			GitPat: &proto.GithubPersonalAccessToken{
-				Token: c.Token,
-			},
-		}
-	case constants.GcpLabel:
-		c := creds.GetGcp()
-		p.Cred = &proto.ReadCredentialResponse_GcpCred{
-			GcpCred: &proto.GcpCredentials{
-				ProjectID:   c.ProjectId,
-				Certificate: c.Certificate,
-			},
-		}
-	}
-
-	s.logger.Debug(ctx, "credentials found", "account", account, "credential_type", credType)
-	return p, nil
+	return s.readCredential(ctx, req.GetAccount(), req.GetType(), req.GetStage(), false)
 }
 
 //AddRoute53Record
@@ -444,11 +498,12 @@ func (s *spawnerService) AddRoute53Record(ctx context.Context, req *proto.AddRou
 		return nil, err
 	}
 	s.logger.Info(ctx, "added route 53 record", "change-id", changeId)
+	s.record(ctx, dnsRecordResource("A", recordName))
 	return &proto.AddRoute53RecordResponse{}, nil
 }
 
 func (s *spawnerService) GetKubeConfig(ctx context.Context, req *proto.GetKubeConfigRequest) (*proto.GetKubeConfigResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, registry.CapKubeConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -456,7 +511,7 @@ func (s *spawnerService) GetKubeConfig(ctx context.Context, req *proto.GetKubeCo
 }
 
 func (s *spawnerService) TagNodeInstance(ctx context.Context, req *proto.TagNodeInstanceRequest) (*proto.TagNodeInstanceResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, registry.CapInstanceTag)
 	if err != nil {
 		return nil, err
 	}
@@ -465,7 +520,7 @@ func (s *spawnerService) TagNodeInstance(ctx context.Context, req *proto.TagNode
 
 //GetWorkspaceCost returns filtered cost grouped by given group and time
 func (s *spawnerService) GetCostByTime(ctx context.Context, req *proto.GetCostByTimeRequest) (*proto.GetCostByTimeResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, registry.CapCost)
 	if err != nil {
 		return nil, err
 	}
@@ -474,7 +529,7 @@ func (s *spawnerService) GetCostByTime(ctx context.Context, req *proto.GetCostBy
 
 func (s *spawnerService) GetContainerRegistryAuth(ctx context.Context, req *proto.GetContainerRegistryAuthRequest) (*proto.GetContainerRegistryAuthResponse, error) {
 
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, registry.CapContainerRegistry)
 	if err != nil {
 		return nil, err
 	}
@@ -482,24 +537,34 @@ func (s *spawnerService) GetContainerRegistryAuth(ctx context.Context, req *prot
 }
 
 func (s *spawnerService) CreateContainerRegistryRepo(ctx context.Context, req *proto.CreateContainerRegistryRepoRequest) (*proto.CreateContainerRegistryRepoResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, registry.CapContainerRegistry)
+	if err != nil {
+		return nil, err
+	}
+	res, err := provider.CreateContainerRegistryRepo(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	return provider.CreateContainerRegistryRepo(ctx, req)
+	s.record(ctx, resource(inventory.KindContainerRegistry, req.Provider, req.Region, req.AccountName, req.Name, req.Tags))
+	return res, nil
 }
 
 func (s *spawnerService) DeleteSnapshot(ctx context.Context, req *proto.DeleteSnapshotRequest) (*proto.DeleteSnapshotResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, registry.CapSnapshot)
 	if err != nil {
 		return nil, err
 	}
-	return provider.DeleteSnapshot(ctx, req)
+	res, err := provider.DeleteSnapshot(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	s.recordDeleted(ctx, resource(inventory.KindSnapshot, req.Provider, req.Region, req.AccountName, req.SnapshotId, nil))
+	return res, nil
 }
 
 func (s *spawnerService) RegisterClusterOIDC(ctx context.Context, req *proto.RegisterClusterOIDCRequest) (*proto.RegisterClusterOIDCResponse, error) {
 
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, registry.CapOIDC)
 	if err != nil {
 		return nil, err
 	}
@@ -563,6 +628,9 @@ func (s *spawnerService) CreateRoute53Records(ctx context.Context, req *proto.Cr
 	if err != nil {
 		return nil, err
 	}
+	for _, r := range req.Records {
+		s.record(ctx, dnsRecordResource(r.Type, r.Name))
+	}
 
 	response := &proto.CreateRoute53RecordsResponse{}
 
@@ -595,6 +663,9 @@ func (s *spawnerService) DeleteRoute53Records(ctx context.Context, req *proto.De
 	if err != nil {
 		return nil, err
 	}
+	for _, r := range req.Records {
+		s.recordDeleted(ctx, dnsRecordResource(r.Type, r.Name))
+	}
 
 	response := &proto.DeleteRoute53RecordsResponse{}
 
@@ -604,13 +675,22 @@ func (s *spawnerService) DeleteRoute53Records(ctx context.Context, req *proto.De
 
 func (s *spawnerService) CopySnapshot(ctx context.Context, req *proto.CopySnapshotRequest) (*proto.CopySnapshotResponse, error) {
 
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, registry.CapSnapshot)
 	if err != nil {
 		return nil, err
 	}
-	return provider.CopySnapshot(ctx, req)
+	res, err := provider.CopySnapshot(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	s.record(ctx, resource(inventory.KindSnapshot, req.Provider, req.Region, req.AccountName, res.NewSnapshotId, req.Labels))
+	return res, nil
 }
 
 func (s *spawnerService) PresignS3Url(ctx context.Context, in *proto.PresignS3UrlRequest) (*proto.PresignS3UrlResponse, error) {
-	return s.awsController.PresignS3Url(ctx, in)
+	provider, err := s.controller(constants.AwsLabel, registry.CapPresign)
+	if err != nil {
+		return nil, err
+	}
+	return provider.PresignS3Url(ctx, in)
 }
@@ -1,6 +1,7 @@
 package system
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -13,6 +14,9 @@ type AzureCredential struct {
 	ClientSecret   string
 	ResourceGroup  string
 	Name           string
+	//Federated the application authenticates with the spawner service account token, trusted as its federated
+	//credential, instead of the client secret
+	Federated bool
 }
 
 type AwsCredential struct {
@@ -22,6 +26,14 @@ type AwsCredential struct {
 	Token  string
 }
 
+//AwsRoleCredential role in the account assumed by spawner, using its system identity
+type AwsRoleCredential struct {
+	Name          string `json:"-"`
+	RoleArn       string `json:"roleArn"`
+	ExternalID    string `json:"externalId,omitempty"`
+	SessionPolicy string `json:"sessionPolicy,omitempty"`
+}
+
 type GithubPersonalAccessToken struct {
 	Name  string
 	Token string
@@ -31,11 +43,17 @@ type GCPCredential struct {
 	Name        string
 	ProjectId   string
 	Certificate string
+	//Audience of the workload identity pool provider trusting the spawner service account token, set for the
+	//workload identity federation credentials which have no certificate
+	Audience string
+	//ServiceAccount impersonated by the federated identity, optional
+	ServiceAccount string
 }
 
 type Credentials interface {
 	GetAzure() *AzureCredential
 	GetAws() *AwsCredential
+	GetAwsRole() *AwsRoleCredential
 	GetGitPAT() *GithubPersonalAccessToken
 	GetGcp() *GCPCredential
 	AsSecretValue() string
@@ -43,6 +61,7 @@ type Credentials interface {
 
 var _ Credentials = (*AzureCredential)(nil)
 var _ Credentials = (*AwsCredential)(nil)
+var _ Credentials = (*AwsRoleCredential)(nil)
 var _ Credentials = (*GithubPersonalAccessToken)(nil)
 var _ Credentials = (*GCPCredential)(nil)
 
@@ -56,11 +75,18 @@ func (a *AzureCredential) GetAws() *AwsCredential {
 	return nil
 }
 
+func (a *AzureCredential) GetAwsRole() *AwsRoleCredential {
+	return nil
+}
+
 func (a *AzureCredential) GetGcp() *GCPCredential {
 	return nil
 }
 
 func (a *AzureCredential) AsSecretValue() string {
+	if a.Federated {
+		return fmt.Sprintf("%s,%s,%s,%s", a.SubscriptionID, a.TenantID, a.ClientID, a.ResourceGroup)
+	}
 	return fmt.Sprintf("%s,%s,%s,%s,%s", a.SubscriptionID, a.TenantID, a.ClientID, a.ClientSecret, a.ResourceGroup)
 }
 
@@ -78,6 +104,10 @@ func (a *AwsCredential) GetAws() *AwsCredential {
 	return a
 }
 
+func (a *AwsCredential) GetAwsRole() *AwsRoleCredential {
+	return nil
+}
+
 func (a *AwsCredential) GetGcp() *GCPCredential {
 	return nil
 }
@@ -90,6 +120,34 @@ func (a *AwsCredential) GetGitPAT() *GithubPersonalAccessToken {
 	return nil
 }
 
+//Aws role credential
+
+func (a *AwsRoleCredential) GetAzure() *AzureCredential {
+	return nil
+}
+
+func (a *AwsRoleCredential) GetAws() *AwsCredential {
+	return nil
+}
+
+func (a *AwsRoleCredential) GetAwsRole() *AwsRoleCredential {
+	return a
+}
+
+func (a *AwsRoleCredential) GetGcp() *GCPCredential {
+	return nil
+}
+
+func (a *AwsRoleCredential) GetGitPAT() *GithubPersonalAccessToken {
+	return nil
+}
+
+//AsSecretValue json encoded role, the session policy is a json document and cannot be comma separated
+func (a *AwsRoleCredential) AsSecretValue() string {
+	b, _ := json.Marshal(a)
+	return string(b)
+}
+
 //GithubPersonalAccessToken credential
 
 func (g *GithubPersonalAccessToken) GetGitPAT() *GithubPersonalAccessToken {
@@ -104,6 +162,10 @@ func (g *GithubPersonalAccessToken) GetAws() *AwsCredential {
 	return nil
 }
 
+func (g *GithubPersonalAccessToken) GetAwsRole() *AwsRoleCredential {
+	return nil
+}
+
 func (g *GithubPersonalAccessToken) GetGcp() *GCPCredential {
 	return nil
 }
@@ -122,6 +184,10 @@ func (g *GCPCredential) GetAws() *AwsCredential {
 	return nil
 }
 
+func (g *GCPCredential) GetAwsRole() *AwsRoleCredential {
+	return nil
+}
+
 func (g *GCPCredential) GetGcp() *GCPCredential {
 	return g
 }
@@ -131,9 +197,37 @@ func (g *GCPCredential) GetGitPAT() *GithubPersonalAccessToken {
 }
 
 func (g *GCPCredential) AsSecretValue() string {
+	if g.Federated() {
+		return fmt.Sprintf("%s,%s,%s", g.ProjectId, g.Audience, g.ServiceAccount)
+	}
 	return fmt.Sprintf("%s,%s", g.ProjectId, g.Certificate)
 }
 
+//Federated credential of the workload identity federation
+func (g *GCPCredential) Federated() bool {
+	return g.Audience != ""
+}
+
+//CredentialsJSON google credentials file of the credential, the service account key or the external account
+//config exchanging the spawner service account token for the federated credentials
+func (g *GCPCredential) CredentialsJSON() []byte {
+	if !g.Federated() {
+		return []byte(g.Certificate)
+	}
+	conf := map[string]interface{}{
+		"type":               "external_account",
+		"audience":           g.Audience,
+		"subject_token_type": "urn:ietf:params:oauth:token-type:jwt",
+		"token_url":          "https://sts.googleapis.com/v1/token",
+		"credential_source":  map[string]string{"file": ServiceAccountTokenFile},
+	}
+	if g.ServiceAccount != "" {
+		conf["service_account_impersonation_url"] = fmt.Sprintf("https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/%s:generateAccessToken", g.ServiceAccount)
+	}
+	b, _ := json.Marshal(conf)
+	return b
+}
+
 //NewAwsCredential recieves comma separated list of credential parts and creates a AwsCredential
 //there can be 2 or 3 parts, when the token is present we will use the latest version of Credentials
 func NewAwsCredential(blob string) (*AwsCredential, error) {
@@ -156,6 +250,15 @@ func NewAwsCredential(blob string) (*AwsCredential, error) {
 	return nil, errors.New("NewAwsCredential: invalid credentials found in secrets")
 }
 
+//NewAwsRoleCredential recieves the json encoded role and creates a AwsRoleCredential
+func NewAwsRoleCredential(blob string) (*AwsRoleCredential, error) {
+	c := &AwsRoleCredential{}
+	if err := json.Unmarshal([]byte(blob), c); err != nil || c.RoleArn == "" {
+		return nil, errors.New("NewAwsRoleCredential: invalid credentials found in secrets")
+	}
+	return c, nil
+}
+
 //NewAzureCredential recieves comma separated list of credential parts and creates a AzureCredential
 func NewAzureCredential(blob string) (*AzureCredential, error) {
 	splits := strings.Split(blob, ",")
@@ -172,18 +275,49 @@ func NewAzureCredential(blob string) (*AzureCredential, error) {
 
 }
 
+//NewAzureFederatedCredential recieves comma separated list of credential parts, without the client secret, and
+//creates a federated AzureCredential
+func NewAzureFederatedCredential(blob string) (*AzureCredential, error) {
+	splits := strings.Split(blob, ",")
+	if len(splits) != 4 {
+		return nil, errors.New("NewAzureFederatedCredential: invalid credentials found in secrets")
+	}
+	return &AzureCredential{
+		SubscriptionID: splits[0],
+		TenantID:       splits[1],
+		ClientID:       splits[2],
+		ResourceGroup:  splits[3],
+		Federated:      true,
+	}, nil
+}
+
 //NewGitPAT return new GithubPersonalAccessToken
 func NewGitPAT(blob string) (*GithubPersonalAccessToken, error) {
 	return &GithubPersonalAccessToken{Token: blob}, nil
 }
 
 func NewGcpCredential(blob string) (*GCPCredential, error) {
-	splits := strings.Split(blob, ",")
+	//service account key is json, only the project is split from it
+	splits := strings.SplitN(blob, ",", 2)
 	if len(splits) != 2 {
-		return nil, errors.New("NewAzureCredential: invalid credentials found in secrets")
+		return nil, errors.New("NewGcpCredential: invalid credentials found in secrets")
 	}
 	return &GCPCredential{
 		ProjectId:   splits[0],
 		Certificate: splits[1],
 	}, nil
 }
+
+//NewGcpFederatedCredential recieves the project, the audience of the workload identity pool provider and the
+//optional service account to impersonate and creates a federated GCPCredential
+func NewGcpFederatedCredential(blob string) (*GCPCredential, error) {
+	splits := strings.Split(blob, ",")
+	if len(splits) != 3 || splits[1] == "" {
+		return nil, errors.New("NewGcpFederatedCredential: invalid credentials found in secrets")
+	}
+	return &GCPCredential{
+		ProjectId:      splits[0],
+		Audience:       splits[1],
+		ServiceAccount: splits[2],
+	}, nil
+}
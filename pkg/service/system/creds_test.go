@@ -0,0 +1,66 @@
+package system
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewAwsRoleCredential(t *testing.T) {
+	role := &AwsRoleCredential{
+		Name:          "team-a",
+		RoleArn:       "arn:aws:iam::123456789012:role/spawner",
+		ExternalID:    "external,id",
+		SessionPolicy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["eks:*","ec2:*"],"Resource":"*"}]}`,
+	}
+
+	c, err := NewAwsRoleCredential(role.AsSecretValue())
+	assert.Nil(t, err)
+	assert.Equal(t, role.RoleArn, c.RoleArn)
+	assert.Equal(t, role.ExternalID, c.ExternalID)
+	assert.Equal(t, role.SessionPolicy, c.SessionPolicy)
+	assert.Empty(t, c.Name, "account is not part of the secret value")
+
+	_, err = NewAwsRoleCredential("access_id,secret_key,token")
+	assert.NotNil(t, err)
+	_, err = NewAwsRoleCredential(`{"externalId":"id"}`)
+	assert.NotNil(t, err, "role arn is required")
+}
+
+func Test_roleSessionName(t *testing.T) {
+	assert.Equal(t, "spawner-team-a", roleSessionName("team-a"))
+	assert.Equal(t, "spawner-team-a-b", roleSessionName("team a/b"))
+	assert.Len(t, roleSessionName(strings.Repeat("a", 100)), maxRoleSessionName)
+}
+
+func Test_federatedCredentials(t *testing.T) {
+	azure := &AzureCredential{SubscriptionID: "sub", TenantID: "tenant", ClientID: "client", ResourceGroup: "rg", Federated: true}
+	a, err := NewAzureFederatedCredential(azure.AsSecretValue())
+	assert.Nil(t, err)
+	assert.Equal(t, azure, a)
+	_, err = NewAzureFederatedCredential("sub,tenant,client,secret,rg")
+	assert.NotNil(t, err, "client secret is not part of the federated credential")
+
+	gcp := &GCPCredential{
+		ProjectId:      "project",
+		Audience:       "//iam.googleapis.com/projects/1/locations/global/workloadIdentityPools/spawner/providers/eks",
+		ServiceAccount: "spawner@project.iam.gserviceaccount.com",
+	}
+	g, err := NewGcpFederatedCredential(gcp.AsSecretValue())
+	assert.Nil(t, err)
+	assert.Equal(t, gcp, g)
+	assert.True(t, g.Federated())
+
+	conf := map[string]interface{}{}
+	assert.Nil(t, json.Unmarshal(g.CredentialsJSON(), &conf))
+	assert.Equal(t, "external_account", conf["type"])
+	assert.Equal(t, gcp.Audience, conf["audience"])
+	assert.Equal(t, map[string]interface{}{"file": ServiceAccountTokenFile}, conf["credential_source"])
+	assert.Contains(t, conf["service_account_impersonation_url"], gcp.ServiceAccount)
+
+	key := &GCPCredential{ProjectId: "project", Certificate: `{"type":"service_account"}`}
+	assert.False(t, key.Federated())
+	assert.Equal(t, key.Certificate, string(key.CredentialsJSON()))
+}
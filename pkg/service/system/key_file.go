@@ -0,0 +1,69 @@
+package system
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+//fileKeyProvider data keys wrapped by the AES-256 key read from a local file. meant for local runs and tests
+type fileKeyProvider struct {
+	aead cipher.AEAD
+}
+
+func newFileKeyProvider(path string) (*fileKeyProvider, error) {
+	if path == "" {
+		return nil, errors.New("credential key file path is required")
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read credential key file")
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(b)))
+	if err != nil {
+		return nil, errors.Wrap(err, "credential key file must hold base64 encoded key")
+	}
+	if len(key) != dataKeySize {
+		return nil, errors.Errorf("credential key must be %d bytes, got %d", dataKeySize, len(key))
+	}
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	return &fileKeyProvider{aead: aead}, nil
+}
+
+func (f *fileKeyProvider) Name() string {
+	return KeyProviderFile
+}
+
+func (f *fileKeyProvider) GenerateDataKey(ctx context.Context) ([]byte, []byte, error) {
+	plain := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, plain); err != nil {
+		return nil, nil, err
+	}
+	nonce := make([]byte, f.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, nil, err
+	}
+	return plain, f.aead.Seal(nonce, nonce, plain, nil), nil
+}
+
+func (f *fileKeyProvider) Decrypt(ctx context.Context, wrapped []byte) ([]byte, error) {
+	if len(wrapped) < f.aead.NonceSize() {
+		return nil, errors.New("invalid wrapped data key")
+	}
+	n := f.aead.NonceSize()
+	return f.aead.Open(nil, wrapped[:n], wrapped[n:], nil)
+}
+
+//Check the key is read when the provider is created
+func (f *fileKeyProvider) Check(ctx context.Context) error {
+	return nil
+}
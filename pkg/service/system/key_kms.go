@@ -0,0 +1,91 @@
+package system
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/instrument"
+)
+
+//kmsKeyProvider data keys wrapped by the aws kms key, the client is created with the system identity on first use
+type kmsKeyProvider struct {
+	region string
+	keyID  string
+
+	mu  sync.Mutex
+	kms *kms.KMS
+}
+
+func newKMSKeyProvider(region, keyID string) (*kmsKeyProvider, error) {
+	if region == "" || keyID == "" {
+		return nil, errors.New("kms region and key id are required")
+	}
+	return &kmsKeyProvider{region: region, keyID: keyID}, nil
+}
+
+func (k *kmsKeyProvider) Name() string {
+	return KeyProviderKMS
+}
+
+func (k *kmsKeyProvider) client() (*kms.KMS, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.kms != nil {
+		return k.kms, nil
+	}
+	sess, err := createSession(k.region)
+	if err != nil {
+		return nil, err
+	}
+	k.kms = kms.New(instrument.AWS(sess))
+	return k.kms, nil
+}
+
+func (k *kmsKeyProvider) GenerateDataKey(ctx context.Context) ([]byte, []byte, error) {
+	client, err := k.client()
+	if err != nil {
+		return nil, nil, err
+	}
+	out, err := client.GenerateDataKeyWithContext(ctx, &kms.GenerateDataKeyInput{
+		KeyId:   aws.String(k.keyID),
+		KeySpec: aws.String(kms.DataKeySpecAes256),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return out.Plaintext, out.CiphertextBlob, nil
+}
+
+func (k *kmsKeyProvider) Decrypt(ctx context.Context, wrapped []byte) ([]byte, error) {
+	client, err := k.client()
+	if err != nil {
+		return nil, err
+	}
+	out, err := client.DecryptWithContext(ctx, &kms.DecryptInput{
+		KeyId:          aws.String(k.keyID),
+		CiphertextBlob: wrapped,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.Plaintext, nil
+}
+
+//Check describes the key, which must be enabled
+func (k *kmsKeyProvider) Check(ctx context.Context) error {
+	client, err := k.client()
+	if err != nil {
+		return err
+	}
+	out, err := client.DescribeKeyWithContext(ctx, &kms.DescribeKeyInput{KeyId: aws.String(k.keyID)})
+	if err != nil {
+		return err
+	}
+	if state := aws.StringValue(out.KeyMetadata.KeyState); state != kms.KeyStateEnabled {
+		return errors.Errorf("kms key '%s' is %s", k.keyID, state)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package system
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+//vaultKeyProvider data keys wrapped by the key of the vault transit engine
+type vaultKeyProvider struct {
+	*vaultClient
+	mount string
+	key   string
+}
+
+func newVaultKeyProvider(addr, token, namespace, mount, key string) (*vaultKeyProvider, error) {
+	client, err := newVaultClient(addr, token, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if key == "" {
+		return nil, errors.New("vault transit key is required")
+	}
+	if mount == "" {
+		mount = "transit"
+	}
+	return &vaultKeyProvider{vaultClient: client, mount: strings.Trim(mount, "/"), key: key}, nil
+}
+
+func (v *vaultKeyProvider) Name() string {
+	return KeyProviderVault
+}
+
+//transit sends the request to the transit engine, missing key is not reported as missing secret
+func (v *vaultKeyProvider) transit(ctx context.Context, op string, body, out interface{}) error {
+	err := v.do(ctx, http.MethodPost, fmt.Sprintf("/v1/%s/%s/%s", v.mount, op, v.key), body, out)
+	if errors.Is(err, ErrSecretNotFound) {
+		return errors.Errorf("vault transit key '%s' not found in '%s'", v.key, v.mount)
+	}
+	return err
+}
+
+func (v *vaultKeyProvider) GenerateDataKey(ctx context.Context) ([]byte, []byte, error) {
+	out := struct {
+		Plaintext  string `json:"plaintext"`
+		Ciphertext string `json:"ciphertext"`
+	}{}
+	if err := v.transit(ctx, "datakey/plaintext", map[string]interface{}{"bits": dataKeySize * 8}, &out); err != nil {
+		return nil, nil, err
+	}
+	plain, err := base64.StdEncoding.DecodeString(out.Plaintext)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "vault transit: invalid data key")
+	}
+	return plain, []byte(out.Ciphertext), nil
+}
+
+func (v *vaultKeyProvider) Decrypt(ctx context.Context, wrapped []byte) ([]byte, error) {
+	out := struct {
+		Plaintext string `json:"plaintext"`
+	}{}
+	if err := v.transit(ctx, "decrypt", map[string]string{"ciphertext": string(wrapped)}, &out); err != nil {
+		return nil, err
+	}
+	plain, err := base64.StdEncoding.DecodeString(out.Plaintext)
+	if err != nil {
+		return nil, errors.Wrap(err, "vault transit: invalid data key")
+	}
+	return plain, nil
+}
+
+//Check reads the transit key
+func (v *vaultKeyProvider) Check(ctx context.Context) error {
+	err := v.do(ctx, http.MethodGet, fmt.Sprintf("/v1/%s/keys/%s", v.mount, v.key), nil, nil)
+	if errors.Is(err, ErrSecretNotFound) {
+		return errors.Errorf("vault transit key '%s' not found in '%s'", v.key, v.mount)
+	}
+	return err
+}
@@ -5,16 +5,24 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/pkg/errors"
 	"gitlab.com/netbook-devs/spawner-service/pkg/config"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/instrument"
+	"gitlab.com/netbook-devs/spawner-service/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 //manages system level secrets,
@@ -26,6 +34,36 @@ type SystemCreds struct {
 	sessionToken *string
 }
 
+//ServiceAccountTokenFile projected oidc token of the spawner service account, exchanged for the system identity
+//and for the federated credentials of the accounts
+const ServiceAccountTokenFile = "/var/run/secrets/eks.amazonaws.com/serviceaccount/token"
+
+//systemIdentityExpiryWindow time before expiry the system identity is refreshed
+const systemIdentityExpiryWindow = time.Minute
+
+//systemIdentity provider of the spawner aws credentials, assumed using the web identity token of the service account.
+//credentials are kept till they are about to expire instead of being assumed for every call
+type systemIdentity struct {
+	credentials.Expiry
+}
+
+func (p *systemIdentity) Retrieve() (credentials.Value, error) {
+	creds, err := getSystemCredential()
+	if err != nil {
+		return credentials.Value{}, err
+	}
+	p.SetExpiration(aws.TimeValue(creds.Expiration), systemIdentityExpiryWindow)
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(creds.AccessKeyId),
+		SecretAccessKey: aws.StringValue(creds.SecretAccessKey),
+		SessionToken:    aws.StringValue(creds.SessionToken),
+		ProviderName:    "SpawnerSystemIdentity",
+	}, nil
+}
+
+//systemCredentials spawner credentials shared by all the system sessions, safe for concurrent use
+var systemCredentials = credentials.NewCredentials(&systemIdentity{})
+
 func getSystemCredential() (*sts.Credentials, error) {
 	ses, err := session.NewSession()
 	if err != nil {
@@ -33,7 +71,7 @@ func getSystemCredential() (*sts.Credentials, error) {
 	}
 
 	svc := sts.New(ses)
-	web_identity_token, err := os.ReadFile("/var/run/secrets/eks.amazonaws.com/serviceaccount/token")
+	web_identity_token, err := os.ReadFile(ServiceAccountTokenFile)
 	if err != nil {
 		return nil, errors.Wrap(err, "error reading web identity token")
 	}
@@ -137,12 +175,10 @@ func createSession(region string) (*session.Session, error) {
 		return sess, nil
 
 	} else {
-		stsCreds, stserr := getSystemCredential()
-
-		if stserr != nil {
-			return nil, stserr
+		if _, err := systemCredentials.Get(); err != nil {
+			return nil, err
 		}
-		cred = credentials.NewStaticCredentials(*stsCreds.AccessKeyId, *stsCreds.SecretAccessKey, *stsCreds.SessionToken)
+		cred = systemCredentials
 	}
 
 	sess, err := session.NewSession(&aws.Config{
@@ -153,61 +189,179 @@ func createSession(region string) (*session.Session, error) {
 	return sess, err
 }
 
+var (
+	secretManagersMu sync.Mutex
+	secretManagers   = map[string]*secretsmanager.SecretsManager{}
+)
+
+//getSecretManager secrets manager client of the region, created once and reused. the system credentials of the
+//client are refreshed when they expire
 func getSecretManager(region string) (*secretsmanager.SecretsManager, error) {
+	secretManagersMu.Lock()
+	defer secretManagersMu.Unlock()
+	if sm, ok := secretManagers[region]; ok {
+		return sm, nil
+	}
 
 	sess, err := createSession(region)
 	if err != nil {
 		return nil, err
 	}
 
-	secretManager := secretsmanager.New(sess)
-
+	secretManager := secretsmanager.New(instrument.AWS(sess))
+	secretManagers[region] = secretManager
 	return secretManager, nil
 }
 
-//GetAwsCredentials Retrieve user credentials from the secret manager
-func GetAwsCredentials(ctx context.Context, region, accountName string) (*credentials.Credentials, error) {
-	c, err := GetCredentials(ctx, region, accountName, constants.CredAws)
+//GetAwsCredentials Retrieve user credentials from the secret store. access keys of the account are used when
+//present, the role of the account is assumed otherwise
+func GetAwsCredentials(ctx context.Context, accountName string) (*credentials.Credentials, error) {
+	c, err := FindCredentials(ctx, accountName, constants.CredAws, constants.CredAwsRole)
 	if err != nil {
 		return nil, err
 	}
+	if role := c.GetAwsRole(); role != nil {
+		return roleCredentials(accountName, role)
+	}
 	return credentials.NewStaticCredentials(c.GetAws().Id, c.GetAws().Secret, c.GetAws().Token), nil
 }
 
+//FindCredentials first of the cred types stored for the account, the error of the first type is returned when none
+//of them is stored
+func FindCredentials(ctx context.Context, accountName string, credTypes ...string) (Credentials, error) {
+	var first error
+	for _, credType := range credTypes {
+		c, err := GetCredentials(ctx, accountName, credType)
+		if err == nil {
+			return c, nil
+		}
+		if !errors.Is(err, ErrSecretNotFound) {
+			return nil, err
+		}
+		if first == nil {
+			first = err
+		}
+	}
+	return nil, first
+}
+
+//stsRegion region of the sts client assuming the account roles
+const stsRegion = "us-east-1"
+
+//maxRoleSessionName length limit of the role session name
+const maxRoleSessionName = 64
+
+var invalidRoleSessionChars = regexp.MustCompile(`[^\w+=,.@-]`)
+
+//roleSessionName session name of the assumed role, shows up in the cloudtrail of the account
+func roleSessionName(account string) string {
+	name := "spawner-" + invalidRoleSessionChars.ReplaceAllString(account, "-")
+	if len(name) > maxRoleSessionName {
+		name = name[:maxRoleSessionName]
+	}
+	return name
+}
+
+//AssumeRoleCredentials credentials of the role, assumed through sts with the spawner system identity as the source.
+//the role is assumed again when the credentials are about to expire
+func AssumeRoleCredentials(role *AwsRoleCredential) (*credentials.Credentials, error) {
+	sess, err := createSession(stsRegion)
+	if err != nil {
+		return nil, errors.Wrap(err, "AssumeRoleCredentials: failed to create system session")
+	}
+
+	provider := &stscreds.AssumeRoleProvider{
+		Client:          sts.New(instrument.AWS(sess)),
+		RoleARN:         role.RoleArn,
+		RoleSessionName: roleSessionName(role.Name),
+		Duration:        stscreds.DefaultDuration,
+		ExpiryWindow:    systemIdentityExpiryWindow,
+	}
+	if role.ExternalID != "" {
+		provider.ExternalID = aws.String(role.ExternalID)
+	}
+	if role.SessionPolicy != "" {
+		provider.Policy = aws.String(role.SessionPolicy)
+	}
+	return credentials.NewCredentials(provider), nil
+}
+
+var (
+	roleCredentialsMu sync.Mutex
+	//roleCredentialsCache assumed roles by account, reused by the sessions till the stored role changes
+	roleCredentialsCache = map[string]cachedRoleCredentials{}
+)
+
+type cachedRoleCredentials struct {
+	role  string
+	creds *credentials.Credentials
+}
+
+//roleCredentials assumed credentials of the role, shared by all the sessions of the account so that the role is
+//not assumed on every session
+func roleCredentials(account string, role *AwsRoleCredential) (*credentials.Credentials, error) {
+	roleCredentialsMu.Lock()
+	defer roleCredentialsMu.Unlock()
+
+	value := role.AsSecretValue()
+	if c, ok := roleCredentialsCache[account]; ok && c.role == value {
+		return c.creds, nil
+	}
+
+	creds, err := AssumeRoleCredentials(role)
+	if err != nil {
+		return nil, err
+	}
+	roleCredentialsCache[account] = cachedRoleCredentials{role: value, creds: creds}
+	return creds, nil
+}
+
 //sid create secrete id using credType and account name
 func sid(credType, name string) string {
 	return fmt.Sprintf("%s/%s", credType, name)
 }
 
 //GetCredentials retrieve crendential for the given cred type of a account
-func GetCredentials(ctx context.Context, region, accountName, credType string) (Credentials, error) {
-	secret, err := getSecretManager(region)
+func GetCredentials(ctx context.Context, accountName, credType string) (Credentials, error) {
+	return GetCredentialsInStage(ctx, accountName, credType, StageCurrent)
+}
+
+//GetCredentialsInStage retrieve the version of the crendential in stage, such as the pending one of rotation
+func GetCredentialsInStage(ctx context.Context, accountName, credType, stage string) (cred Credentials, err error) {
+	ctx, span := tracing.Start(ctx, "secrets.GetCredentials",
+		attribute.String("spawner.account", accountName),
+		attribute.String("spawner.credential_type", credType),
+		attribute.String("spawner.credential_stage", stage))
+	defer func() { tracing.End(span, err) }()
+
+	store, err := getStore()
 	if err != nil {
-		return nil, errors.Wrapf(err, "GetCredentials: failed to get secretsmanager")
-	}
-	s := sid(credType, accountName)
-	input := &secretsmanager.GetSecretValueInput{
-		SecretId:     &s,
-		VersionStage: aws.String("AWSCURRENT"),
+		return nil, errors.Wrap(err, "GetCredentials: failed to get secret store")
 	}
-
-	result, err := secret.GetSecretValueWithContext(ctx, input)
+	value, err := store.Get(ctx, sid(credType, accountName), stage)
 	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			return nil, errors.Wrapf(aerr, "GetAwsCredentials: failed to fetch user credentials")
-		}
+		return nil, errors.Wrap(err, "GetCredentials: failed to fetch user credentials")
 	}
 
-	var cred Credentials
 	switch credType {
 	case constants.CredAws:
-		cred, err = NewAwsCredential(*result.SecretString)
+		cred, err = NewAwsCredential(value)
+	case constants.CredAwsRole:
+		var role *AwsRoleCredential
+		if role, err = NewAwsRoleCredential(value); err == nil {
+			role.Name = accountName
+			cred = role
+		}
 	case constants.CredAzure:
-		cred, err = NewAzureCredential(*result.SecretString)
+		cred, err = NewAzureCredential(value)
+	case constants.CredAzureFederated:
+		cred, err = NewAzureFederatedCredential(value)
 	case constants.CredGitPat:
-		cred, err = NewGitPAT(*result.SecretString)
+		cred, err = NewGitPAT(value)
 	case constants.GcpLabel:
-		cred, err = NewGcpCredential(*result.SecretString)
+		cred, err = NewGcpCredential(value)
+	case constants.CredGcpFederated:
+		cred, err = NewGcpFederatedCredential(value)
 	}
 
 	if err != nil {
@@ -216,51 +370,74 @@ func GetCredentials(ctx context.Context, region, accountName, credType string) (
 	return cred, nil
 }
 
-//WriteOrUpdateCredential Creates a new secrets in AWS, updates the existing if key already present
+//WriteOrUpdateCredential Creates a new secret in the secret store, updates the existing if key already present
 // update will be set to true when key Update operation is perfromed,
 // false on new secret creation
-func WriteOrUpdateCredential(ctx context.Context, region, account, credType string, cred Credentials) (update bool, err error) {
-
-	secret, err := getSecretManager(region)
+func WriteOrUpdateCredential(ctx context.Context, account, credType string, cred Credentials) (update bool, err error) {
+	store, err := getStore()
 	if err != nil {
 		return false, err
 	}
-	value := cred.AsSecretValue()
+	return store.Put(ctx, sid(credType, account), cred.AsSecretValue())
+}
 
-	s := sid(credType, account)
-	input := &secretsmanager.GetSecretValueInput{
-		SecretId:     &s,
-		VersionStage: aws.String("AWSCURRENT"),
-	}
+//CredentialInfo stored credential, without the secret
+type CredentialInfo struct {
+	Account   string
+	Type      string
+	UpdatedAt time.Time
+}
 
-	exist := true
-	result, err := secret.GetSecretValueWithContext(ctx, input)
+//ListCredentials lists the credentials of the type
+func ListCredentials(ctx context.Context, credType string) ([]CredentialInfo, error) {
+	store, err := getStore()
 	if err != nil {
-		//check if key exist
-		if aerr, ok := err.(awserr.Error); ok {
-			if aerr.Code() == secretsmanager.ErrCodeResourceNotFoundException {
-				exist = false
-			}
-		}
-		//we will ignore any other might have accured, which is most lilkey to get caugh next,
-		//handling error here becomes tedius,
+		return nil, err
+	}
+	prefix := sid(credType, "")
+	secrets, err := store.List(ctx, prefix)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list '%s' credentials", credType)
+	}
+	creds := make([]CredentialInfo, 0, len(secrets))
+	for _, s := range secrets {
+		creds = append(creds, CredentialInfo{Account: strings.TrimPrefix(s.ID, prefix), Type: credType, UpdatedAt: s.UpdatedAt})
 	}
+	return creds, nil
+}
 
-	if !exist {
+//DeleteCredential removes the credential along with all its versions
+func DeleteCredential(ctx context.Context, account, credType string) error {
+	store, err := getStore()
+	if err != nil {
+		return err
+	}
+	return store.Delete(ctx, sid(credType, account))
+}
 
-		_, err = secret.CreateSecretWithContext(ctx, &secretsmanager.CreateSecretInput{
-			Name:         &s,
-			SecretString: &value,
-		})
-		update = false
-	} else {
-		_, err = secret.UpdateSecretWithContext(ctx, &secretsmanager.UpdateSecretInput{
+//StageCredential sets the pending version of the credential being rotated, current one is used till it is promoted
+func StageCredential(ctx context.Context, account, credType string, cred Credentials) (version string, err error) {
+	store, err := getStore()
+	if err != nil {
+		return "", err
+	}
+	return store.Stage(ctx, sid(credType, account), cred.AsSecretValue())
+}
 
-			SecretId:     result.Name,
-			SecretString: &value,
-		})
-		update = true
+//PromoteCredential makes the pending version of the credential current, the replaced one is kept as previous
+func PromoteCredential(ctx context.Context, account, credType string) (version string, err error) {
+	store, err := getStore()
+	if err != nil {
+		return "", err
 	}
-	return
+	return store.Promote(ctx, sid(credType, account))
+}
 
+//CredentialVersions current, pending and previous versions of the credential
+func CredentialVersions(ctx context.Context, account, credType string) ([]SecretVersion, error) {
+	store, err := getStore()
+	if err != nil {
+		return nil, err
+	}
+	return store.Versions(ctx, sid(credType, account))
 }
@@ -0,0 +1,132 @@
+package system
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/config"
+)
+
+const (
+	StoreAWS   = "aws"
+	StoreVault = "vault"
+	StoreFile  = "file"
+)
+
+//stages of the secret versions, a secret being rotated has the new value in pending till it is promoted
+const (
+	StageCurrent  = "current"
+	StagePending  = "pending"
+	StagePrevious = "previous"
+)
+
+var (
+	ErrSecretNotFound   = errors.New("secret not found")
+	ErrNoPendingVersion = errors.New("secret has no pending version")
+	//ErrSecretConflict secret was modified by another writer since it was read
+	ErrSecretConflict = errors.New("secret was modified concurrently")
+)
+
+//SecretInfo secret listed by the store, without the value
+type SecretInfo struct {
+	ID        string
+	UpdatedAt time.Time
+}
+
+//SecretVersion version kept by the store and the stages it is labelled with
+type SecretVersion struct {
+	ID        string
+	Stages    []string
+	CreatedAt time.Time
+}
+
+//SecretStore keeps the account credentials, secrets are identified by '<cred type>/<account>'
+//
+//store keeps the current, pending and previous versions of each secret. Put replaces the current value and
+//keeps the replaced one as previous, Stage sets the pending value without changing the current one and Promote
+//makes the pending value current.
+type SecretStore interface {
+	//Get returns the value of the secret in stage, ErrSecretNotFound when it does not exist
+	Get(ctx context.Context, id, stage string) (string, error)
+	//Put creates or updates the secret, update is true when the secret already existed
+	Put(ctx context.Context, id, value string) (update bool, err error)
+	//Stage sets the pending value of the existing secret, returns the version created
+	Stage(ctx context.Context, id, value string) (version string, err error)
+	//Promote makes the pending value current, ErrNoPendingVersion when no value is staged
+	Promote(ctx context.Context, id string) (version string, err error)
+	//Versions lists the versions of the secret which carry a stage
+	Versions(ctx context.Context, id string) ([]SecretVersion, error)
+	//List returns the secrets with the id prefix
+	List(ctx context.Context, prefix string) ([]SecretInfo, error)
+	//Delete removes the secret along with all its versions
+	Delete(ctx context.Context, id string) error
+	//Check returns error when the store cannot be used
+	Check(ctx context.Context) error
+}
+
+var (
+	storeMu sync.Mutex
+	store   SecretStore
+)
+
+//NewSecretStore creates the store selected by SECRET_STORE, aws secrets manager when not set
+func NewSecretStore(conf config.Config) (SecretStore, error) {
+	switch conf.SecretStore {
+	case "", StoreAWS:
+		return newAwsStore(conf.SecretHostRegion), nil
+	case StoreVault:
+		return newVaultStore(conf.VaultAddr, conf.VaultToken, conf.VaultNamespace, conf.VaultMount, conf.VaultPathPrefix)
+	case StoreFile:
+		return newFileStore(conf.SecretFilePath, conf.SecretFileKey)
+	}
+	return nil, fmt.Errorf("unknown secret store '%s', must be one of ['%s', '%s', '%s']", conf.SecretStore, StoreAWS, StoreVault, StoreFile)
+}
+
+//credentialCacheTTL time the credentials read from the store are cached, CREDENTIAL_CACHE_TTL_IN_SECONDS is read
+//on every call to apply the reloaded config
+func credentialCacheTTL() time.Duration {
+	return time.Duration(config.Get().CredentialCacheTTL) * time.Second
+}
+
+//getStore returns the configured store with the credential cache, created on first use. values are encrypted
+//before they reach the store when the key provider is configured
+func getStore() (SecretStore, error) {
+	storeMu.Lock()
+	defer storeMu.Unlock()
+	if store != nil {
+		return store, nil
+	}
+	conf := config.Get()
+	s, err := NewSecretStore(conf)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := NewKeyProvider(conf)
+	if err != nil {
+		return nil, err
+	}
+	if keys != nil {
+		s = newEnvelopeStore(s, keys, conf.CredentialAllowPlaintext)
+	}
+	store = newCachedStore(s, credentialCacheTTL)
+	return store, nil
+}
+
+//SetStore replaces the store used for the account credentials
+func SetStore(s SecretStore) {
+	storeMu.Lock()
+	defer storeMu.Unlock()
+	store = s
+}
+
+//CheckCredentials checks the secret store holding the account credentials can be used
+func CheckCredentials(ctx context.Context) error {
+	s, err := getStore()
+	if err != nil {
+		return err
+	}
+	return s.Check(ctx)
+}
@@ -0,0 +1,271 @@
+package system
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/config"
+)
+
+//awsStages secrets manager version stages of the store stages
+var awsStages = map[string]string{
+	StageCurrent:  "AWSCURRENT",
+	StagePending:  "AWSPENDING",
+	StagePrevious: "AWSPREVIOUS",
+}
+
+//awsStore keeps the secrets in aws secrets manager of the region, stages are the secrets manager version stages
+type awsStore struct {
+	region string
+}
+
+func newAwsStore(region string) *awsStore {
+	return &awsStore{region: region}
+}
+
+func notFound(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && aerr.Code() == secretsmanager.ErrCodeResourceNotFoundException
+}
+
+//pendingDeletion secrets manager rejects the calls on the secret scheduled for deletion with InvalidRequestException
+func pendingDeletion(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && aerr.Code() == secretsmanager.ErrCodeInvalidRequestException && strings.Contains(aerr.Message(), "marked for deletion")
+}
+
+//missing secret does not exist or is scheduled for deletion, deleted secrets are not found till they are written again
+func missing(err error) bool {
+	return notFound(err) || pendingDeletion(err)
+}
+
+func (a *awsStore) client() (*secretsmanager.SecretsManager, error) {
+	secret, err := getSecretManager(a.region)
+	return secret, errors.Wrap(err, "failed to get secretsmanager")
+}
+
+func (a *awsStore) Get(ctx context.Context, id, stage string) (string, error) {
+	secret, err := a.client()
+	if err != nil {
+		return "", err
+	}
+	awsStage, ok := awsStages[stage]
+	if !ok {
+		return "", errors.Errorf("unknown secret stage '%s'", stage)
+	}
+
+	result, err := secret.GetSecretValueWithContext(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId:     &id,
+		VersionStage: &awsStage,
+	})
+	if missing(err) {
+		return "", errors.Wrapf(ErrSecretNotFound, "secret '%s' %s", id, stage)
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "failed to fetch secret")
+	}
+	return aws.StringValue(result.SecretString), nil
+}
+
+func (a *awsStore) Put(ctx context.Context, id, value string) (bool, error) {
+	secret, err := a.client()
+	if err != nil {
+		return false, err
+	}
+
+	desc, err := secret.DescribeSecretWithContext(ctx, &secretsmanager.DescribeSecretInput{SecretId: &id})
+	if notFound(err) {
+		_, err = secret.CreateSecretWithContext(ctx, &secretsmanager.CreateSecretInput{
+			Name:         &id,
+			SecretString: &value,
+		})
+		return false, err
+	}
+	if err != nil {
+		return false, err
+	}
+
+	//deleted secret keeps its name till the recovery window ends, it is restored and written as a new one
+	existed := desc.DeletedDate == nil
+	if !existed {
+		if _, err := secret.RestoreSecretWithContext(ctx, &secretsmanager.RestoreSecretInput{SecretId: &id}); err != nil {
+			return false, errors.Wrap(err, "failed to restore secret scheduled for deletion")
+		}
+	}
+
+	_, err = secret.UpdateSecretWithContext(ctx, &secretsmanager.UpdateSecretInput{
+		SecretId:     &id,
+		SecretString: &value,
+	})
+	return existed, err
+}
+
+func (a *awsStore) Stage(ctx context.Context, id, value string) (string, error) {
+	secret, err := a.client()
+	if err != nil {
+		return "", err
+	}
+
+	//the pending label moves to the new version when already set on another one
+	out, err := secret.PutSecretValueWithContext(ctx, &secretsmanager.PutSecretValueInput{
+		SecretId:      &id,
+		SecretString:  &value,
+		VersionStages: aws.StringSlice([]string{awsStages[StagePending]}),
+	})
+	if missing(err) {
+		return "", errors.Wrapf(ErrSecretNotFound, "secret '%s'", id)
+	}
+	if err != nil {
+		return "", err
+	}
+	return aws.StringValue(out.VersionId), nil
+}
+
+func (a *awsStore) Promote(ctx context.Context, id string) (string, error) {
+	secret, err := a.client()
+	if err != nil {
+		return "", err
+	}
+
+	desc, err := secret.DescribeSecretWithContext(ctx, &secretsmanager.DescribeSecretInput{SecretId: &id})
+	if notFound(err) || (err == nil && desc.DeletedDate != nil) {
+		return "", errors.Wrapf(ErrSecretNotFound, "secret '%s'", id)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	var pending, current string
+	for version, stages := range desc.VersionIdsToStages {
+		for _, stage := range aws.StringValueSlice(stages) {
+			switch stage {
+			case awsStages[StagePending]:
+				pending = version
+			case awsStages[StageCurrent]:
+				current = version
+			}
+		}
+	}
+	if pending == "" {
+		return "", errors.Wrapf(ErrNoPendingVersion, "secret '%s'", id)
+	}
+
+	//secrets manager labels the version losing AWSCURRENT as AWSPREVIOUS
+	_, err = secret.UpdateSecretVersionStageWithContext(ctx, &secretsmanager.UpdateSecretVersionStageInput{
+		SecretId:            &id,
+		VersionStage:        aws.String(awsStages[StageCurrent]),
+		MoveToVersionId:     &pending,
+		RemoveFromVersionId: &current,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to promote pending version")
+	}
+	_, err = secret.UpdateSecretVersionStageWithContext(ctx, &secretsmanager.UpdateSecretVersionStageInput{
+		SecretId:            &id,
+		VersionStage:        aws.String(awsStages[StagePending]),
+		RemoveFromVersionId: &pending,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to remove pending stage")
+	}
+	return pending, nil
+}
+
+func (a *awsStore) Versions(ctx context.Context, id string) ([]SecretVersion, error) {
+	secret, err := a.client()
+	if err != nil {
+		return nil, err
+	}
+
+	stages := map[string]string{}
+	for stage, awsStage := range awsStages {
+		stages[awsStage] = stage
+	}
+
+	versions := []SecretVersion{}
+	err = secret.ListSecretVersionIdsPagesWithContext(ctx, &secretsmanager.ListSecretVersionIdsInput{SecretId: &id},
+		func(page *secretsmanager.ListSecretVersionIdsOutput, last bool) bool {
+			for _, v := range page.Versions {
+				version := SecretVersion{ID: aws.StringValue(v.VersionId), CreatedAt: aws.TimeValue(v.CreatedDate)}
+				for _, s := range aws.StringValueSlice(v.VersionStages) {
+					if stage, ok := stages[s]; ok {
+						version.Stages = append(version.Stages, stage)
+					}
+				}
+				if len(version.Stages) > 0 {
+					versions = append(versions, version)
+				}
+			}
+			return true
+		})
+	if missing(err) {
+		return nil, errors.Wrapf(ErrSecretNotFound, "secret '%s'", id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i].CreatedAt.After(versions[j].CreatedAt) })
+	return versions, nil
+}
+
+func (a *awsStore) List(ctx context.Context, prefix string) ([]SecretInfo, error) {
+	secret, err := a.client()
+	if err != nil {
+		return nil, err
+	}
+
+	input := &secretsmanager.ListSecretsInput{}
+	if prefix != "" {
+		input.Filters = []*secretsmanager.Filter{{Key: aws.String(secretsmanager.FilterNameStringTypeName), Values: aws.StringSlice([]string{prefix})}}
+	}
+	secrets := []SecretInfo{}
+	err = secret.ListSecretsPagesWithContext(ctx, input, func(page *secretsmanager.ListSecretsOutput, last bool) bool {
+		for _, s := range page.SecretList {
+			//name filter matches the prefix of any word in the name
+			name := aws.StringValue(s.Name)
+			if !strings.HasPrefix(name, prefix) {
+				continue
+			}
+			updated := aws.TimeValue(s.LastChangedDate)
+			if updated.IsZero() {
+				updated = aws.TimeValue(s.CreatedDate)
+			}
+			secrets = append(secrets, SecretInfo{ID: name, UpdatedAt: updated})
+		}
+		return true
+	})
+	return secrets, err
+}
+
+//Delete schedules the deletion of the secret, secrets manager keeps it for the recovery window of 30 days. the
+//secret is not found meanwhile, writing it again restores the secret with the new value
+func (a *awsStore) Delete(ctx context.Context, id string) error {
+	secret, err := a.client()
+	if err != nil {
+		return err
+	}
+	_, err = secret.DeleteSecretWithContext(ctx, &secretsmanager.DeleteSecretInput{SecretId: &id})
+	if missing(err) {
+		return errors.Wrapf(ErrSecretNotFound, "secret '%s'", id)
+	}
+	return err
+}
+
+//Check checks the spawner credentials used to access the secrets manager can be obtained
+func (a *awsStore) Check(ctx context.Context) error {
+	if config.Get().Env == "local" {
+		sess, err := getLocalEnvSession(a.region)
+		if err != nil {
+			return err
+		}
+		_, err = sess.Config.Credentials.GetWithContext(ctx)
+		return errors.Wrap(err, "unable to get the aws credentials")
+	}
+	_, err := systemCredentials.GetWithContext(ctx)
+	return err
+}
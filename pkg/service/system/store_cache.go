@@ -0,0 +1,122 @@
+package system
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
+	"golang.org/x/sync/singleflight"
+)
+
+//cachedStore caches the values read from the store and the secrets found missing, cached values of a secret are dropped when the secret is
+//written, rotated or deleted through the store. other spawner replicas read the change once their cached value
+//expires.
+//
+//concurrent reads of a secret missing in cache share a single read from the store, made on behalf of all of them. a
+//caller giving up does not fail the read for the others
+type cachedStore struct {
+	SecretStore
+	//ttl how long the values are cached, cache is bypassed when not positive
+	ttl func() time.Duration
+
+	mu     sync.RWMutex
+	values map[string]cachedValue
+	//generation incremented on every invalidation, values read before it are not cached
+	generation uint64
+	group      singleflight.Group
+}
+
+//sharedReadTimeout max duration of the read shared by the concurrent callers
+const sharedReadTimeout = 30 * time.Second
+
+type cachedValue struct {
+	value string
+	//err ErrSecretNotFound of the missing secrets, accounts using other credential types look them up on every call
+	err     error
+	expires time.Time
+}
+
+func newCachedStore(s SecretStore, ttl func() time.Duration) *cachedStore {
+	return &cachedStore{SecretStore: s, ttl: ttl, values: map[string]cachedValue{}}
+}
+
+func cacheKey(id, stage string) string {
+	return id + "@" + stage
+}
+
+func (c *cachedStore) Get(ctx context.Context, id, stage string) (string, error) {
+	ttl := c.ttl()
+	if ttl <= 0 {
+		return c.SecretStore.Get(ctx, id, stage)
+	}
+
+	key := cacheKey(id, stage)
+	c.mu.RLock()
+	v, ok := c.values[key]
+	c.mu.RUnlock()
+	if ok && time.Now().Before(v.expires) {
+		return v.value, v.err
+	}
+
+	ch := c.group.DoChan(key, func() (interface{}, error) {
+		c.mu.RLock()
+		generation := c.generation
+		c.mu.RUnlock()
+
+		//the read shared by the concurrent callers must not fail when the one which started it goes away
+		readCtx, cancel := context.WithTimeout(common.Detach(ctx), sharedReadTimeout)
+		defer cancel()
+		value, err := c.SecretStore.Get(readCtx, id, stage)
+		if err != nil && !errors.Is(err, ErrSecretNotFound) {
+			return "", err
+		}
+		c.mu.Lock()
+		if generation == c.generation {
+			c.values[key] = cachedValue{value: value, err: err, expires: time.Now().Add(ttl)}
+		}
+		c.mu.Unlock()
+		return value, err
+	})
+
+	select {
+	case res := <-ch:
+		return res.Val.(string), res.Err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+//invalidate drops the cached values of all the stages of the secret
+func (c *cachedStore) invalidate(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	for _, stage := range []string{StageCurrent, StagePending, StagePrevious} {
+		key := cacheKey(id, stage)
+		delete(c.values, key)
+		//reads in flight return the old value to their callers, later reads go to the store
+		c.group.Forget(key)
+	}
+}
+
+func (c *cachedStore) Put(ctx context.Context, id, value string) (bool, error) {
+	defer c.invalidate(id)
+	return c.SecretStore.Put(ctx, id, value)
+}
+
+func (c *cachedStore) Stage(ctx context.Context, id, value string) (string, error) {
+	defer c.invalidate(id)
+	return c.SecretStore.Stage(ctx, id, value)
+}
+
+func (c *cachedStore) Promote(ctx context.Context, id string) (string, error) {
+	defer c.invalidate(id)
+	return c.SecretStore.Promote(ctx, id)
+}
+
+func (c *cachedStore) Delete(ctx context.Context, id string) error {
+	defer c.invalidate(id)
+	return c.SecretStore.Delete(ctx, id)
+}
@@ -0,0 +1,226 @@
+package system
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/netbookai/log"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/config"
+)
+
+const (
+	KeyProviderKMS   = "aws-kms"
+	KeyProviderVault = "vault-transit"
+	KeyProviderFile  = "file"
+)
+
+//envelopePrefix marks the values sealed by the envelope store, followed by the key provider, the wrapped data key
+//and the sealed value separated by ':'
+const envelopePrefix = "envelope:v1:"
+
+//dataKeySize AES-256 data keys
+const dataKeySize = 32
+
+//maxDataKeys unwrapped data keys kept by the envelope store, the cache is cleared when it grows beyond
+const maxDataKeys = 1024
+
+//KeyProvider generates the data keys the credentials are encrypted with and wraps them with its master key, which
+//never leaves the provider
+type KeyProvider interface {
+	//Name of the provider, recorded along with the wrapped data key
+	Name() string
+	//GenerateDataKey returns new AES-256 data key, in plain and wrapped with the master key
+	GenerateDataKey(ctx context.Context) (plain, wrapped []byte, err error)
+	//Decrypt unwraps the data key
+	Decrypt(ctx context.Context, wrapped []byte) ([]byte, error)
+	//Check checks the master key can be used, without generating a data key
+	Check(ctx context.Context) error
+}
+
+//NewKeyProvider creates the key provider selected by CREDENTIAL_KEY_PROVIDER, nil when credentials are not encrypted
+func NewKeyProvider(conf config.Config) (KeyProvider, error) {
+	switch conf.CredentialKeyProvider {
+	case "":
+		return nil, nil
+	case KeyProviderKMS:
+		region := conf.KMSRegion
+		if region == "" {
+			region = conf.SecretHostRegion
+		}
+		return newKMSKeyProvider(region, conf.KMSKeyID)
+	case KeyProviderVault:
+		return newVaultKeyProvider(conf.VaultAddr, conf.VaultToken, conf.VaultNamespace, conf.VaultTransitMount, conf.VaultTransitKey)
+	case KeyProviderFile:
+		return newFileKeyProvider(conf.CredentialKeyFile)
+	}
+	return nil, fmt.Errorf("unknown credential key provider '%s', must be one of ['%s', '%s', '%s']",
+		conf.CredentialKeyProvider, KeyProviderKMS, KeyProviderVault, KeyProviderFile)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+//envelopeStore encrypts the values before they are written to the store and decrypts them on read, so the store
+//never holds the plain credentials. each value is sealed with its own data key, kept wrapped by the key provider
+//along with the value. the secret id is authenticated with the value, a sealed value copied to another secret
+//cannot be opened.
+//
+//values written before the encryption was enabled are refused, unless CREDENTIAL_ALLOW_PLAINTEXT is set while they
+//are migrated. they are then read as they are, and sealed on their next write
+type envelopeStore struct {
+	SecretStore
+	keys           KeyProvider
+	allowPlaintext bool
+	logger         log.Logger
+
+	mu sync.Mutex
+	//dataKeys unwrapped data keys by the wrapped key, saves a call to the provider on every read
+	dataKeys map[string][]byte
+}
+
+func newEnvelopeStore(s SecretStore, keys KeyProvider, allowPlaintext bool) *envelopeStore {
+	return &envelopeStore{
+		SecretStore:    s,
+		keys:           keys,
+		allowPlaintext: allowPlaintext,
+		logger:         log.GetLogger(),
+		dataKeys:       map[string][]byte{},
+	}
+}
+
+func (e *envelopeStore) seal(ctx context.Context, id, value string) (string, error) {
+	plain, wrapped, err := e.keys.GenerateDataKey(ctx)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to generate data key with '%s'", e.keys.Name())
+	}
+	aead, err := newGCM(plain)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(value), []byte(id))
+	return envelopePrefix + strings.Join([]string{
+		e.keys.Name(),
+		base64.StdEncoding.EncodeToString(wrapped),
+		base64.StdEncoding.EncodeToString(sealed),
+	}, ":"), nil
+}
+
+func (e *envelopeStore) open(ctx context.Context, id, value string) (string, error) {
+	if !strings.HasPrefix(value, envelopePrefix) {
+		if !e.allowPlaintext {
+			return "", errors.Errorf("secret '%s' is not encrypted, set CREDENTIAL_ALLOW_PLAINTEXT to read it", id)
+		}
+		e.logger.Warn(ctx, "envelopeStore: read unencrypted secret", "id", id)
+		return value, nil
+	}
+	parts := strings.Split(strings.TrimPrefix(value, envelopePrefix), ":")
+	if len(parts) != 3 {
+		return "", errors.Errorf("secret '%s' is not a valid envelope", id)
+	}
+	if parts[0] != e.keys.Name() {
+		return "", errors.Errorf("secret '%s' is encrypted with '%s', configured key provider is '%s'", id, parts[0], e.keys.Name())
+	}
+	sealed, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", errors.Wrapf(err, "secret '%s' is not a valid envelope", id)
+	}
+
+	key, err := e.dataKey(ctx, parts[1])
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to decrypt data key of secret '%s'", id)
+	}
+	aead, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.Errorf("secret '%s' is not a valid envelope", id)
+	}
+	plain, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(id))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to decrypt secret '%s'", id)
+	}
+	return string(plain), nil
+}
+
+//dataKey unwraps the data key, keys already unwrapped are reused
+func (e *envelopeStore) dataKey(ctx context.Context, wrapped string) ([]byte, error) {
+	e.mu.Lock()
+	key, ok := e.dataKeys[wrapped]
+	e.mu.Unlock()
+	if ok {
+		return key, nil
+	}
+
+	w, err := base64.StdEncoding.DecodeString(wrapped)
+	if err != nil {
+		return nil, err
+	}
+	key, err = e.keys.Decrypt(ctx, w)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != dataKeySize {
+		return nil, errors.Errorf("data key must be %d bytes, got %d", dataKeySize, len(key))
+	}
+
+	e.mu.Lock()
+	if len(e.dataKeys) >= maxDataKeys {
+		e.dataKeys = map[string][]byte{}
+	}
+	e.dataKeys[wrapped] = key
+	e.mu.Unlock()
+	return key, nil
+}
+
+func (e *envelopeStore) Get(ctx context.Context, id, stage string) (string, error) {
+	value, err := e.SecretStore.Get(ctx, id, stage)
+	if err != nil {
+		return "", err
+	}
+	return e.open(ctx, id, value)
+}
+
+func (e *envelopeStore) Put(ctx context.Context, id, value string) (bool, error) {
+	sealed, err := e.seal(ctx, id, value)
+	if err != nil {
+		return false, err
+	}
+	return e.SecretStore.Put(ctx, id, sealed)
+}
+
+func (e *envelopeStore) Stage(ctx context.Context, id, value string) (string, error) {
+	sealed, err := e.seal(ctx, id, value)
+	if err != nil {
+		return "", err
+	}
+	return e.SecretStore.Stage(ctx, id, sealed)
+}
+
+//Check checks the store and the key provider
+func (e *envelopeStore) Check(ctx context.Context) error {
+	if err := e.SecretStore.Check(ctx); err != nil {
+		return err
+	}
+	if err := e.keys.Check(ctx); err != nil {
+		return errors.Wrapf(err, "credential key provider '%s'", e.keys.Name())
+	}
+	return nil
+}
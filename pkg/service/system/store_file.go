@@ -0,0 +1,303 @@
+package system
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+//fileStore keeps the secrets in a local file, encrypted with AES-256-GCM. meant for local runs and tests
+//
+//file content is nonce followed by the sealed json map of the secrets and their versions
+type fileStore struct {
+	path string
+	aead cipher.AEAD
+	mu   sync.Mutex
+}
+
+func newFileStore(path, key string) (*fileStore, error) {
+	if path == "" {
+		return nil, errors.New("secret file path is required")
+	}
+	k, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "secret file key must be base64 encoded")
+	}
+	if len(k) != 32 {
+		return nil, errors.Errorf("secret file key must be 32 bytes, got %d", len(k))
+	}
+	block, err := aes.NewCipher(k)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &fileStore{path: path, aead: aead}, nil
+}
+
+//fileSecret versions of a secret, only the versions carrying a stage are kept
+type fileSecret struct {
+	Versions  []*fileVersion `json:"versions"`
+	Next      int            `json:"next"`
+	UpdatedAt time.Time      `json:"updatedAt"`
+}
+
+type fileVersion struct {
+	ID        string    `json:"id"`
+	Value     string    `json:"value"`
+	Stages    []string  `json:"stages"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (s *fileSecret) version(stage string) *fileVersion {
+	for _, v := range s.Versions {
+		for _, st := range v.Stages {
+			if st == stage {
+				return v
+			}
+		}
+	}
+	return nil
+}
+
+//label moves the stage to the version, stage is removed when to is nil
+func (s *fileSecret) label(stage string, to *fileVersion) {
+	for _, v := range s.Versions {
+		stages := v.Stages[:0]
+		for _, st := range v.Stages {
+			if st != stage {
+				stages = append(stages, st)
+			}
+		}
+		v.Stages = stages
+	}
+	if to != nil {
+		to.Stages = append(to.Stages, stage)
+	}
+}
+
+//add creates new version of the value, versions left without stage are dropped
+func (s *fileSecret) add(value string, stage string) *fileVersion {
+	s.Next++
+	now := time.Now().UTC()
+	v := &fileVersion{ID: strconv.Itoa(s.Next), Value: value, CreatedAt: now}
+	s.Versions = append(s.Versions, v)
+	s.UpdatedAt = now
+	s.label(stage, v)
+	return v
+}
+
+func (s *fileSecret) prune() {
+	versions := s.Versions[:0]
+	for _, v := range s.Versions {
+		if len(v.Stages) > 0 {
+			versions = append(versions, v)
+		}
+	}
+	s.Versions = versions
+}
+
+//read decrypts the secrets, empty when the file does not exist yet
+func (f *fileStore) read() (map[string]*fileSecret, error) {
+	secrets := map[string]*fileSecret{}
+	b, err := ioutil.ReadFile(f.path)
+	if os.IsNotExist(err) {
+		return secrets, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	size := f.aead.NonceSize()
+	if len(b) < size {
+		return nil, errors.Errorf("secret file '%s' is corrupted", f.path)
+	}
+	plain, err := f.aead.Open(nil, b[:size], b[size:], nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decrypt secret file '%s'", f.path)
+	}
+	if err := json.Unmarshal(plain, &secrets); err != nil {
+		return nil, errors.Wrapf(err, "secret file '%s' is corrupted", f.path)
+	}
+	return secrets, nil
+}
+
+//write encrypts the secrets, the file is replaced atomically
+func (f *fileStore) write(secrets map[string]*fileSecret) error {
+	plain, err := json.Marshal(secrets)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, f.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+	sealed := f.aead.Seal(nonce, nonce, plain, nil)
+
+	tmp, err := ioutil.TempFile(filepath.Dir(f.path), filepath.Base(f.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if err := tmp.Chmod(0600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(sealed); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), f.path)
+}
+
+func (f *fileStore) Get(ctx context.Context, id, stage string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	secrets, err := f.read()
+	if err != nil {
+		return "", err
+	}
+	secret, ok := secrets[id]
+	if !ok {
+		return "", errors.Wrapf(ErrSecretNotFound, "secret '%s'", id)
+	}
+	v := secret.version(stage)
+	if v == nil {
+		return "", errors.Wrapf(ErrSecretNotFound, "secret '%s' %s", id, stage)
+	}
+	return v.Value, nil
+}
+
+func (f *fileStore) Put(ctx context.Context, id, value string) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	secrets, err := f.read()
+	if err != nil {
+		return false, err
+	}
+	secret, update := secrets[id]
+	if !update {
+		secret = &fileSecret{}
+		secrets[id] = secret
+	}
+	secret.label(StagePrevious, secret.version(StageCurrent))
+	secret.add(value, StageCurrent)
+	secret.prune()
+	return update, f.write(secrets)
+}
+
+func (f *fileStore) Stage(ctx context.Context, id, value string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	secrets, err := f.read()
+	if err != nil {
+		return "", err
+	}
+	secret, ok := secrets[id]
+	if !ok {
+		return "", errors.Wrapf(ErrSecretNotFound, "secret '%s'", id)
+	}
+	v := secret.add(value, StagePending)
+	secret.prune()
+	return v.ID, f.write(secrets)
+}
+
+func (f *fileStore) Promote(ctx context.Context, id string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	secrets, err := f.read()
+	if err != nil {
+		return "", err
+	}
+	secret, ok := secrets[id]
+	if !ok {
+		return "", errors.Wrapf(ErrSecretNotFound, "secret '%s'", id)
+	}
+	pending := secret.version(StagePending)
+	if pending == nil {
+		return "", errors.Wrapf(ErrNoPendingVersion, "secret '%s'", id)
+	}
+	secret.label(StagePrevious, secret.version(StageCurrent))
+	secret.label(StageCurrent, pending)
+	secret.label(StagePending, nil)
+	secret.UpdatedAt = time.Now().UTC()
+	secret.prune()
+	return pending.ID, f.write(secrets)
+}
+
+func (f *fileStore) Versions(ctx context.Context, id string) ([]SecretVersion, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	secrets, err := f.read()
+	if err != nil {
+		return nil, err
+	}
+	secret, ok := secrets[id]
+	if !ok {
+		return nil, errors.Wrapf(ErrSecretNotFound, "secret '%s'", id)
+	}
+	versions := make([]SecretVersion, 0, len(secret.Versions))
+	for i := len(secret.Versions) - 1; i >= 0; i-- {
+		v := secret.Versions[i]
+		versions = append(versions, SecretVersion{ID: v.ID, Stages: v.Stages, CreatedAt: v.CreatedAt})
+	}
+	return versions, nil
+}
+
+func (f *fileStore) List(ctx context.Context, prefix string) ([]SecretInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	secrets, err := f.read()
+	if err != nil {
+		return nil, err
+	}
+	list := []SecretInfo{}
+	for id, secret := range secrets {
+		if strings.HasPrefix(id, prefix) {
+			list = append(list, SecretInfo{ID: id, UpdatedAt: secret.UpdatedAt})
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	return list, nil
+}
+
+func (f *fileStore) Delete(ctx context.Context, id string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	secrets, err := f.read()
+	if err != nil {
+		return err
+	}
+	if _, ok := secrets[id]; !ok {
+		return errors.Wrapf(ErrSecretNotFound, "secret '%s'", id)
+	}
+	delete(secrets, id)
+	return f.write(secrets)
+}
+
+//Check makes sure the file, when present, can be decrypted with the key
+func (f *fileStore) Check(ctx context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_, err := f.read()
+	return err
+}
@@ -0,0 +1,453 @@
+package system
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func stages(t *testing.T, s SecretStore, id string) map[string]string {
+	versions, err := s.Versions(context.Background(), id)
+	assert.Nil(t, err)
+	out := map[string]string{}
+	for _, v := range versions {
+		for _, stage := range v.Stages {
+			out[stage] = v.ID
+		}
+	}
+	return out
+}
+
+//testLifecycle runs write, rotate, list and delete on the store
+func testLifecycle(t *testing.T, s SecretStore) {
+	ctx := context.Background()
+
+	_, err := s.Get(ctx, "aws/team-a", StageCurrent)
+	assert.True(t, errors.Is(err, ErrSecretNotFound))
+	_, err = s.Stage(ctx, "aws/team-a", "s3cr3t")
+	assert.True(t, errors.Is(err, ErrSecretNotFound), "only existing secrets can be rotated")
+
+	update, err := s.Put(ctx, "aws/team-a", "s3cr3t")
+	assert.Nil(t, err)
+	assert.False(t, update)
+	update, err = s.Put(ctx, "aws/team-a", "n3w")
+	assert.Nil(t, err)
+	assert.True(t, update)
+	_, err = s.Put(ctx, "azure/team-a", "az")
+	assert.Nil(t, err)
+
+	v, err := s.Get(ctx, "aws/team-a", StageCurrent)
+	assert.Nil(t, err)
+	assert.Equal(t, "n3w", v)
+	v, err = s.Get(ctx, "aws/team-a", StagePrevious)
+	assert.Nil(t, err)
+	assert.Equal(t, "s3cr3t", v)
+
+	_, err = s.Promote(ctx, "aws/team-a")
+	assert.True(t, errors.Is(err, ErrNoPendingVersion))
+
+	before := stages(t, s, "aws/team-a")
+	pending, err := s.Stage(ctx, "aws/team-a", "r0tated")
+	assert.Nil(t, err)
+	v, _ = s.Get(ctx, "aws/team-a", StageCurrent)
+	assert.Equal(t, "n3w", v, "current must be used till the pending version is promoted")
+	v, _ = s.Get(ctx, "aws/team-a", StagePending)
+	assert.Equal(t, "r0tated", v)
+
+	promoted, err := s.Promote(ctx, "aws/team-a")
+	assert.Nil(t, err)
+	assert.Equal(t, pending, promoted)
+	v, _ = s.Get(ctx, "aws/team-a", StageCurrent)
+	assert.Equal(t, "r0tated", v)
+	v, _ = s.Get(ctx, "aws/team-a", StagePrevious)
+	assert.Equal(t, "n3w", v)
+	_, err = s.Get(ctx, "aws/team-a", StagePending)
+	assert.True(t, errors.Is(err, ErrSecretNotFound))
+	assert.Equal(t, map[string]string{StageCurrent: pending, StagePrevious: before[StageCurrent]}, stages(t, s, "aws/team-a"))
+
+	list, err := s.List(ctx, "aws/")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(list))
+	assert.Equal(t, "aws/team-a", list[0].ID)
+	assert.False(t, list[0].UpdatedAt.IsZero())
+
+	assert.Nil(t, s.Delete(ctx, "aws/team-a"))
+	assert.True(t, errors.Is(s.Delete(ctx, "aws/team-a"), ErrSecretNotFound))
+	_, err = s.Get(ctx, "aws/team-a", StageCurrent)
+	assert.True(t, errors.Is(err, ErrSecretNotFound))
+	list, _ = s.List(ctx, "aws/")
+	assert.Equal(t, 0, len(list))
+	assert.Nil(t, s.Check(ctx))
+}
+
+func Test_fileStore(t *testing.T) {
+	key := base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+	path := filepath.Join(t.TempDir(), "secrets")
+	s, err := newFileStore(path, key)
+	assert.Nil(t, err)
+	testLifecycle(t, s)
+
+	b, _ := ioutil.ReadFile(path)
+	assert.False(t, strings.Contains(string(b), "az"), "file must be encrypted")
+
+	other, _ := newFileStore(path, base64.StdEncoding.EncodeToString([]byte("fedcba9876543210fedcba9876543210")))
+	assert.NotNil(t, other.Check(context.Background()), "wrong key must not decrypt the file")
+
+	_, err = newFileStore(path, base64.StdEncoding.EncodeToString([]byte("short")))
+	assert.NotNil(t, err)
+}
+
+type kvSecret struct {
+	versions map[int]string
+	created  map[int]time.Time
+	current  int
+	custom   map[string]string
+	updated  time.Time
+}
+
+//fakeVault kv v2 engine mounted at 'secret'
+type fakeVault struct {
+	mu      sync.Mutex
+	secrets map[string]*kvSecret
+	//interleave writes made by another writer, one ahead of each of the next writes
+	interleave int
+}
+
+func reply(w http.ResponseWriter, data interface{}) {
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+}
+
+func (f *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if r.Header.Get("X-Vault-Token") != "t0ken" {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+		return
+	}
+	if r.URL.Path == "/v1/auth/token/lookup-self" {
+		reply(w, map[string]string{})
+		return
+	}
+
+	if path := strings.TrimPrefix(r.URL.Path, "/v1/secret/data/"); path != r.URL.Path {
+		s, ok := f.secrets[path]
+		switch r.Method {
+		case http.MethodGet:
+			version, _ := strconv.Atoi(r.URL.Query().Get("version"))
+			if !ok || s.versions[version] == "" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			reply(w, map[string]interface{}{"data": map[string]string{"value": s.versions[version]}})
+		case http.MethodPost:
+			body := struct {
+				Options struct {
+					Cas *int `json:"cas"`
+				} `json:"options"`
+				Data map[string]string `json:"data"`
+			}{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if !ok {
+				s = &kvSecret{versions: map[int]string{}, created: map[int]time.Time{}}
+				f.secrets[path] = s
+			}
+			if f.interleave > 0 {
+				f.interleave--
+				s.current++
+				s.versions[s.current] = "other"
+			}
+			if body.Options.Cas != nil && *body.Options.Cas != s.current {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"errors":["check-and-set parameter did not match the current version"]}`))
+				return
+			}
+			s.current++
+			s.versions[s.current] = body.Data["value"]
+			s.created[s.current] = time.Now().Add(time.Duration(s.current) * time.Second)
+			s.updated = time.Now()
+			reply(w, map[string]int{"version": s.current})
+		}
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/v1/secret/metadata/")
+	s, ok := f.secrets[path]
+	switch r.Method {
+	case "LIST":
+		keys := []string{}
+		for id := range f.secrets {
+			if strings.HasPrefix(id, path) {
+				keys = append(keys, strings.TrimPrefix(id, path))
+			}
+		}
+		if len(keys) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		reply(w, map[string]interface{}{"keys": keys})
+	case http.MethodGet:
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		versions := map[string]interface{}{}
+		for v := range s.versions {
+			versions[strconv.Itoa(v)] = map[string]interface{}{"created_time": s.created[v], "deletion_time": "", "destroyed": false}
+		}
+		reply(w, map[string]interface{}{"current_version": s.current, "custom_metadata": s.custom, "updated_time": s.updated, "versions": versions})
+	case http.MethodPost:
+		body := struct {
+			Custom map[string]string `json:"custom_metadata"`
+		}{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		s.custom = body.Custom
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		delete(f.secrets, path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func Test_awsStoreMissing(t *testing.T) {
+	deleted := awserr.New(secretsmanager.ErrCodeInvalidRequestException,
+		"You can't perform this operation on the secret because it was marked for deletion.", nil)
+	assert.True(t, missing(deleted), "secret scheduled for deletion")
+	assert.True(t, missing(awserr.New(secretsmanager.ErrCodeResourceNotFoundException, "not found", nil)))
+	assert.False(t, missing(awserr.New(secretsmanager.ErrCodeInvalidRequestException, "invalid version stage", nil)))
+}
+
+func Test_vaultStore(t *testing.T) {
+	vault := &fakeVault{secrets: map[string]*kvSecret{}}
+	server := httptest.NewServer(vault)
+	defer server.Close()
+
+	s, err := newVaultStore(server.URL, "t0ken", "", "", "spawner")
+	assert.Nil(t, err)
+	testLifecycle(t, s)
+	assert.Equal(t, "az", vault.secrets["spawner/azure/team-a"].versions[1])
+
+	//secret written outside spawner has no stages, latest version is current
+	vault.secrets["spawner/git-pat/team-b"] = &kvSecret{versions: map[int]string{1: "old", 2: "latest"}, current: 2}
+	v, err := s.Get(context.Background(), "git-pat/team-b", StageCurrent)
+	assert.Nil(t, err)
+	assert.Equal(t, "latest", v)
+
+	//concurrent writer, the write is made again on the latest version
+	ctx := context.Background()
+	_, err = s.Put(ctx, "aws/team-c", "s3cr3t")
+	assert.Nil(t, err)
+	vault.interleave = 2
+	pending, err := s.Stage(ctx, "aws/team-c", "r0tated")
+	assert.Nil(t, err)
+	assert.Equal(t, "4", pending)
+	v, _ = s.Get(ctx, "aws/team-c", StagePending)
+	assert.Equal(t, "r0tated", v)
+	v, _ = s.Get(ctx, "aws/team-c", StageCurrent)
+	assert.Equal(t, "s3cr3t", v, "current stage must be kept")
+
+	vault.interleave = maxConflictRetries + 1
+	_, err = s.Put(ctx, "aws/team-c", "again")
+	assert.True(t, errors.Is(err, ErrSecretConflict))
+	v, _ = s.Get(ctx, "aws/team-c", StageCurrent)
+	assert.Equal(t, "s3cr3t", v, "stages must not change when the write conflicts")
+
+	bad, _ := newVaultStore(server.URL, "wrong", "", "", "")
+	err = bad.Check(context.Background())
+	assert.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), "permission denied"))
+}
+
+//countingStore counts the reads made to the store
+type countingStore struct {
+	SecretStore
+	mu    sync.Mutex
+	reads int
+}
+
+func (c *countingStore) Get(ctx context.Context, id, stage string) (string, error) {
+	c.mu.Lock()
+	c.reads++
+	c.mu.Unlock()
+	return c.SecretStore.Get(ctx, id, stage)
+}
+
+func Test_cachedStore(t *testing.T) {
+	key := base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+	file, err := newFileStore(filepath.Join(t.TempDir(), "secrets"), key)
+	assert.Nil(t, err)
+	counting := &countingStore{SecretStore: file}
+	ttl := time.Minute
+	s := newCachedStore(counting, func() time.Duration { return ttl })
+	ctx := context.Background()
+
+	_, err = s.Put(ctx, "aws/team-a", "s3cr3t")
+	assert.Nil(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := s.Get(ctx, "aws/team-a", StageCurrent)
+			assert.Nil(t, err)
+			assert.Equal(t, "s3cr3t", v)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1, counting.reads, "credential must be read from the store once")
+
+	_, err = s.Put(ctx, "aws/team-a", "n3w")
+	assert.Nil(t, err)
+	v, _ := s.Get(ctx, "aws/team-a", StageCurrent)
+	assert.Equal(t, "n3w", v, "write must invalidate the cached value")
+	assert.Equal(t, 2, counting.reads)
+
+	_, err = s.Get(ctx, "aws/team-b", StageCurrent)
+	assert.True(t, errors.Is(err, ErrSecretNotFound))
+	_, err = s.Get(ctx, "aws/team-b", StageCurrent)
+	assert.True(t, errors.Is(err, ErrSecretNotFound))
+	assert.Equal(t, 3, counting.reads, "missing secret must be cached")
+
+	_, err = s.Put(ctx, "aws/team-b", "s3cr3t")
+	assert.Nil(t, err)
+	v, _ = s.Get(ctx, "aws/team-b", StageCurrent)
+	assert.Equal(t, "s3cr3t", v, "write must invalidate the cached miss")
+	assert.Equal(t, 4, counting.reads)
+
+	ttl = 0
+	_, _ = s.Get(ctx, "aws/team-a", StageCurrent)
+	assert.Equal(t, 5, counting.reads, "cache must be bypassed when ttl is 0")
+}
+
+//blockingStore reads block till released and fail when their context is done
+type blockingStore struct {
+	SecretStore
+	started chan struct{}
+	release chan struct{}
+}
+
+func (b *blockingStore) Get(ctx context.Context, id, stage string) (string, error) {
+	close(b.started)
+	select {
+	case <-b.release:
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+	return b.SecretStore.Get(ctx, id, stage)
+}
+
+func Test_cachedStoreSharedRead(t *testing.T) {
+	key := base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+	file, err := newFileStore(filepath.Join(t.TempDir(), "secrets"), key)
+	assert.Nil(t, err)
+	_, err = file.Put(context.Background(), "aws/team-a", "s3cr3t")
+	assert.Nil(t, err)
+
+	blocking := &blockingStore{SecretStore: file, started: make(chan struct{}), release: make(chan struct{})}
+	s := newCachedStore(blocking, func() time.Duration { return time.Minute })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error)
+	go func() {
+		_, err := s.Get(ctx, "aws/team-a", StageCurrent)
+		first <- err
+	}()
+	<-blocking.started
+
+	second := make(chan string)
+	go func() {
+		v, _ := s.Get(context.Background(), "aws/team-a", StageCurrent)
+		second <- v
+	}()
+
+	cancel()
+	assert.Equal(t, context.Canceled, <-first)
+	close(blocking.release)
+	assert.Equal(t, "s3cr3t", <-second, "read must not fail when the caller which started it is cancelled")
+}
+
+//fakeTransit vault transit engine mounted at 'transit', data keys are wrapped by prefixing them
+type fakeTransit struct{}
+
+func (fakeTransit) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/v1/transit/datakey/plaintext/spawner":
+		key := base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+		reply(w, map[string]string{"plaintext": key, "ciphertext": "vault:v1:" + key})
+	case "/v1/transit/keys/spawner":
+		reply(w, map[string]string{"name": "spawner"})
+	case "/v1/transit/decrypt/spawner":
+		body := struct {
+			Ciphertext string `json:"ciphertext"`
+		}{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		reply(w, map[string]string{"plaintext": strings.TrimPrefix(body.Ciphertext, "vault:v1:")})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func Test_envelopeStore(t *testing.T) {
+	dir := t.TempDir()
+	key := base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+	keyFile := filepath.Join(dir, "key")
+	assert.Nil(t, ioutil.WriteFile(keyFile, []byte(key+"\n"), 0600))
+	keys, err := newFileKeyProvider(keyFile)
+	assert.Nil(t, err)
+
+	file, err := newFileStore(filepath.Join(dir, "secrets"), key)
+	assert.Nil(t, err)
+	s := newEnvelopeStore(file, keys, false)
+	testLifecycle(t, s)
+
+	ctx := context.Background()
+	_, err = s.Put(ctx, "aws/team-a", "s3cr3t")
+	assert.Nil(t, err)
+	raw, _ := file.Get(ctx, "aws/team-a", StageCurrent)
+	assert.True(t, strings.HasPrefix(raw, envelopePrefix+KeyProviderFile+":"))
+	assert.False(t, strings.Contains(raw, "s3cr3t"), "store must not hold the plain value")
+
+	_, _ = file.Put(ctx, "aws/team-b", raw)
+	_, err = s.Get(ctx, "aws/team-b", StageCurrent)
+	assert.NotNil(t, err, "value sealed for another secret must not open")
+
+	_, _ = file.Put(ctx, "aws/team-c", "plain")
+	_, err = s.Get(ctx, "aws/team-c", StageCurrent)
+	assert.NotNil(t, err, "values stored before the encryption are refused by default")
+	v, err := newEnvelopeStore(file, keys, true).Get(ctx, "aws/team-c", StageCurrent)
+	assert.Nil(t, err)
+	assert.Equal(t, "plain", v, "values stored before the encryption are read as they are when allowed")
+
+	server := httptest.NewServer(fakeTransit{})
+	defer server.Close()
+	transit, err := newVaultKeyProvider(server.URL, "t0ken", "", "", "spawner")
+	assert.Nil(t, err)
+	_, err = newEnvelopeStore(file, transit, false).Get(ctx, "aws/team-a", StageCurrent)
+	assert.NotNil(t, err, "value sealed with another key provider must not open")
+
+	vs := newEnvelopeStore(file, transit, false)
+	_, err = vs.Put(ctx, "aws/team-a", "s3cr3t")
+	assert.Nil(t, err)
+	v, err = vs.Get(ctx, "aws/team-a", StageCurrent)
+	assert.Nil(t, err)
+	assert.Equal(t, "s3cr3t", v)
+
+	assert.Nil(t, vs.Check(ctx))
+	missing, _ := newVaultKeyProvider(server.URL, "t0ken", "", "", "other")
+	assert.NotNil(t, newEnvelopeStore(file, missing, false).Check(ctx))
+}
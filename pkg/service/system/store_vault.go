@@ -0,0 +1,380 @@
+package system
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+//vaultStore keeps the secrets in the hashicorp vault kv v2 engine, each secret under '<mount>/data/<prefix>/<id>'
+//with the value in the 'value' field.
+//
+//kv versions are the secret versions, the version of each stage is kept in the custom metadata of the secret.
+//the latest version is current when the secret has no stages, such as the ones written outside spawner.
+//
+//values are written with check-and-set on the version read, the stages are written only when the metadata is still
+//as read. the concurrent writers retry on conflict, a writer failing between the two writes leaves an unstaged
+//version and the stages as they were
+type vaultStore struct {
+	*vaultClient
+	mount  string
+	prefix string
+}
+
+func newVaultStore(addr, token, namespace, mount, prefix string) (*vaultStore, error) {
+	client, err := newVaultClient(addr, token, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if mount == "" {
+		mount = "secret"
+	}
+	return &vaultStore{
+		vaultClient: client,
+		mount:       strings.Trim(mount, "/"),
+		prefix:      strings.Trim(prefix, "/"),
+	}, nil
+}
+
+//maxConflictRetries attempts made again when the secret is modified concurrently
+const maxConflictRetries = 5
+
+//vaultClient http client of the vault api, shared by the kv store and the transit key provider
+type vaultClient struct {
+	addr      string
+	token     string
+	namespace string
+	client    *http.Client
+}
+
+func newVaultClient(addr, token, namespace string) (*vaultClient, error) {
+	if addr == "" || token == "" {
+		return nil, errors.New("vault address and token are required")
+	}
+	return &vaultClient{
+		addr:      strings.TrimRight(addr, "/"),
+		token:     token,
+		namespace: namespace,
+		client:    &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+type vaultResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []string        `json:"errors"`
+}
+
+type vaultMetadata struct {
+	CurrentVersion int               `json:"current_version"`
+	CustomMetadata map[string]string `json:"custom_metadata"`
+	UpdatedTime    time.Time         `json:"updated_time"`
+	Versions       map[string]struct {
+		CreatedTime  time.Time `json:"created_time"`
+		DeletionTime string    `json:"deletion_time"`
+		Destroyed    bool      `json:"destroyed"`
+	} `json:"versions"`
+}
+
+//stage version of the stage, the latest version is current when the stages are not set
+func (m *vaultMetadata) stage(stage string) string {
+	if v, ok := m.CustomMetadata[stage]; ok {
+		return v
+	}
+	if stage == StageCurrent && len(m.CustomMetadata) == 0 {
+		return strconv.Itoa(m.CurrentVersion)
+	}
+	return ""
+}
+
+func (m *vaultMetadata) stages() map[string]string {
+	stages := map[string]string{}
+	for _, stage := range []string{StageCurrent, StagePending, StagePrevious} {
+		if v := m.stage(stage); v != "" {
+			stages[stage] = v
+		}
+	}
+	return stages
+}
+
+func (v *vaultStore) path(kind, id string) string {
+	if v.prefix == "" {
+		return fmt.Sprintf("/v1/%s/%s/%s", v.mount, kind, id)
+	}
+	return fmt.Sprintf("/v1/%s/%s/%s/%s", v.mount, kind, v.prefix, id)
+}
+
+//do sends the request to vault and decodes the response data in out, status 404 is reported as ErrSecretNotFound
+func (v *vaultClient) do(ctx context.Context, method, path string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, v.addr+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Vault-Token", v.token)
+	if v.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := v.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "vault request failed")
+	}
+	defer res.Body.Close()
+
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	r := &vaultResponse{}
+	if len(b) > 0 {
+		_ = json.Unmarshal(b, r)
+	}
+	if res.StatusCode == http.StatusNotFound {
+		return errors.Wrapf(ErrSecretNotFound, "vault %s", path)
+	}
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("vault %s %s: status %d %s", method, path, res.StatusCode, strings.Join(r.Errors, ", "))
+	}
+	if out != nil && len(r.Data) > 0 {
+		return errors.Wrapf(json.Unmarshal(r.Data, out), "vault %s %s: invalid response", method, path)
+	}
+	return nil
+}
+
+func (v *vaultStore) metadata(ctx context.Context, id string) (*vaultMetadata, error) {
+	m := &vaultMetadata{}
+	err := v.do(ctx, http.MethodGet, v.path("metadata", id), nil, m)
+	return m, err
+}
+
+//sameStages custom metadata is unchanged
+func sameStages(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if b[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+//setStages replaces the custom metadata with the stages when the latest version is the expected one and the stages
+//are still as read. vault has no check-and-set on the metadata, it is compared just before the write
+func (v *vaultStore) setStages(ctx context.Context, id string, read *vaultMetadata, version int, stages map[string]string) error {
+	m, err := v.metadata(ctx, id)
+	if err != nil {
+		return err
+	}
+	if m.CurrentVersion != version || !sameStages(m.CustomMetadata, read.CustomMetadata) {
+		return errors.Wrapf(ErrSecretConflict, "secret '%s' stages", id)
+	}
+	return v.do(ctx, http.MethodPost, v.path("metadata", id), map[string]interface{}{"custom_metadata": stages}, nil)
+}
+
+//write creates new kv version of the value, only when the latest version is still the one read. 0 creates the secret
+func (v *vaultStore) write(ctx context.Context, id, value string, cas int) (int, error) {
+	out := struct {
+		Version int `json:"version"`
+	}{}
+	body := map[string]interface{}{
+		"options": map[string]int{"cas": cas},
+		"data":    map[string]string{"value": value},
+	}
+	err := v.do(ctx, http.MethodPost, v.path("data", id), body, &out)
+	if err != nil && strings.Contains(err.Error(), "check-and-set") {
+		return 0, errors.Wrapf(ErrSecretConflict, "secret '%s' version %d", id, cas)
+	}
+	return out.Version, err
+}
+
+//retry runs fn again while the secret is modified concurrently
+func retry(fn func() error) error {
+	err := fn()
+	for i := 0; i < maxConflictRetries && errors.Is(err, ErrSecretConflict); i++ {
+		err = fn()
+	}
+	return err
+}
+
+func (v *vaultStore) Get(ctx context.Context, id, stage string) (string, error) {
+	m, err := v.metadata(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	version := m.stage(stage)
+	if version == "" {
+		return "", errors.Wrapf(ErrSecretNotFound, "secret '%s' %s", id, stage)
+	}
+
+	out := struct {
+		Data map[string]string `json:"data"`
+	}{}
+	if err := v.do(ctx, http.MethodGet, v.path("data", id)+"?version="+version, nil, &out); err != nil {
+		return "", err
+	}
+	value, ok := out.Data["value"]
+	if !ok {
+		return "", errors.Wrapf(ErrSecretNotFound, "secret '%s' has no value", id)
+	}
+	return value, nil
+}
+
+func (v *vaultStore) Put(ctx context.Context, id, value string) (bool, error) {
+	update := false
+	err := retry(func() error {
+		m, err := v.metadata(ctx, id)
+		update = err == nil
+		if errors.Is(err, ErrSecretNotFound) {
+			m = &vaultMetadata{}
+		} else if err != nil {
+			return err
+		}
+
+		version, err := v.write(ctx, id, value, m.CurrentVersion)
+		if err != nil {
+			return err
+		}
+		stages := map[string]string{}
+		if update {
+			stages = m.stages()
+		}
+		delete(stages, StagePrevious)
+		if current, ok := stages[StageCurrent]; ok {
+			stages[StagePrevious] = current
+		}
+		stages[StageCurrent] = strconv.Itoa(version)
+		return v.setStages(ctx, id, m, version, stages)
+	})
+	return update, err
+}
+
+func (v *vaultStore) Stage(ctx context.Context, id, value string) (string, error) {
+	staged := ""
+	err := retry(func() error {
+		m, err := v.metadata(ctx, id)
+		if err != nil {
+			return err
+		}
+		version, err := v.write(ctx, id, value, m.CurrentVersion)
+		if err != nil {
+			return err
+		}
+		staged = strconv.Itoa(version)
+		stages := m.stages()
+		stages[StagePending] = staged
+		return v.setStages(ctx, id, m, version, stages)
+	})
+	return staged, err
+}
+
+func (v *vaultStore) Promote(ctx context.Context, id string) (string, error) {
+	pending := ""
+	err := retry(func() error {
+		m, err := v.metadata(ctx, id)
+		if err != nil {
+			return err
+		}
+		stages := m.stages()
+		var ok bool
+		pending, ok = stages[StagePending]
+		if !ok {
+			return errors.Wrapf(ErrNoPendingVersion, "secret '%s'", id)
+		}
+		delete(stages, StagePrevious)
+		if current, ok := stages[StageCurrent]; ok {
+			stages[StagePrevious] = current
+		}
+		stages[StageCurrent] = pending
+		delete(stages, StagePending)
+		return v.setStages(ctx, id, m, m.CurrentVersion, stages)
+	})
+	return pending, err
+}
+
+func (v *vaultStore) Versions(ctx context.Context, id string) ([]SecretVersion, error) {
+	m, err := v.metadata(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	labels := map[string][]string{}
+	for stage, version := range m.stages() {
+		labels[version] = append(labels[version], stage)
+	}
+
+	versions := []SecretVersion{}
+	for version, kv := range m.Versions {
+		stages := labels[version]
+		if len(stages) == 0 || kv.Destroyed || kv.DeletionTime != "" {
+			continue
+		}
+		sort.Strings(stages)
+		versions = append(versions, SecretVersion{ID: version, Stages: stages, CreatedAt: kv.CreatedTime})
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i].CreatedAt.After(versions[j].CreatedAt) })
+	return versions, nil
+}
+
+//List lists the secrets in the folder of the prefix, the time each was updated is read from its metadata
+func (v *vaultStore) List(ctx context.Context, prefix string) ([]SecretInfo, error) {
+	folder := prefix[:strings.LastIndex(prefix, "/")+1]
+	out := struct {
+		Keys []string `json:"keys"`
+	}{}
+	err := v.do(ctx, "LIST", v.path("metadata", folder), nil, &out)
+	if errors.Is(err, ErrSecretNotFound) {
+		return []SecretInfo{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := []SecretInfo{}
+	for _, key := range out.Keys {
+		id := folder + key
+		if strings.HasSuffix(key, "/") || !strings.HasPrefix(id, prefix) {
+			continue
+		}
+		m, err := v.metadata(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, SecretInfo{ID: id, UpdatedAt: m.UpdatedTime})
+	}
+	sort.Slice(secrets, func(i, j int) bool { return secrets[i].ID < secrets[j].ID })
+	return secrets, nil
+}
+
+//Delete removes the metadata and all the versions of the secret
+func (v *vaultStore) Delete(ctx context.Context, id string) error {
+	if _, err := v.metadata(ctx, id); err != nil {
+		return err
+	}
+	return v.do(ctx, http.MethodDelete, v.path("metadata", id), nil, nil)
+}
+
+//Check looks up the token, fails when vault is unreachable or the token is not valid
+func (v *vaultStore) Check(ctx context.Context) error {
+	return v.do(ctx, http.MethodGet, "/v1/auth/token/lookup-self", nil, nil)
+}
@@ -0,0 +1,351 @@
+package service
+
+import (
+	"context"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/fleet"
+	"gitlab.com/netbook-devs/spawner-service/pkg/health"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/registry"
+	"gitlab.com/netbook-devs/spawner-service/pkg/tracing"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+//tracedService records a span for every call of the service
+type tracedService struct {
+	next SpawnerService
+}
+
+//watchStream carries the context of the service span to the stream handler
+type watchStream struct {
+	proto.SpawnerService_WatchClusterStatusServer
+	ctx context.Context
+}
+
+func (s *watchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (t *tracedService) CreateCluster(ctx context.Context, req *proto.ClusterRequest) (*proto.ClusterResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.CreateCluster", tracing.RequestAttributes(req)...)
+	res, err := t.next.CreateCluster(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) GetCluster(ctx context.Context, req *proto.GetClusterRequest) (*proto.ClusterSpec, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.GetCluster", tracing.RequestAttributes(req)...)
+	res, err := t.next.GetCluster(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) GetClusters(ctx context.Context, req *proto.GetClustersRequest) (*proto.GetClustersResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.GetClusters", tracing.RequestAttributes(req)...)
+	res, err := t.next.GetClusters(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) AddToken(ctx context.Context, req *proto.AddTokenRequest) (*proto.AddTokenResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.AddToken", tracing.RequestAttributes(req)...)
+	res, err := t.next.AddToken(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) GetToken(ctx context.Context, req *proto.GetTokenRequest) (*proto.GetTokenResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.GetToken", tracing.RequestAttributes(req)...)
+	res, err := t.next.GetToken(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) ClusterStatus(ctx context.Context, req *proto.ClusterStatusRequest) (*proto.ClusterStatusResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.ClusterStatus", tracing.RequestAttributes(req)...)
+	res, err := t.next.ClusterStatus(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) AddNode(ctx context.Context, req *proto.NodeSpawnRequest) (*proto.NodeSpawnResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.AddNode", tracing.RequestAttributes(req)...)
+	res, err := t.next.AddNode(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) DeleteCluster(ctx context.Context, req *proto.ClusterDeleteRequest) (*proto.ClusterDeleteResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.DeleteCluster", tracing.RequestAttributes(req)...)
+	res, err := t.next.DeleteCluster(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) DeleteNode(ctx context.Context, req *proto.NodeDeleteRequest) (*proto.NodeDeleteResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.DeleteNode", tracing.RequestAttributes(req)...)
+	res, err := t.next.DeleteNode(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) CreateVolume(ctx context.Context, req *proto.CreateVolumeRequest) (*proto.CreateVolumeResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.CreateVolume", tracing.RequestAttributes(req)...)
+	res, err := t.next.CreateVolume(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) DeleteVolume(ctx context.Context, req *proto.DeleteVolumeRequest) (*proto.DeleteVolumeResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.DeleteVolume", tracing.RequestAttributes(req)...)
+	res, err := t.next.DeleteVolume(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) CreateSnapshot(ctx context.Context, req *proto.CreateSnapshotRequest) (*proto.CreateSnapshotResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.CreateSnapshot", tracing.RequestAttributes(req)...)
+	res, err := t.next.CreateSnapshot(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) DeleteSnapshot(ctx context.Context, req *proto.DeleteSnapshotRequest) (*proto.DeleteSnapshotResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.DeleteSnapshot", tracing.RequestAttributes(req)...)
+	res, err := t.next.DeleteSnapshot(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) CreateSnapshotAndDelete(ctx context.Context, req *proto.CreateSnapshotAndDeleteRequest) (*proto.CreateSnapshotAndDeleteResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.CreateSnapshotAndDelete", tracing.RequestAttributes(req)...)
+	res, err := t.next.CreateSnapshotAndDelete(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) GetWorkspacesCost(ctx context.Context, req *proto.GetWorkspacesCostRequest) (*proto.GetWorkspacesCostResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.GetWorkspacesCost", tracing.RequestAttributes(req)...)
+	res, err := t.next.GetWorkspacesCost(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) GetApplicationsCost(ctx context.Context, req *proto.GetApplicationsCostRequest) (*proto.GetApplicationsCostResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.GetApplicationsCost", tracing.RequestAttributes(req)...)
+	res, err := t.next.GetApplicationsCost(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) GetKubeConfig(ctx context.Context, req *proto.GetKubeConfigRequest) (*proto.GetKubeConfigResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.GetKubeConfig", tracing.RequestAttributes(req)...)
+	res, err := t.next.GetKubeConfig(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) TagNodeInstance(ctx context.Context, req *proto.TagNodeInstanceRequest) (*proto.TagNodeInstanceResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.TagNodeInstance", tracing.RequestAttributes(req)...)
+	res, err := t.next.TagNodeInstance(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) RegisterWithRancher(ctx context.Context, req *proto.RancherRegistrationRequest) (*proto.RancherRegistrationResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.RegisterWithRancher", tracing.RequestAttributes(req)...)
+	res, err := t.next.RegisterWithRancher(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) WriteCredential(ctx context.Context, req *proto.WriteCredentialRequest) (*proto.WriteCredentialResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.WriteCredential", tracing.RequestAttributes(req)...)
+	res, err := t.next.WriteCredential(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) ReadCredential(ctx context.Context, req *proto.ReadCredentialRequest) (*proto.ReadCredentialResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.ReadCredential", tracing.RequestAttributes(req)...)
+	res, err := t.next.ReadCredential(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) RevealCredential(ctx context.Context, req *proto.RevealCredentialRequest) (*proto.ReadCredentialResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.RevealCredential", tracing.RequestAttributes(req)...)
+	res, err := t.next.RevealCredential(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) ListCredentials(ctx context.Context, req *proto.ListCredentialsRequest) (*proto.ListCredentialsResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.ListCredentials", tracing.RequestAttributes(req)...)
+	res, err := t.next.ListCredentials(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) DeleteCredential(ctx context.Context, req *proto.DeleteCredentialRequest) (*proto.DeleteCredentialResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.DeleteCredential", tracing.RequestAttributes(req)...)
+	res, err := t.next.DeleteCredential(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) RotateCredential(ctx context.Context, req *proto.RotateCredentialRequest) (*proto.RotateCredentialResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.RotateCredential", tracing.RequestAttributes(req)...)
+	res, err := t.next.RotateCredential(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) ListCredentialVersions(ctx context.Context, req *proto.ListCredentialVersionsRequest) (*proto.ListCredentialVersionsResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.ListCredentialVersions", tracing.RequestAttributes(req)...)
+	res, err := t.next.ListCredentialVersions(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) VerifyCredential(ctx context.Context, req *proto.VerifyCredentialRequest) (*proto.VerifyCredentialResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.VerifyCredential", tracing.RequestAttributes(req)...)
+	res, err := t.next.VerifyCredential(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) AddRoute53Record(ctx context.Context, req *proto.AddRoute53RecordRequest) (*proto.AddRoute53RecordResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.AddRoute53Record", tracing.RequestAttributes(req)...)
+	res, err := t.next.AddRoute53Record(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) GetCostByTime(ctx context.Context, req *proto.GetCostByTimeRequest) (*proto.GetCostByTimeResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.GetCostByTime", tracing.RequestAttributes(req)...)
+	res, err := t.next.GetCostByTime(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) GetContainerRegistryAuth(ctx context.Context, req *proto.GetContainerRegistryAuthRequest) (*proto.GetContainerRegistryAuthResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.GetContainerRegistryAuth", tracing.RequestAttributes(req)...)
+	res, err := t.next.GetContainerRegistryAuth(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) CreateContainerRegistryRepo(ctx context.Context, req *proto.CreateContainerRegistryRepoRequest) (*proto.CreateContainerRegistryRepoResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.CreateContainerRegistryRepo", tracing.RequestAttributes(req)...)
+	res, err := t.next.CreateContainerRegistryRepo(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) RegisterClusterOIDC(ctx context.Context, req *proto.RegisterClusterOIDCRequest) (*proto.RegisterClusterOIDCResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.RegisterClusterOIDC", tracing.RequestAttributes(req)...)
+	res, err := t.next.RegisterClusterOIDC(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) CreateRoute53Records(ctx context.Context, req *proto.CreateRoute53RecordsRequest) (*proto.CreateRoute53RecordsResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.CreateRoute53Records", tracing.RequestAttributes(req)...)
+	res, err := t.next.CreateRoute53Records(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) GetRoute53TXTRecords(ctx context.Context, req *proto.GetRoute53TXTRecordsRequest) (*proto.GetRoute53TXTRecordsResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.GetRoute53TXTRecords", tracing.RequestAttributes(req)...)
+	res, err := t.next.GetRoute53TXTRecords(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) DeleteRoute53Records(ctx context.Context, req *proto.DeleteRoute53RecordsRequest) (*proto.DeleteRoute53RecordsResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.DeleteRoute53Records", tracing.RequestAttributes(req)...)
+	res, err := t.next.DeleteRoute53Records(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) CopySnapshot(ctx context.Context, req *proto.CopySnapshotRequest) (*proto.CopySnapshotResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.CopySnapshot", tracing.RequestAttributes(req)...)
+	res, err := t.next.CopySnapshot(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) PresignS3Url(ctx context.Context, req *proto.PresignS3UrlRequest) (*proto.PresignS3UrlResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.PresignS3Url", tracing.RequestAttributes(req)...)
+	res, err := t.next.PresignS3Url(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) GetOperation(ctx context.Context, req *proto.GetOperationRequest) (*proto.Operation, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.GetOperation", tracing.RequestAttributes(req)...)
+	res, err := t.next.GetOperation(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) ListOperations(ctx context.Context, req *proto.ListOperationsRequest) (*proto.ListOperationsResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.ListOperations", tracing.RequestAttributes(req)...)
+	res, err := t.next.ListOperations(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) CancelOperation(ctx context.Context, req *proto.CancelOperationRequest) (*proto.Operation, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.CancelOperation", tracing.RequestAttributes(req)...)
+	res, err := t.next.CancelOperation(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) ListResources(ctx context.Context, req *proto.ListResourcesRequest) (*proto.ListResourcesResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.ListResources", tracing.RequestAttributes(req)...)
+	res, err := t.next.ListResources(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) GetResource(ctx context.Context, req *proto.GetResourceRequest) (*proto.Resource, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.GetResource", tracing.RequestAttributes(req)...)
+	res, err := t.next.GetResource(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) ListProviders(ctx context.Context, req *proto.ListProvidersRequest) (*proto.ListProvidersResponse, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.ListProviders", tracing.RequestAttributes(req)...)
+	res, err := t.next.ListProviders(ctx, req)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (t *tracedService) WatchClusterStatus(req *proto.WatchClusterStatusRequest, stream proto.SpawnerService_WatchClusterStatusServer) error {
+	ctx, span := tracing.Start(stream.Context(), "spawnerService.WatchClusterStatus", tracing.RequestAttributes(req)...)
+	err := t.next.WatchClusterStatus(req, &watchStream{SpawnerService_WatchClusterStatusServer: stream, ctx: ctx})
+	tracing.End(span, err)
+	return err
+}
+
+func (t *tracedService) RegisterHealthChecks(c *health.Checker) {
+	t.next.RegisterHealthChecks(c)
+}
+
+func (t *tracedService) FleetTargets(ctx context.Context) ([]fleet.Target, error) {
+	return t.next.FleetTargets(ctx)
+}
+
+func (t *tracedService) ListFleet(ctx context.Context, target fleet.Target) (*registry.Fleet, error) {
+	ctx, span := tracing.Start(ctx, "spawnerService.ListFleet", attribute.String("spawner.provider", target.Provider),
+		attribute.String("spawner.account", target.Account), attribute.String("spawner.region", target.Region))
+	f, err := t.next.ListFleet(ctx, target)
+	tracing.End(span, err)
+	return f, err
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/registry"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+//minWatchInterval lower bound of the watch poll interval, keeps provider api calls in check
+const minWatchInterval = 5 * time.Second
+
+//nodeGroupStatus aggregates the node specs by node group.
+//
+//aws reports one spec per node while azure and gcp reports one spec per node pool with the count.
+func nodeGroupStatus(specs []*proto.NodeSpec) []*proto.NodeGroupStatus {
+	groups := map[string]*proto.NodeGroupStatus{}
+	issues := map[string]map[string]bool{}
+
+	for _, spec := range specs {
+		g, ok := groups[spec.Name]
+		if !ok {
+			g = &proto.NodeGroupStatus{Name: spec.Name, Status: spec.State}
+			groups[spec.Name] = g
+			issues[spec.Name] = map[string]bool{}
+		}
+
+		if spec.Count > 0 {
+			g.Count += spec.Count
+		} else {
+			g.Count++
+		}
+
+		//group is active only if all of its nodes are active
+		if isActive(g.Status) && !isActive(spec.State) {
+			g.Status = spec.State
+		}
+
+		for _, issue := range spec.GetHealth().GetIssue() {
+			k := issue.Code + "/" + issue.Description
+			if issues[spec.Name][k] {
+				continue
+			}
+			issues[spec.Name][k] = true
+			g.Issues = append(g.Issues, issue)
+		}
+	}
+
+	res := make([]*proto.NodeGroupStatus, 0, len(groups))
+	for _, g := range groups {
+		res = append(res, g)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+	return res
+}
+
+//clusterEvent fetch the current cluster state, seen tells if the cluster was reported earlier in the watch
+func (s *spawnerService) clusterEvent(ctx context.Context, provider registry.Controller, req *proto.WatchClusterStatusRequest, seen bool) (*proto.ClusterStatusEvent, error) {
+	event := &proto.ClusterStatusEvent{
+		ClusterName: req.ClusterName,
+	}
+
+	stat, err := provider.ClusterStatus(ctx, &proto.ClusterStatusRequest{
+		Provider:    req.Provider,
+		Region:      req.Region,
+		AccountName: req.AccountName,
+		ClusterName: req.ClusterName,
+	})
+	if err != nil {
+		if !seen {
+			return nil, err
+		}
+		event.Error = err.Error()
+		if isNotFound(err) {
+			event.Deleted = true
+			event.Terminal = true
+			return event, nil
+		}
+		//status not available for now, keep watching
+		s.logger.Debug(ctx, "failed to get cluster status", "cluster", req.ClusterName, "error", err)
+		return event, nil
+	}
+	event.Status = stat.Status
+	event.Error = stat.Error
+
+	spec, err := provider.GetCluster(ctx, &proto.GetClusterRequest{
+		Provider:    req.Provider,
+		Region:      req.Region,
+		AccountName: req.AccountName,
+		ClusterName: req.ClusterName,
+	})
+	if err != nil {
+		//node groups are not reachable till cluster is ready, report the cluster status alone
+		s.logger.Debug(ctx, "failed to get node groups", "cluster", req.ClusterName, "error", err)
+	} else {
+		event.NodeGroups = nodeGroupStatus(spec.NodeSpec)
+	}
+
+	if isClusterFailed(stat.Status) {
+		event.Terminal = true
+		return event, nil
+	}
+
+	if !req.Follow && isActive(stat.Status) {
+		event.Terminal = true
+		for _, g := range event.NodeGroups {
+			if !isActive(g.Status) {
+				event.Terminal = false
+				break
+			}
+		}
+	}
+	return event, nil
+}
+
+//WatchClusterStatus polls the provider and sends cluster state whenever it changes
+func (s *spawnerService) WatchClusterStatus(req *proto.WatchClusterStatusRequest, stream proto.SpawnerService_WatchClusterStatusServer) error {
+	ctx := stream.Context()
+	provider, err := s.controller(req.Provider, registry.CapCluster)
+	if err != nil {
+		return err
+	}
+
+	interval := pollInterval
+	if req.Interval > 0 {
+		interval = time.Duration(req.Interval) * time.Second
+		if interval < minWatchInterval {
+			interval = minWatchInterval
+		}
+	}
+
+	s.logger.Info(ctx, "watching cluster status", "cluster", req.ClusterName, "provider", req.Provider, "interval", interval)
+
+	var last *proto.ClusterStatusEvent
+	for {
+		event, err := s.clusterEvent(ctx, provider, req, last != nil)
+		if err != nil {
+			return err
+		}
+
+		if last == nil || !gproto.Equal(last, event) {
+			last = gproto.Clone(event).(*proto.ClusterStatusEvent)
+			event.Timestamp = time.Now().Unix()
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+
+		if event.Terminal {
+			return nil
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
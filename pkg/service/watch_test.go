@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/netbookai/log"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/fake"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+func Test_nodeGroupStatus(t *testing.T) {
+	issue := &proto.Issue{Code: "AsgInstanceLaunchFailures", Description: "capacity"}
+	specs := []*proto.NodeSpec{
+		//aws, spec per node
+		{Name: "np1", State: "active"},
+		{Name: "np1", State: "inactive", Health: &proto.Health{Issue: []*proto.Issue{issue}}},
+		{Name: "np1", State: "active", Health: &proto.Health{Issue: []*proto.Issue{issue}}},
+		//azure and gcp, spec per pool
+		{Name: "np0", State: "active", Count: 3},
+	}
+
+	got := nodeGroupStatus(specs)
+	assert.Equal(t, 2, len(got))
+
+	assert.Equal(t, "np0", got[0].Name)
+	assert.Equal(t, "active", got[0].Status)
+	assert.Equal(t, int64(3), got[0].Count)
+
+	assert.Equal(t, "np1", got[1].Name)
+	assert.Equal(t, "inactive", got[1].Status, "group with inactive node must not be active")
+	assert.Equal(t, int64(3), got[1].Count)
+	assert.Equal(t, 1, len(got[1].Issues), "issues must be reported once")
+}
+
+func Test_clusterEvent(t *testing.T) {
+	s := &spawnerService{logger: log.GetLogger()}
+	ctx := context.Background()
+	req := &proto.WatchClusterStatusRequest{Provider: constants.FakeLabel, AccountName: "team-a", ClusterName: "c1"}
+	f := fake.NewControllerWithOptions(log.GetLogger(), fake.Options{})
+
+	_, err := s.clusterEvent(ctx, f, req, false)
+	assert.NotNil(t, err, "cluster must exist when the watch starts")
+
+	f.InjectFailure("ClusterStatus", errors.New("connection reset"), 1)
+	event, err := s.clusterEvent(ctx, f, req, true)
+	assert.Nil(t, err)
+	assert.False(t, event.Terminal, "provider error must not end the watch")
+	assert.False(t, event.Deleted)
+	assert.NotEmpty(t, event.Error)
+
+	event, err = s.clusterEvent(ctx, f, req, true)
+	assert.Nil(t, err)
+	assert.True(t, event.Deleted, "cluster not found is deleted")
+	assert.True(t, event.Terminal)
+
+	for _, status := range []string{"FAILED", "error", "degraded", constants.Failed} {
+		event, err = s.clusterEvent(ctx, failedCluster{status: status}, req, false)
+		assert.Nil(t, err)
+		assert.True(t, event.Terminal, "cluster in '%s' ends the watch", status)
+	}
+}
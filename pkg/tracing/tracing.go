@@ -0,0 +1,155 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	gproto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	instrumentationName = "gitlab.com/netbook-devs/spawner-service"
+
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+	ExporterNone   = "none"
+)
+
+//Config tracer provider settings
+type Config struct {
+	//ServiceName reported as the service.name resource attribute
+	ServiceName string
+	//Exporter one of 'otlp', 'stdout' or 'none', spans are not recorded when empty or 'none'
+	Exporter string
+	//Endpoint host:port of the otlp grpc collector
+	Endpoint string
+	//Insecure disables tls to the collector
+	Insecure bool
+	//SampleRatio fraction of the root spans sampled, spans continuing a sampled remote trace are always sampled
+	SampleRatio float64
+}
+
+func exporter(ctx context.Context, c Config) (sdktrace.SpanExporter, error) {
+	switch c.Exporter {
+	case ExporterOTLP:
+		opts := []otlptracegrpc.Option{}
+		if c.Endpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(c.Endpoint))
+		}
+		if c.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		return otlptracegrpc.New(ctx, opts...)
+	case ExporterStdout:
+		return stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	}
+	return nil, fmt.Errorf("unknown trace exporter '%s', must be one of ['%s', '%s', '%s']", c.Exporter, ExporterOTLP, ExporterStdout, ExporterNone)
+}
+
+//Init sets up the global tracer provider and the w3c trace context propagator.
+//
+//shutdown flushes the pending spans, it must be called before exit
+func Init(ctx context.Context, c Config) (shutdown func(context.Context) error, err error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if c.Exporter == "" || c.Exporter == ExporterNone {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exp, err := exporter(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	sampler := sdktrace.AlwaysSample()
+	if c.SampleRatio > 0 && c.SampleRatio < 1 {
+		sampler = sdktrace.TraceIDRatioBased(c.SampleRatio)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exp),
+		sdktrace.WithSampler(sdktrace.ParentBased(sampler)),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(c.ServiceName))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+//Start starts span as child of the span in ctx
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+//Record records the outbound call which took place between start and end as a client span
+func Record(ctx context.Context, name string, start, end time.Time, err error, attrs ...attribute.KeyValue) {
+	_, span := otel.Tracer(instrumentationName).Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithTimestamp(start),
+		trace.WithAttributes(attrs...))
+	if err != nil {
+		span.RecordError(err, trace.WithTimestamp(end))
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End(trace.WithTimestamp(end))
+}
+
+//requestFields request fields recorded as span attributes
+var requestFields = map[protoreflect.Name]attribute.Key{
+	"provider":    "spawner.provider",
+	"region":      "spawner.region",
+	"accountName": "spawner.account",
+	"account":     "spawner.account",
+	"clusterName": "spawner.cluster",
+}
+
+//RequestAttributes provider, region, account and cluster of the request
+func RequestAttributes(req gproto.Message) []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+	m := req.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		key, ok := requestFields[fd.Name()]
+		if !ok || fd.Kind() != protoreflect.StringKind {
+			continue
+		}
+		if v := m.Get(fd).String(); v != "" {
+			attrs = append(attrs, key.String(v))
+		}
+	}
+	return attrs
+}
+
+//End records the error, if any, and ends the span
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+//Interceptor creates the server span of the unary calls, continuing the trace propagated by the caller
+func Interceptor() grpc.UnaryServerInterceptor {
+	return otelgrpc.UnaryServerInterceptor()
+}
+
+//StreamInterceptor creates the server span of the streaming calls
+func StreamInterceptor() grpc.StreamServerInterceptor {
+	return otelgrpc.StreamServerInterceptor()
+}
@@ -0,0 +1,74 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func recorder(t *testing.T) *tracetest.SpanRecorder {
+	_, err := Init(context.Background(), Config{})
+	assert.Nil(t, err)
+
+	sr := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)))
+	return sr
+}
+
+func Test_Interceptor(t *testing.T) {
+	sr := recorder(t)
+
+	traceID := "4bf92f3577b34da6a3ce929d0e0e4736"
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01"))
+
+	req := &proto.ClusterRequest{Provider: "aws", Region: "us-west-2", AccountName: "team-a", ClusterName: "c1"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		ctx, span := Start(ctx, "spawnerService.CreateCluster", RequestAttributes(req.(*proto.ClusterRequest))...)
+		defer span.End()
+
+		start := time.Now()
+		Record(ctx, "aws.eks/CreateCluster", start, start.Add(time.Second), errors.New("throttled"))
+		return nil, nil
+	}
+	_, err := Interceptor()(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/spawner.SpawnerService/CreateCluster"}, handler)
+	assert.Nil(t, err)
+
+	spans := sr.Ended()
+	assert.Equal(t, 3, len(spans))
+	call, svc, server := spans[0], spans[1], spans[2]
+
+	for _, s := range spans {
+		assert.Equal(t, traceID, s.SpanContext().TraceID().String(), "spans must continue the caller trace")
+	}
+	assert.Equal(t, trace.SpanKindServer, server.SpanKind())
+	assert.Equal(t, server.SpanContext().SpanID(), svc.Parent().SpanID())
+	assert.Equal(t, svc.SpanContext().SpanID(), call.Parent().SpanID())
+
+	assert.Contains(t, svc.Attributes(), attribute.String("spawner.account", "team-a"))
+	assert.Contains(t, svc.Attributes(), attribute.String("spawner.cluster", "c1"))
+
+	assert.Equal(t, trace.SpanKindClient, call.SpanKind())
+	assert.Equal(t, time.Second, call.EndTime().Sub(call.StartTime()))
+	assert.Equal(t, codes.Error, call.Status().Code)
+}
+
+func Test_Init(t *testing.T) {
+	_, err := Init(context.Background(), Config{Exporter: "jaeger"})
+	assert.NotNil(t, err)
+
+	shutdown, err := Init(context.Background(), Config{Exporter: ExporterNone})
+	assert.Nil(t, err)
+	assert.Nil(t, shutdown(context.Background()))
+}
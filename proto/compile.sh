@@ -1,10 +1,10 @@
 #!/usr/bin/env sh
 
-# find all proto files in proto directory
-ALL_PROTO_FILES=$(find ./proto -type f  -name '*.proto')
+# find all proto files in proto directory, third party protos are only imported
+ALL_PROTO_FILES=$(find ./proto -path ./proto/third_party -prune -o -type f  -name '*.proto' -print)
 
 for proto in $ALL_PROTO_FILES; do
-    protoc $proto --go_out=. --go_opt=paths=source_relative
-    protoc $proto --go-grpc_out=. --go-grpc_opt=paths=source_relative
+    protoc -I . -I ./proto/third_party $proto --go_out=. --go_opt=paths=source_relative
+    protoc -I . -I ./proto/third_party $proto --go-grpc_out=. --go-grpc_opt=paths=source_relative
+    protoc -I . -I ./proto/third_party $proto --grpc-gateway_out=. --grpc-gateway_opt=paths=source_relative
 done 
-
@@ -7,6 +7,8 @@
 package spawner
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	status "google.golang.org/genproto/googleapis/rpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -127,6 +129,64 @@ func (CapacityType) EnumDescriptor() ([]byte, []int) {
 	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{1}
 }
 
+type OperationState int32
+
+const (
+	OperationState_OPERATION_UNKNOWN   OperationState = 0
+	OperationState_OPERATION_PENDING   OperationState = 1
+	OperationState_OPERATION_RUNNING   OperationState = 2
+	OperationState_OPERATION_SUCCEEDED OperationState = 3
+	OperationState_OPERATION_FAILED    OperationState = 4
+	OperationState_OPERATION_CANCELLED OperationState = 5
+)
+
+// Enum value maps for OperationState.
+var (
+	OperationState_name = map[int32]string{
+		0: "OPERATION_UNKNOWN",
+		1: "OPERATION_PENDING",
+		2: "OPERATION_RUNNING",
+		3: "OPERATION_SUCCEEDED",
+		4: "OPERATION_FAILED",
+		5: "OPERATION_CANCELLED",
+	}
+	OperationState_value = map[string]int32{
+		"OPERATION_UNKNOWN":   0,
+		"OPERATION_PENDING":   1,
+		"OPERATION_RUNNING":   2,
+		"OPERATION_SUCCEEDED": 3,
+		"OPERATION_FAILED":    4,
+		"OPERATION_CANCELLED": 5,
+	}
+)
+
+func (x OperationState) Enum() *OperationState {
+	p := new(OperationState)
+	*p = x
+	return p
+}
+
+func (x OperationState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OperationState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_netbookai_spawner_spawner_proto_enumTypes[2].Descriptor()
+}
+
+func (OperationState) Type() protoreflect.EnumType {
+	return &file_proto_netbookai_spawner_spawner_proto_enumTypes[2]
+}
+
+func (x OperationState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OperationState.Descriptor instead.
+func (OperationState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{2}
+}
+
 type Empty struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -555,6 +615,8 @@ type ClusterRequest struct {
 	ClusterName string            `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
 	Node        *NodeSpec         `protobuf:"bytes,5,opt,name=node,proto3" json:"node,omitempty"`
 	Labels      map[string]string `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// repeated calls with the same key return the result of the first call
+	IdempotencyKey string `protobuf:"bytes,7,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
 }
 
 func (x *ClusterRequest) Reset() {
@@ -631,6 +693,13 @@ func (x *ClusterRequest) GetLabels() map[string]string {
 	return nil
 }
 
+func (x *ClusterRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type GetClusterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -880,9 +949,10 @@ type ClusterResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClusterName   string `protobuf:"bytes,1,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
-	NodeGroupName string `protobuf:"bytes,2,opt,name=nodeGroupName,proto3" json:"nodeGroupName,omitempty"`
-	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	ClusterName   string     `protobuf:"bytes,1,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	NodeGroupName string     `protobuf:"bytes,2,opt,name=nodeGroupName,proto3" json:"nodeGroupName,omitempty"`
+	Error         string     `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Operation     *Operation `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"`
 }
 
 func (x *ClusterResponse) Reset() {
@@ -938,6 +1008,13 @@ func (x *ClusterResponse) GetError() string {
 	return ""
 }
 
+func (x *ClusterResponse) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
 type ClusterStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1064,6 +1141,265 @@ func (x *ClusterStatusResponse) GetError() string {
 	return ""
 }
 
+type WatchClusterStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	// poll interval in seconds
+	Interval int32 `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	// keep watching once the cluster is active, stream ends when cluster is
+	// deleted or failed
+	Follow bool `protobuf:"varint,6,opt,name=follow,proto3" json:"follow,omitempty"`
+}
+
+func (x *WatchClusterStatusRequest) Reset() {
+	*x = WatchClusterStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchClusterStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchClusterStatusRequest) ProtoMessage() {}
+
+func (x *WatchClusterStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchClusterStatusRequest.ProtoReflect.Descriptor instead.
+func (*WatchClusterStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WatchClusterStatusRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *WatchClusterStatusRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *WatchClusterStatusRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *WatchClusterStatusRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *WatchClusterStatusRequest) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *WatchClusterStatusRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+type NodeGroupStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Count  int64    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Issues []*Issue `protobuf:"bytes,4,rep,name=issues,proto3" json:"issues,omitempty"`
+}
+
+func (x *NodeGroupStatus) Reset() {
+	*x = NodeGroupStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeGroupStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeGroupStatus) ProtoMessage() {}
+
+func (x *NodeGroupStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeGroupStatus.ProtoReflect.Descriptor instead.
+func (*NodeGroupStatus) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *NodeGroupStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NodeGroupStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *NodeGroupStatus) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *NodeGroupStatus) GetIssues() []*Issue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type ClusterStatusEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClusterName string             `protobuf:"bytes,1,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	Status      string             `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	NodeGroups  []*NodeGroupStatus `protobuf:"bytes,3,rep,name=nodeGroups,proto3" json:"nodeGroups,omitempty"`
+	// cluster reached terminal state, no more events are sent
+	Terminal bool `protobuf:"varint,4,opt,name=terminal,proto3" json:"terminal,omitempty"`
+	// cluster is not reported by the provider anymore
+	Deleted bool   `protobuf:"varint,5,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Error   string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	// unix time of the event
+	Timestamp int64 `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *ClusterStatusEvent) Reset() {
+	*x = ClusterStatusEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClusterStatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterStatusEvent) ProtoMessage() {}
+
+func (x *ClusterStatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterStatusEvent.ProtoReflect.Descriptor instead.
+func (*ClusterStatusEvent) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ClusterStatusEvent) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *ClusterStatusEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ClusterStatusEvent) GetNodeGroups() []*NodeGroupStatus {
+	if x != nil {
+		return x.NodeGroups
+	}
+	return nil
+}
+
+func (x *ClusterStatusEvent) GetTerminal() bool {
+	if x != nil {
+		return x.Terminal
+	}
+	return false
+}
+
+func (x *ClusterStatusEvent) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *ClusterStatusEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ClusterStatusEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
 type AddTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1077,7 +1413,7 @@ type AddTokenRequest struct {
 func (x *AddTokenRequest) Reset() {
 	*x = AddTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[14]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1090,7 +1426,7 @@ func (x *AddTokenRequest) String() string {
 func (*AddTokenRequest) ProtoMessage() {}
 
 func (x *AddTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[14]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1103,7 +1439,7 @@ func (x *AddTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddTokenRequest.ProtoReflect.Descriptor instead.
 func (*AddTokenRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{14}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *AddTokenRequest) GetProvider() string {
@@ -1139,7 +1475,7 @@ type AddTokenResponse struct {
 func (x *AddTokenResponse) Reset() {
 	*x = AddTokenResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[15]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1152,7 +1488,7 @@ func (x *AddTokenResponse) String() string {
 func (*AddTokenResponse) ProtoMessage() {}
 
 func (x *AddTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[15]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1165,7 +1501,7 @@ func (x *AddTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddTokenResponse.ProtoReflect.Descriptor instead.
 func (*AddTokenResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{15}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *AddTokenResponse) GetStatus() string {
@@ -1196,7 +1532,7 @@ type GetTokenRequest struct {
 func (x *GetTokenRequest) Reset() {
 	*x = GetTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[16]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1209,7 +1545,7 @@ func (x *GetTokenRequest) String() string {
 func (*GetTokenRequest) ProtoMessage() {}
 
 func (x *GetTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[16]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1222,7 +1558,7 @@ func (x *GetTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTokenRequest.ProtoReflect.Descriptor instead.
 func (*GetTokenRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{16}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetTokenRequest) GetProvider() string {
@@ -1268,7 +1604,7 @@ type GetTokenResponse struct {
 func (x *GetTokenResponse) Reset() {
 	*x = GetTokenResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[17]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1281,7 +1617,7 @@ func (x *GetTokenResponse) String() string {
 func (*GetTokenResponse) ProtoMessage() {}
 
 func (x *GetTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[17]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1294,7 +1630,7 @@ func (x *GetTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTokenResponse.ProtoReflect.Descriptor instead.
 func (*GetTokenResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{17}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetTokenResponse) GetToken() string {
@@ -1347,7 +1683,7 @@ type AddRoute53RecordRequest struct {
 func (x *AddRoute53RecordRequest) Reset() {
 	*x = AddRoute53RecordRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[18]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1360,7 +1696,7 @@ func (x *AddRoute53RecordRequest) String() string {
 func (*AddRoute53RecordRequest) ProtoMessage() {}
 
 func (x *AddRoute53RecordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[18]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1373,7 +1709,7 @@ func (x *AddRoute53RecordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddRoute53RecordRequest.ProtoReflect.Descriptor instead.
 func (*AddRoute53RecordRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{18}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *AddRoute53RecordRequest) GetProvider() string {
@@ -1423,7 +1759,7 @@ type AddRoute53RecordResponse struct {
 func (x *AddRoute53RecordResponse) Reset() {
 	*x = AddRoute53RecordResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[19]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1436,7 +1772,7 @@ func (x *AddRoute53RecordResponse) String() string {
 func (*AddRoute53RecordResponse) ProtoMessage() {}
 
 func (x *AddRoute53RecordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[19]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1449,7 +1785,7 @@ func (x *AddRoute53RecordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddRoute53RecordResponse.ProtoReflect.Descriptor instead.
 func (*AddRoute53RecordResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{19}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *AddRoute53RecordResponse) GetStatus() string {
@@ -1476,12 +1812,14 @@ type NodeSpawnRequest struct {
 	AccountName string    `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
 	ClusterName string    `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
 	NodeSpec    *NodeSpec `protobuf:"bytes,5,opt,name=nodeSpec,proto3" json:"nodeSpec,omitempty"`
+	// repeated calls with the same key return the result of the first call
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
 }
 
 func (x *NodeSpawnRequest) Reset() {
 	*x = NodeSpawnRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[20]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1494,7 +1832,7 @@ func (x *NodeSpawnRequest) String() string {
 func (*NodeSpawnRequest) ProtoMessage() {}
 
 func (x *NodeSpawnRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[20]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1507,7 +1845,7 @@ func (x *NodeSpawnRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeSpawnRequest.ProtoReflect.Descriptor instead.
 func (*NodeSpawnRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{20}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *NodeSpawnRequest) GetProvider() string {
@@ -1545,18 +1883,26 @@ func (x *NodeSpawnRequest) GetNodeSpec() *NodeSpec {
 	return nil
 }
 
+func (x *NodeSpawnRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type NodeSpawnResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Error     string     `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Operation *Operation `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
 }
 
 func (x *NodeSpawnResponse) Reset() {
 	*x = NodeSpawnResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[21]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1569,7 +1915,7 @@ func (x *NodeSpawnResponse) String() string {
 func (*NodeSpawnResponse) ProtoMessage() {}
 
 func (x *NodeSpawnResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[21]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1582,7 +1928,7 @@ func (x *NodeSpawnResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeSpawnResponse.ProtoReflect.Descriptor instead.
 func (*NodeSpawnResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{21}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *NodeSpawnResponse) GetError() string {
@@ -1592,6 +1938,13 @@ func (x *NodeSpawnResponse) GetError() string {
 	return ""
 }
 
+func (x *NodeSpawnResponse) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
 type ClusterDeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1607,7 +1960,7 @@ type ClusterDeleteRequest struct {
 func (x *ClusterDeleteRequest) Reset() {
 	*x = ClusterDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[22]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1620,7 +1973,7 @@ func (x *ClusterDeleteRequest) String() string {
 func (*ClusterDeleteRequest) ProtoMessage() {}
 
 func (x *ClusterDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[22]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1633,7 +1986,7 @@ func (x *ClusterDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterDeleteRequest.ProtoReflect.Descriptor instead.
 func (*ClusterDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{22}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ClusterDeleteRequest) GetProvider() string {
@@ -1676,13 +2029,14 @@ type ClusterDeleteResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Error     string     `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Operation *Operation `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
 }
 
 func (x *ClusterDeleteResponse) Reset() {
 	*x = ClusterDeleteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[23]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1695,7 +2049,7 @@ func (x *ClusterDeleteResponse) String() string {
 func (*ClusterDeleteResponse) ProtoMessage() {}
 
 func (x *ClusterDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[23]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1708,7 +2062,7 @@ func (x *ClusterDeleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterDeleteResponse.ProtoReflect.Descriptor instead.
 func (*ClusterDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{23}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ClusterDeleteResponse) GetError() string {
@@ -1718,6 +2072,13 @@ func (x *ClusterDeleteResponse) GetError() string {
 	return ""
 }
 
+func (x *ClusterDeleteResponse) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
 type NodeDeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1733,7 +2094,7 @@ type NodeDeleteRequest struct {
 func (x *NodeDeleteRequest) Reset() {
 	*x = NodeDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[24]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1746,7 +2107,7 @@ func (x *NodeDeleteRequest) String() string {
 func (*NodeDeleteRequest) ProtoMessage() {}
 
 func (x *NodeDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[24]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1759,7 +2120,7 @@ func (x *NodeDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeDeleteRequest.ProtoReflect.Descriptor instead.
 func (*NodeDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{24}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *NodeDeleteRequest) GetProvider() string {
@@ -1802,13 +2163,14 @@ type NodeDeleteResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Error     string     `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Operation *Operation `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
 }
 
 func (x *NodeDeleteResponse) Reset() {
 	*x = NodeDeleteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[25]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1821,7 +2183,7 @@ func (x *NodeDeleteResponse) String() string {
 func (*NodeDeleteResponse) ProtoMessage() {}
 
 func (x *NodeDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[25]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1834,7 +2196,7 @@ func (x *NodeDeleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeDeleteResponse.ProtoReflect.Descriptor instead.
 func (*NodeDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{25}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *NodeDeleteResponse) GetError() string {
@@ -1844,6 +2206,13 @@ func (x *NodeDeleteResponse) GetError() string {
 	return ""
 }
 
+func (x *NodeDeleteResponse) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
 type CreateVolumeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1859,12 +2228,14 @@ type CreateVolumeRequest struct {
 	Labels           map[string]string `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	SnapshotUri      string            `protobuf:"bytes,9,opt,name=snapshotUri,proto3" json:"snapshotUri,omitempty"`
 	DeleteSnapshot   bool              `protobuf:"varint,10,opt,name=deleteSnapshot,proto3" json:"deleteSnapshot,omitempty"`
+	// repeated calls with the same key return the result of the first call
+	IdempotencyKey string `protobuf:"bytes,11,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
 }
 
 func (x *CreateVolumeRequest) Reset() {
 	*x = CreateVolumeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[26]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1877,7 +2248,7 @@ func (x *CreateVolumeRequest) String() string {
 func (*CreateVolumeRequest) ProtoMessage() {}
 
 func (x *CreateVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[26]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1890,7 +2261,7 @@ func (x *CreateVolumeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateVolumeRequest.ProtoReflect.Descriptor instead.
 func (*CreateVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{26}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *CreateVolumeRequest) GetProvider() string {
@@ -1963,21 +2334,29 @@ func (x *CreateVolumeRequest) GetDeleteSnapshot() bool {
 	return false
 }
 
+func (x *CreateVolumeRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type CreateVolumeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// volume id is name
-	Volumeid    string `protobuf:"bytes,1,opt,name=volumeid,proto3" json:"volumeid,omitempty"`
-	Error       string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	ResourceUri string `protobuf:"bytes,3,opt,name=resource_uri,json=resourceUri,proto3" json:"resource_uri,omitempty"`
+	Volumeid    string     `protobuf:"bytes,1,opt,name=volumeid,proto3" json:"volumeid,omitempty"`
+	Error       string     `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	ResourceUri string     `protobuf:"bytes,3,opt,name=resource_uri,json=resourceUri,proto3" json:"resource_uri,omitempty"`
+	Operation   *Operation `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"`
 }
 
 func (x *CreateVolumeResponse) Reset() {
 	*x = CreateVolumeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[27]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1990,7 +2369,7 @@ func (x *CreateVolumeResponse) String() string {
 func (*CreateVolumeResponse) ProtoMessage() {}
 
 func (x *CreateVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[27]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2003,7 +2382,7 @@ func (x *CreateVolumeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateVolumeResponse.ProtoReflect.Descriptor instead.
 func (*CreateVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{27}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *CreateVolumeResponse) GetVolumeid() string {
@@ -2027,6 +2406,13 @@ func (x *CreateVolumeResponse) GetResourceUri() string {
 	return ""
 }
 
+func (x *CreateVolumeResponse) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
 type DeleteVolumeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2041,7 +2427,7 @@ type DeleteVolumeRequest struct {
 func (x *DeleteVolumeRequest) Reset() {
 	*x = DeleteVolumeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[28]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2054,7 +2440,7 @@ func (x *DeleteVolumeRequest) String() string {
 func (*DeleteVolumeRequest) ProtoMessage() {}
 
 func (x *DeleteVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[28]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2067,7 +2453,7 @@ func (x *DeleteVolumeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVolumeRequest.ProtoReflect.Descriptor instead.
 func (*DeleteVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{28}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *DeleteVolumeRequest) GetProvider() string {
@@ -2110,7 +2496,7 @@ type DeleteVolumeResponse struct {
 func (x *DeleteVolumeResponse) Reset() {
 	*x = DeleteVolumeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[29]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2123,7 +2509,7 @@ func (x *DeleteVolumeResponse) String() string {
 func (*DeleteVolumeResponse) ProtoMessage() {}
 
 func (x *DeleteVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[29]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2136,7 +2522,7 @@ func (x *DeleteVolumeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVolumeResponse.ProtoReflect.Descriptor instead.
 func (*DeleteVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{29}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *DeleteVolumeResponse) GetDeleted() bool {
@@ -2163,12 +2549,14 @@ type CreateSnapshotRequest struct {
 	AccountName string            `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
 	Volumeid    string            `protobuf:"bytes,4,opt,name=volumeid,proto3" json:"volumeid,omitempty"`
 	Labels      map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// repeated calls with the same key return the result of the first call
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
 }
 
 func (x *CreateSnapshotRequest) Reset() {
 	*x = CreateSnapshotRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[30]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2181,7 +2569,7 @@ func (x *CreateSnapshotRequest) String() string {
 func (*CreateSnapshotRequest) ProtoMessage() {}
 
 func (x *CreateSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[30]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2194,7 +2582,7 @@ func (x *CreateSnapshotRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSnapshotRequest.ProtoReflect.Descriptor instead.
 func (*CreateSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{30}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *CreateSnapshotRequest) GetProvider() string {
@@ -2232,6 +2620,13 @@ func (x *CreateSnapshotRequest) GetLabels() map[string]string {
 	return nil
 }
 
+func (x *CreateSnapshotRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type CreateSnapshotResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2246,7 +2641,7 @@ type CreateSnapshotResponse struct {
 func (x *CreateSnapshotResponse) Reset() {
 	*x = CreateSnapshotResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[31]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2259,7 +2654,7 @@ func (x *CreateSnapshotResponse) String() string {
 func (*CreateSnapshotResponse) ProtoMessage() {}
 
 func (x *CreateSnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[31]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2272,7 +2667,7 @@ func (x *CreateSnapshotResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSnapshotResponse.ProtoReflect.Descriptor instead.
 func (*CreateSnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{31}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *CreateSnapshotResponse) GetSnapshotid() string {
@@ -2304,7 +2699,7 @@ type CreateSnapshotAndDeleteRequest struct {
 func (x *CreateSnapshotAndDeleteRequest) Reset() {
 	*x = CreateSnapshotAndDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[32]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2317,7 +2712,7 @@ func (x *CreateSnapshotAndDeleteRequest) String() string {
 func (*CreateSnapshotAndDeleteRequest) ProtoMessage() {}
 
 func (x *CreateSnapshotAndDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[32]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2330,7 +2725,7 @@ func (x *CreateSnapshotAndDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSnapshotAndDeleteRequest.ProtoReflect.Descriptor instead.
 func (*CreateSnapshotAndDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{32}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *CreateSnapshotAndDeleteRequest) GetProvider() string {
@@ -2377,13 +2772,14 @@ type CreateSnapshotAndDeleteResponse struct {
 	Deleted    bool   `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	// must retain tag for backward compatibilty, left in commet for reference
 	// string error = 3;
-	SnapshotUri string `protobuf:"bytes,4,opt,name=snapshotUri,proto3" json:"snapshotUri,omitempty"`
+	SnapshotUri string     `protobuf:"bytes,4,opt,name=snapshotUri,proto3" json:"snapshotUri,omitempty"`
+	Operation   *Operation `protobuf:"bytes,5,opt,name=operation,proto3" json:"operation,omitempty"`
 }
 
 func (x *CreateSnapshotAndDeleteResponse) Reset() {
 	*x = CreateSnapshotAndDeleteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[33]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2396,7 +2792,7 @@ func (x *CreateSnapshotAndDeleteResponse) String() string {
 func (*CreateSnapshotAndDeleteResponse) ProtoMessage() {}
 
 func (x *CreateSnapshotAndDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[33]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2409,7 +2805,7 @@ func (x *CreateSnapshotAndDeleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSnapshotAndDeleteResponse.ProtoReflect.Descriptor instead.
 func (*CreateSnapshotAndDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{33}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *CreateSnapshotAndDeleteResponse) GetSnapshotid() string {
@@ -2433,6 +2829,13 @@ func (x *CreateSnapshotAndDeleteResponse) GetSnapshotUri() string {
 	return ""
 }
 
+func (x *CreateSnapshotAndDeleteResponse) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
 type RancherRegistrationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2444,7 +2847,7 @@ type RancherRegistrationRequest struct {
 func (x *RancherRegistrationRequest) Reset() {
 	*x = RancherRegistrationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[34]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2457,7 +2860,7 @@ func (x *RancherRegistrationRequest) String() string {
 func (*RancherRegistrationRequest) ProtoMessage() {}
 
 func (x *RancherRegistrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[34]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2470,7 +2873,7 @@ func (x *RancherRegistrationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RancherRegistrationRequest.ProtoReflect.Descriptor instead.
 func (*RancherRegistrationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{34}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *RancherRegistrationRequest) GetClusterName() string {
@@ -2495,7 +2898,7 @@ type RancherRegistrationResponse struct {
 func (x *RancherRegistrationResponse) Reset() {
 	*x = RancherRegistrationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[35]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2508,7 +2911,7 @@ func (x *RancherRegistrationResponse) String() string {
 func (*RancherRegistrationResponse) ProtoMessage() {}
 
 func (x *RancherRegistrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[35]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2521,7 +2924,7 @@ func (x *RancherRegistrationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RancherRegistrationResponse.ProtoReflect.Descriptor instead.
 func (*RancherRegistrationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{35}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *RancherRegistrationResponse) GetClusterName() string {
@@ -2564,7 +2967,7 @@ type GetWorkspacesCostRequest struct {
 func (x *GetWorkspacesCostRequest) Reset() {
 	*x = GetWorkspacesCostRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[36]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2577,7 +2980,7 @@ func (x *GetWorkspacesCostRequest) String() string {
 func (*GetWorkspacesCostRequest) ProtoMessage() {}
 
 func (x *GetWorkspacesCostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[36]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2590,7 +2993,7 @@ func (x *GetWorkspacesCostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWorkspacesCostRequest.ProtoReflect.Descriptor instead.
 func (*GetWorkspacesCostRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{36}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetWorkspacesCostRequest) GetProvider() string {
@@ -2667,7 +3070,7 @@ type GetApplicationsCostRequest struct {
 func (x *GetApplicationsCostRequest) Reset() {
 	*x = GetApplicationsCostRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[37]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2680,7 +3083,7 @@ func (x *GetApplicationsCostRequest) String() string {
 func (*GetApplicationsCostRequest) ProtoMessage() {}
 
 func (x *GetApplicationsCostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[37]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2693,7 +3096,7 @@ func (x *GetApplicationsCostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetApplicationsCostRequest.ProtoReflect.Descriptor instead.
 func (*GetApplicationsCostRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{37}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetApplicationsCostRequest) GetApplicationIds() []string {
@@ -2764,7 +3167,7 @@ type GroupBy struct {
 func (x *GroupBy) Reset() {
 	*x = GroupBy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[38]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2777,7 +3180,7 @@ func (x *GroupBy) String() string {
 func (*GroupBy) ProtoMessage() {}
 
 func (x *GroupBy) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[38]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2790,7 +3193,7 @@ func (x *GroupBy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupBy.ProtoReflect.Descriptor instead.
 func (*GroupBy) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{38}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GroupBy) GetType() string {
@@ -2819,7 +3222,7 @@ type GetWorkspacesCostResponse struct {
 func (x *GetWorkspacesCostResponse) Reset() {
 	*x = GetWorkspacesCostResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[39]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2832,7 +3235,7 @@ func (x *GetWorkspacesCostResponse) String() string {
 func (*GetWorkspacesCostResponse) ProtoMessage() {}
 
 func (x *GetWorkspacesCostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[39]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2845,7 +3248,7 @@ func (x *GetWorkspacesCostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWorkspacesCostResponse.ProtoReflect.Descriptor instead.
 func (*GetWorkspacesCostResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{39}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *GetWorkspacesCostResponse) GetTotalCost() int64 {
@@ -2874,7 +3277,7 @@ type GetApplicationsCostResponse struct {
 func (x *GetApplicationsCostResponse) Reset() {
 	*x = GetApplicationsCostResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[40]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2887,7 +3290,7 @@ func (x *GetApplicationsCostResponse) String() string {
 func (*GetApplicationsCostResponse) ProtoMessage() {}
 
 func (x *GetApplicationsCostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[40]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2900,7 +3303,7 @@ func (x *GetApplicationsCostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetApplicationsCostResponse.ProtoReflect.Descriptor instead.
 func (*GetApplicationsCostResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{40}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *GetApplicationsCostResponse) GetTotalCost() int64 {
@@ -2930,7 +3333,7 @@ type AwsCredentials struct {
 func (x *AwsCredentials) Reset() {
 	*x = AwsCredentials{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[41]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2943,7 +3346,7 @@ func (x *AwsCredentials) String() string {
 func (*AwsCredentials) ProtoMessage() {}
 
 func (x *AwsCredentials) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[41]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2956,7 +3359,7 @@ func (x *AwsCredentials) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AwsCredentials.ProtoReflect.Descriptor instead.
 func (*AwsCredentials) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{41}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *AwsCredentials) GetAccessKeyID() string {
@@ -2980,6 +3383,73 @@ func (x *AwsCredentials) GetToken() string {
 	return ""
 }
 
+// AwsRoleCredentials role in the account assumed by spawner, in place of the
+// access keys
+type AwsRoleCredentials struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoleArn string `protobuf:"bytes,1,opt,name=roleArn,proto3" json:"roleArn,omitempty"`
+	// external id required by the trust policy of the role
+	ExternalID string `protobuf:"bytes,2,opt,name=externalID,proto3" json:"externalID,omitempty"`
+	// json policy further restricting the assumed role session
+	SessionPolicy string `protobuf:"bytes,3,opt,name=sessionPolicy,proto3" json:"sessionPolicy,omitempty"`
+}
+
+func (x *AwsRoleCredentials) Reset() {
+	*x = AwsRoleCredentials{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AwsRoleCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AwsRoleCredentials) ProtoMessage() {}
+
+func (x *AwsRoleCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AwsRoleCredentials.ProtoReflect.Descriptor instead.
+func (*AwsRoleCredentials) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AwsRoleCredentials) GetRoleArn() string {
+	if x != nil {
+		return x.RoleArn
+	}
+	return ""
+}
+
+func (x *AwsRoleCredentials) GetExternalID() string {
+	if x != nil {
+		return x.ExternalID
+	}
+	return ""
+}
+
+func (x *AwsRoleCredentials) GetSessionPolicy() string {
+	if x != nil {
+		return x.SessionPolicy
+	}
+	return ""
+}
+
 type AzureCredentials struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2995,7 +3465,7 @@ type AzureCredentials struct {
 func (x *AzureCredentials) Reset() {
 	*x = AzureCredentials{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[42]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3008,7 +3478,7 @@ func (x *AzureCredentials) String() string {
 func (*AzureCredentials) ProtoMessage() {}
 
 func (x *AzureCredentials) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[42]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3021,7 +3491,7 @@ func (x *AzureCredentials) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AzureCredentials.ProtoReflect.Descriptor instead.
 func (*AzureCredentials) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{42}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *AzureCredentials) GetSubscriptionID() string {
@@ -3070,7 +3540,7 @@ type GithubPersonalAccessToken struct {
 func (x *GithubPersonalAccessToken) Reset() {
 	*x = GithubPersonalAccessToken{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[43]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3083,7 +3553,7 @@ func (x *GithubPersonalAccessToken) String() string {
 func (*GithubPersonalAccessToken) ProtoMessage() {}
 
 func (x *GithubPersonalAccessToken) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[43]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3096,7 +3566,7 @@ func (x *GithubPersonalAccessToken) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GithubPersonalAccessToken.ProtoReflect.Descriptor instead.
 func (*GithubPersonalAccessToken) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{43}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *GithubPersonalAccessToken) GetToken() string {
@@ -3118,7 +3588,7 @@ type GcpCredentials struct {
 func (x *GcpCredentials) Reset() {
 	*x = GcpCredentials{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[44]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3131,7 +3601,7 @@ func (x *GcpCredentials) String() string {
 func (*GcpCredentials) ProtoMessage() {}
 
 func (x *GcpCredentials) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[44]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3144,7 +3614,7 @@ func (x *GcpCredentials) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GcpCredentials.ProtoReflect.Descriptor instead.
 func (*GcpCredentials) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{44}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *GcpCredentials) GetProjectID() string {
@@ -3161,27 +3631,173 @@ func (x *GcpCredentials) GetCertificate() string {
 	return ""
 }
 
-type WriteCredentialRequest struct {
+// AzureFederatedCredentials application trusting the spawner service account
+// token as its federated credential, in place of the client secret
+type AzureFederatedCredentials struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// Deprecated: Do not use.
-	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
-	Type     string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
-	// Types that are assignable to Cred:
-	//	*WriteCredentialRequest_AwsCred
-	//	*WriteCredentialRequest_AzureCred
-	//	*WriteCredentialRequest_GitPat
-	//	*WriteCredentialRequest_GcpCred
+	SubscriptionID string `protobuf:"bytes,1,opt,name=subscriptionID,proto3" json:"subscriptionID,omitempty"`
+	TenantID       string `protobuf:"bytes,2,opt,name=tenantID,proto3" json:"tenantID,omitempty"`
+	ClientID       string `protobuf:"bytes,3,opt,name=clientID,proto3" json:"clientID,omitempty"`
+	ResourceGroup  string `protobuf:"bytes,4,opt,name=resourceGroup,proto3" json:"resourceGroup,omitempty"`
+}
+
+func (x *AzureFederatedCredentials) Reset() {
+	*x = AzureFederatedCredentials{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AzureFederatedCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AzureFederatedCredentials) ProtoMessage() {}
+
+func (x *AzureFederatedCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AzureFederatedCredentials.ProtoReflect.Descriptor instead.
+func (*AzureFederatedCredentials) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AzureFederatedCredentials) GetSubscriptionID() string {
+	if x != nil {
+		return x.SubscriptionID
+	}
+	return ""
+}
+
+func (x *AzureFederatedCredentials) GetTenantID() string {
+	if x != nil {
+		return x.TenantID
+	}
+	return ""
+}
+
+func (x *AzureFederatedCredentials) GetClientID() string {
+	if x != nil {
+		return x.ClientID
+	}
+	return ""
+}
+
+func (x *AzureFederatedCredentials) GetResourceGroup() string {
+	if x != nil {
+		return x.ResourceGroup
+	}
+	return ""
+}
+
+// GcpFederatedCredentials workload identity federation trusting the spawner
+// service account token, in place of the service account key
+type GcpFederatedCredentials struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectID string `protobuf:"bytes,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
+	// audience of the workload identity pool provider,
+	// //iam.googleapis.com/projects/<number>/locations/global/workloadIdentityPools/<pool>/providers/<provider>
+	Audience string `protobuf:"bytes,2,opt,name=audience,proto3" json:"audience,omitempty"`
+	// service account impersonated by the federated identity, optional
+	ServiceAccount string `protobuf:"bytes,3,opt,name=serviceAccount,proto3" json:"serviceAccount,omitempty"`
+}
+
+func (x *GcpFederatedCredentials) Reset() {
+	*x = GcpFederatedCredentials{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GcpFederatedCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GcpFederatedCredentials) ProtoMessage() {}
+
+func (x *GcpFederatedCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GcpFederatedCredentials.ProtoReflect.Descriptor instead.
+func (*GcpFederatedCredentials) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GcpFederatedCredentials) GetProjectID() string {
+	if x != nil {
+		return x.ProjectID
+	}
+	return ""
+}
+
+func (x *GcpFederatedCredentials) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+func (x *GcpFederatedCredentials) GetServiceAccount() string {
+	if x != nil {
+		return x.ServiceAccount
+	}
+	return ""
+}
+
+type WriteCredentialRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// Deprecated: Do not use.
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Type     string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	// Types that are assignable to Cred:
+	//	*WriteCredentialRequest_AwsCred
+	//	*WriteCredentialRequest_AzureCred
+	//	*WriteCredentialRequest_GitPat
+	//	*WriteCredentialRequest_GcpCred
+	//	*WriteCredentialRequest_AwsRoleCred
+	//	*WriteCredentialRequest_AzureFederatedCred
+	//	*WriteCredentialRequest_GcpFederatedCred
 	Cred isWriteCredentialRequest_Cred `protobuf_oneof:"cred"`
+	// store the credential without checking it with the provider
+	SkipVerification bool `protobuf:"varint,8,opt,name=skipVerification,proto3" json:"skipVerification,omitempty"`
 }
 
 func (x *WriteCredentialRequest) Reset() {
 	*x = WriteCredentialRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[45]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3194,7 +3810,7 @@ func (x *WriteCredentialRequest) String() string {
 func (*WriteCredentialRequest) ProtoMessage() {}
 
 func (x *WriteCredentialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[45]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3207,7 +3823,7 @@ func (x *WriteCredentialRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WriteCredentialRequest.ProtoReflect.Descriptor instead.
 func (*WriteCredentialRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{45}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *WriteCredentialRequest) GetAccount() string {
@@ -3267,6 +3883,34 @@ func (x *WriteCredentialRequest) GetGcpCred() *GcpCredentials {
 	return nil
 }
 
+func (x *WriteCredentialRequest) GetAwsRoleCred() *AwsRoleCredentials {
+	if x, ok := x.GetCred().(*WriteCredentialRequest_AwsRoleCred); ok {
+		return x.AwsRoleCred
+	}
+	return nil
+}
+
+func (x *WriteCredentialRequest) GetAzureFederatedCred() *AzureFederatedCredentials {
+	if x, ok := x.GetCred().(*WriteCredentialRequest_AzureFederatedCred); ok {
+		return x.AzureFederatedCred
+	}
+	return nil
+}
+
+func (x *WriteCredentialRequest) GetGcpFederatedCred() *GcpFederatedCredentials {
+	if x, ok := x.GetCred().(*WriteCredentialRequest_GcpFederatedCred); ok {
+		return x.GcpFederatedCred
+	}
+	return nil
+}
+
+func (x *WriteCredentialRequest) GetSkipVerification() bool {
+	if x != nil {
+		return x.SkipVerification
+	}
+	return false
+}
+
 type isWriteCredentialRequest_Cred interface {
 	isWriteCredentialRequest_Cred()
 }
@@ -3287,6 +3931,18 @@ type WriteCredentialRequest_GcpCred struct {
 	GcpCred *GcpCredentials `protobuf:"bytes,7,opt,name=gcpCred,proto3,oneof"`
 }
 
+type WriteCredentialRequest_AwsRoleCred struct {
+	AwsRoleCred *AwsRoleCredentials `protobuf:"bytes,9,opt,name=awsRoleCred,proto3,oneof"`
+}
+
+type WriteCredentialRequest_AzureFederatedCred struct {
+	AzureFederatedCred *AzureFederatedCredentials `protobuf:"bytes,10,opt,name=azureFederatedCred,proto3,oneof"`
+}
+
+type WriteCredentialRequest_GcpFederatedCred struct {
+	GcpFederatedCred *GcpFederatedCredentials `protobuf:"bytes,11,opt,name=gcpFederatedCred,proto3,oneof"`
+}
+
 func (*WriteCredentialRequest_AwsCred) isWriteCredentialRequest_Cred() {}
 
 func (*WriteCredentialRequest_AzureCred) isWriteCredentialRequest_Cred() {}
@@ -3295,18 +3951,103 @@ func (*WriteCredentialRequest_GitPat) isWriteCredentialRequest_Cred() {}
 
 func (*WriteCredentialRequest_GcpCred) isWriteCredentialRequest_Cred() {}
 
+func (*WriteCredentialRequest_AwsRoleCred) isWriteCredentialRequest_Cred() {}
+
+func (*WriteCredentialRequest_AzureFederatedCred) isWriteCredentialRequest_Cred() {}
+
+func (*WriteCredentialRequest_GcpFederatedCred) isWriteCredentialRequest_Cred() {}
+
+// CredentialIdentity principal the credential authenticates as
+type CredentialIdentity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// aws arn, azure client id or gcp service account email
+	Principal string `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+	// aws account id, azure subscription or gcp project
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// permissions required by spawner which are not granted to the principal
+	MissingPermissions []string `protobuf:"bytes,3,rep,name=missingPermissions,proto3" json:"missingPermissions,omitempty"`
+	// set when the permissions could not be checked
+	PermissionCheckError string `protobuf:"bytes,4,opt,name=permissionCheckError,proto3" json:"permissionCheckError,omitempty"`
+}
+
+func (x *CredentialIdentity) Reset() {
+	*x = CredentialIdentity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CredentialIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CredentialIdentity) ProtoMessage() {}
+
+func (x *CredentialIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CredentialIdentity.ProtoReflect.Descriptor instead.
+func (*CredentialIdentity) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CredentialIdentity) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *CredentialIdentity) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *CredentialIdentity) GetMissingPermissions() []string {
+	if x != nil {
+		return x.MissingPermissions
+	}
+	return nil
+}
+
+func (x *CredentialIdentity) GetPermissionCheckError() string {
+	if x != nil {
+		return x.PermissionCheckError
+	}
+	return ""
+}
+
 type WriteCredentialResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	// identity of the verified credential, not set when verification is
+	// skipped or not supported for the type
+	Identity *CredentialIdentity `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
 }
 
 func (x *WriteCredentialResponse) Reset() {
 	*x = WriteCredentialResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[46]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3319,7 +4060,7 @@ func (x *WriteCredentialResponse) String() string {
 func (*WriteCredentialResponse) ProtoMessage() {}
 
 func (x *WriteCredentialResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[46]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3332,7 +4073,7 @@ func (x *WriteCredentialResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WriteCredentialResponse.ProtoReflect.Descriptor instead.
 func (*WriteCredentialResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{46}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *WriteCredentialResponse) GetError() string {
@@ -3342,6 +4083,13 @@ func (x *WriteCredentialResponse) GetError() string {
 	return ""
 }
 
+func (x *WriteCredentialResponse) GetIdentity() *CredentialIdentity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
 type ReadCredentialRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3351,12 +4099,14 @@ type ReadCredentialRequest struct {
 	// Deprecated: Do not use.
 	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
 	Type     string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// one of current, pending, previous. defaults to current
+	Stage string `protobuf:"bytes,4,opt,name=stage,proto3" json:"stage,omitempty"`
 }
 
 func (x *ReadCredentialRequest) Reset() {
 	*x = ReadCredentialRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[47]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3369,7 +4119,7 @@ func (x *ReadCredentialRequest) String() string {
 func (*ReadCredentialRequest) ProtoMessage() {}
 
 func (x *ReadCredentialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[47]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3382,7 +4132,7 @@ func (x *ReadCredentialRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadCredentialRequest.ProtoReflect.Descriptor instead.
 func (*ReadCredentialRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{47}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *ReadCredentialRequest) GetAccount() string {
@@ -3407,6 +4157,13 @@ func (x *ReadCredentialRequest) GetType() string {
 	return ""
 }
 
+func (x *ReadCredentialRequest) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
 type ReadCredentialResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3421,13 +4178,27 @@ type ReadCredentialResponse struct {
 	//	*ReadCredentialResponse_AzureCred
 	//	*ReadCredentialResponse_GitPat
 	//	*ReadCredentialResponse_GcpCred
+	//	*ReadCredentialResponse_AwsRoleCred
+	//	*ReadCredentialResponse_AzureFederatedCred
+	//	*ReadCredentialResponse_GcpFederatedCred
 	Cred isReadCredentialResponse_Cred `protobuf_oneof:"cred"`
+	// identifies the credential without disclosing it: aws access key id or
+	// role arn, azure client id, gcp private key id or service account
+	KeyID string `protobuf:"bytes,11,opt,name=keyID,proto3" json:"keyID,omitempty"`
+	// sha256 fingerprint of the secret, changes when the credential is replaced
+	Fingerprint string `protobuf:"bytes,12,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// version of the credential read
+	Version string `protobuf:"bytes,13,opt,name=version,proto3" json:"version,omitempty"`
+	// unix time the version was written, the last rotation
+	RotatedAt int64 `protobuf:"varint,14,opt,name=rotatedAt,proto3" json:"rotatedAt,omitempty"`
+	// secret fields are masked
+	Redacted bool `protobuf:"varint,15,opt,name=redacted,proto3" json:"redacted,omitempty"`
 }
 
 func (x *ReadCredentialResponse) Reset() {
 	*x = ReadCredentialResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[48]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3440,7 +4211,7 @@ func (x *ReadCredentialResponse) String() string {
 func (*ReadCredentialResponse) ProtoMessage() {}
 
 func (x *ReadCredentialResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[48]
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3453,7 +4224,7 @@ func (x *ReadCredentialResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadCredentialResponse.ProtoReflect.Descriptor instead.
 func (*ReadCredentialResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{48}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *ReadCredentialResponse) GetAccount() string {
@@ -3513,63 +4284,138 @@ func (x *ReadCredentialResponse) GetGcpCred() *GcpCredentials {
 	return nil
 }
 
-type isReadCredentialResponse_Cred interface {
-	isReadCredentialResponse_Cred()
+func (x *ReadCredentialResponse) GetAwsRoleCred() *AwsRoleCredentials {
+	if x, ok := x.GetCred().(*ReadCredentialResponse_AwsRoleCred); ok {
+		return x.AwsRoleCred
+	}
+	return nil
 }
 
-type ReadCredentialResponse_AwsCred struct {
-	AwsCred *AwsCredentials `protobuf:"bytes,3,opt,name=awsCred,proto3,oneof"`
+func (x *ReadCredentialResponse) GetAzureFederatedCred() *AzureFederatedCredentials {
+	if x, ok := x.GetCred().(*ReadCredentialResponse_AzureFederatedCred); ok {
+		return x.AzureFederatedCred
+	}
+	return nil
 }
 
-type ReadCredentialResponse_AzureCred struct {
-	AzureCred *AzureCredentials `protobuf:"bytes,4,opt,name=azureCred,proto3,oneof"`
+func (x *ReadCredentialResponse) GetGcpFederatedCred() *GcpFederatedCredentials {
+	if x, ok := x.GetCred().(*ReadCredentialResponse_GcpFederatedCred); ok {
+		return x.GcpFederatedCred
+	}
+	return nil
 }
 
-type ReadCredentialResponse_GitPat struct {
-	GitPat *GithubPersonalAccessToken `protobuf:"bytes,6,opt,name=gitPat,proto3,oneof"`
+func (x *ReadCredentialResponse) GetKeyID() string {
+	if x != nil {
+		return x.KeyID
+	}
+	return ""
 }
 
-type ReadCredentialResponse_GcpCred struct {
-	GcpCred *GcpCredentials `protobuf:"bytes,7,opt,name=gcpCred,proto3,oneof"`
+func (x *ReadCredentialResponse) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
 }
 
-func (*ReadCredentialResponse_AwsCred) isReadCredentialResponse_Cred() {}
-
-func (*ReadCredentialResponse_AzureCred) isReadCredentialResponse_Cred() {}
+func (x *ReadCredentialResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ReadCredentialResponse) GetRotatedAt() int64 {
+	if x != nil {
+		return x.RotatedAt
+	}
+	return 0
+}
+
+func (x *ReadCredentialResponse) GetRedacted() bool {
+	if x != nil {
+		return x.Redacted
+	}
+	return false
+}
+
+type isReadCredentialResponse_Cred interface {
+	isReadCredentialResponse_Cred()
+}
+
+type ReadCredentialResponse_AwsCred struct {
+	AwsCred *AwsCredentials `protobuf:"bytes,3,opt,name=awsCred,proto3,oneof"`
+}
+
+type ReadCredentialResponse_AzureCred struct {
+	AzureCred *AzureCredentials `protobuf:"bytes,4,opt,name=azureCred,proto3,oneof"`
+}
+
+type ReadCredentialResponse_GitPat struct {
+	GitPat *GithubPersonalAccessToken `protobuf:"bytes,6,opt,name=gitPat,proto3,oneof"`
+}
+
+type ReadCredentialResponse_GcpCred struct {
+	GcpCred *GcpCredentials `protobuf:"bytes,7,opt,name=gcpCred,proto3,oneof"`
+}
+
+type ReadCredentialResponse_AwsRoleCred struct {
+	AwsRoleCred *AwsRoleCredentials `protobuf:"bytes,8,opt,name=awsRoleCred,proto3,oneof"`
+}
+
+type ReadCredentialResponse_AzureFederatedCred struct {
+	AzureFederatedCred *AzureFederatedCredentials `protobuf:"bytes,9,opt,name=azureFederatedCred,proto3,oneof"`
+}
+
+type ReadCredentialResponse_GcpFederatedCred struct {
+	GcpFederatedCred *GcpFederatedCredentials `protobuf:"bytes,10,opt,name=gcpFederatedCred,proto3,oneof"`
+}
+
+func (*ReadCredentialResponse_AwsCred) isReadCredentialResponse_Cred() {}
+
+func (*ReadCredentialResponse_AzureCred) isReadCredentialResponse_Cred() {}
 
 func (*ReadCredentialResponse_GitPat) isReadCredentialResponse_Cred() {}
 
 func (*ReadCredentialResponse_GcpCred) isReadCredentialResponse_Cred() {}
 
-type GetKubeConfigRequest struct {
+func (*ReadCredentialResponse_AwsRoleCred) isReadCredentialResponse_Cred() {}
+
+func (*ReadCredentialResponse_AzureFederatedCred) isReadCredentialResponse_Cred() {}
+
+func (*ReadCredentialResponse_GcpFederatedCred) isReadCredentialResponse_Cred() {}
+
+type RevealCredentialRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
-	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
-	RawToken    bool   `protobuf:"varint,5,opt,name=rawToken,proto3" json:"rawToken,omitempty"`
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Type    string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// one of current, pending, previous. defaults to current
+	Stage string `protobuf:"bytes,3,opt,name=stage,proto3" json:"stage,omitempty"`
+	// why the secret is needed, recorded in the audit log
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *GetKubeConfigRequest) Reset() {
-	*x = GetKubeConfigRequest{}
+func (x *RevealCredentialRequest) Reset() {
+	*x = RevealCredentialRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[49]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetKubeConfigRequest) String() string {
+func (x *RevealCredentialRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetKubeConfigRequest) ProtoMessage() {}
+func (*RevealCredentialRequest) ProtoMessage() {}
 
-func (x *GetKubeConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[49]
+func (x *RevealCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3580,72 +4426,67 @@ func (x *GetKubeConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetKubeConfigRequest.ProtoReflect.Descriptor instead.
-func (*GetKubeConfigRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use RevealCredentialRequest.ProtoReflect.Descriptor instead.
+func (*RevealCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetKubeConfigRequest) GetProvider() string {
+func (x *RevealCredentialRequest) GetAccount() string {
 	if x != nil {
-		return x.Provider
+		return x.Account
 	}
 	return ""
 }
 
-func (x *GetKubeConfigRequest) GetRegion() string {
+func (x *RevealCredentialRequest) GetType() string {
 	if x != nil {
-		return x.Region
+		return x.Type
 	}
 	return ""
 }
 
-func (x *GetKubeConfigRequest) GetAccountName() string {
+func (x *RevealCredentialRequest) GetStage() string {
 	if x != nil {
-		return x.AccountName
+		return x.Stage
 	}
 	return ""
 }
 
-func (x *GetKubeConfigRequest) GetClusterName() string {
+func (x *RevealCredentialRequest) GetReason() string {
 	if x != nil {
-		return x.ClusterName
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *GetKubeConfigRequest) GetRawToken() bool {
-	if x != nil {
-		return x.RawToken
-	}
-	return false
-}
-
-type GetKubeConfigResponse struct {
+type CredentialInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClusterName string `protobuf:"bytes,1,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
-	Config      []byte `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Type    string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// unix time
+	UpdatedAt int64 `protobuf:"varint,3,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
 }
 
-func (x *GetKubeConfigResponse) Reset() {
-	*x = GetKubeConfigResponse{}
+func (x *CredentialInfo) Reset() {
+	*x = CredentialInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[50]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetKubeConfigResponse) String() string {
+func (x *CredentialInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetKubeConfigResponse) ProtoMessage() {}
+func (*CredentialInfo) ProtoMessage() {}
 
-func (x *GetKubeConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[50]
+func (x *CredentialInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3656,48 +4497,60 @@ func (x *GetKubeConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetKubeConfigResponse.ProtoReflect.Descriptor instead.
-func (*GetKubeConfigResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use CredentialInfo.ProtoReflect.Descriptor instead.
+func (*CredentialInfo) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GetKubeConfigResponse) GetClusterName() string {
+func (x *CredentialInfo) GetAccount() string {
 	if x != nil {
-		return x.ClusterName
+		return x.Account
 	}
 	return ""
 }
 
-func (x *GetKubeConfigResponse) GetConfig() []byte {
+func (x *CredentialInfo) GetType() string {
 	if x != nil {
-		return x.Config
+		return x.Type
 	}
-	return nil
+	return ""
 }
 
-type TagNodeInstanceResponse struct {
+func (x *CredentialInfo) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type ListCredentialsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// credentials of all accounts when empty
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// credentials of all types when empty
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
 }
 
-func (x *TagNodeInstanceResponse) Reset() {
-	*x = TagNodeInstanceResponse{}
+func (x *ListCredentialsRequest) Reset() {
+	*x = ListCredentialsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[51]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TagNodeInstanceResponse) String() string {
+func (x *ListCredentialsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TagNodeInstanceResponse) ProtoMessage() {}
+func (*ListCredentialsRequest) ProtoMessage() {}
 
-func (x *TagNodeInstanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[51]
+func (x *ListCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3708,41 +4561,50 @@ func (x *TagNodeInstanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TagNodeInstanceResponse.ProtoReflect.Descriptor instead.
-func (*TagNodeInstanceResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use ListCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*ListCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{58}
 }
 
-type TagNodeInstanceRequest struct {
+func (x *ListCredentialsRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *ListCredentialsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type ListCredentialsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider    string            `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Region      string            `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
-	AccountName string            `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	ClusterName string            `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
-	NodeGroup   string            `protobuf:"bytes,5,opt,name=nodeGroup,proto3" json:"nodeGroup,omitempty"`
-	Labels      map[string]string `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Credentials []*CredentialInfo `protobuf:"bytes,1,rep,name=credentials,proto3" json:"credentials,omitempty"`
 }
 
-func (x *TagNodeInstanceRequest) Reset() {
-	*x = TagNodeInstanceRequest{}
+func (x *ListCredentialsResponse) Reset() {
+	*x = ListCredentialsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[52]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TagNodeInstanceRequest) String() string {
+func (x *ListCredentialsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TagNodeInstanceRequest) ProtoMessage() {}
+func (*ListCredentialsResponse) ProtoMessage() {}
 
-func (x *TagNodeInstanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[52]
+func (x *ListCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3753,84 +4615,96 @@ func (x *TagNodeInstanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TagNodeInstanceRequest.ProtoReflect.Descriptor instead.
-func (*TagNodeInstanceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use ListCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*ListCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *TagNodeInstanceRequest) GetProvider() string {
+func (x *ListCredentialsResponse) GetCredentials() []*CredentialInfo {
 	if x != nil {
-		return x.Provider
+		return x.Credentials
 	}
-	return ""
+	return nil
 }
 
-func (x *TagNodeInstanceRequest) GetRegion() string {
-	if x != nil {
-		return x.Region
-	}
-	return ""
+type DeleteCredentialRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Type    string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
 }
 
-func (x *TagNodeInstanceRequest) GetAccountName() string {
-	if x != nil {
-		return x.AccountName
+func (x *DeleteCredentialRequest) Reset() {
+	*x = DeleteCredentialRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *TagNodeInstanceRequest) GetClusterName() string {
-	if x != nil {
-		return x.ClusterName
+func (x *DeleteCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCredentialRequest) ProtoMessage() {}
+
+func (x *DeleteCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *TagNodeInstanceRequest) GetNodeGroup() string {
+// Deprecated: Use DeleteCredentialRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeleteCredentialRequest) GetAccount() string {
 	if x != nil {
-		return x.NodeGroup
+		return x.Account
 	}
 	return ""
 }
 
-func (x *TagNodeInstanceRequest) GetLabels() map[string]string {
+func (x *DeleteCredentialRequest) GetType() string {
 	if x != nil {
-		return x.Labels
+		return x.Type
 	}
-	return nil
+	return ""
 }
 
-type GetCostByTimeRequest struct {
+type DeleteCredentialResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Provider    string   `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Ids         []string `protobuf:"bytes,2,rep,name=Ids,proto3" json:"Ids,omitempty"`
-	AccountName string   `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	StartDate   string   `protobuf:"bytes,4,opt,name=startDate,proto3" json:"startDate,omitempty"`
-	EndDate     string   `protobuf:"bytes,5,opt,name=endDate,proto3" json:"endDate,omitempty"`
-	Granularity string   `protobuf:"bytes,6,opt,name=granularity,proto3" json:"granularity,omitempty"`
-	GroupBy     *GroupBy `protobuf:"bytes,7,opt,name=groupBy,proto3" json:"groupBy,omitempty"`
 }
 
-func (x *GetCostByTimeRequest) Reset() {
-	*x = GetCostByTimeRequest{}
+func (x *DeleteCredentialResponse) Reset() {
+	*x = DeleteCredentialResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[53]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCostByTimeRequest) String() string {
+func (x *DeleteCredentialResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCostByTimeRequest) ProtoMessage() {}
+func (*DeleteCredentialResponse) ProtoMessage() {}
 
-func (x *GetCostByTimeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[53]
+func (x *DeleteCredentialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3841,85 +4715,227 @@ func (x *GetCostByTimeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCostByTimeRequest.ProtoReflect.Descriptor instead.
-func (*GetCostByTimeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use DeleteCredentialResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCredentialResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *GetCostByTimeRequest) GetProvider() string {
-	if x != nil {
-		return x.Provider
-	}
-	return ""
-}
+type RotateCredentialRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *GetCostByTimeRequest) GetIds() []string {
-	if x != nil {
-		return x.Ids
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Type    string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// new credential, staged as pending
+	//
+	// Types that are assignable to Cred:
+	//	*RotateCredentialRequest_AwsCred
+	//	*RotateCredentialRequest_AzureCred
+	//	*RotateCredentialRequest_GitPat
+	//	*RotateCredentialRequest_GcpCred
+	//	*RotateCredentialRequest_AwsRoleCred
+	//	*RotateCredentialRequest_AzureFederatedCred
+	//	*RotateCredentialRequest_GcpFederatedCred
+	Cred isRotateCredentialRequest_Cred `protobuf_oneof:"cred"`
+	// promote the pending credential to current, along with the new credential
+	// when set
+	Confirm bool `protobuf:"varint,7,opt,name=confirm,proto3" json:"confirm,omitempty"`
+	// stage the credential without checking it with the provider
+	SkipVerification bool `protobuf:"varint,8,opt,name=skipVerification,proto3" json:"skipVerification,omitempty"`
+}
+
+func (x *RotateCredentialRequest) Reset() {
+	*x = RotateCredentialRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *GetCostByTimeRequest) GetAccountName() string {
-	if x != nil {
-		return x.AccountName
-	}
-	return ""
+func (x *RotateCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetCostByTimeRequest) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
+func (*RotateCredentialRequest) ProtoMessage() {}
+
+func (x *RotateCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetCostByTimeRequest) GetEndDate() string {
+// Deprecated: Use RotateCredentialRequest.ProtoReflect.Descriptor instead.
+func (*RotateCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RotateCredentialRequest) GetAccount() string {
 	if x != nil {
-		return x.EndDate
+		return x.Account
 	}
 	return ""
 }
 
-func (x *GetCostByTimeRequest) GetGranularity() string {
+func (x *RotateCredentialRequest) GetType() string {
 	if x != nil {
-		return x.Granularity
+		return x.Type
 	}
 	return ""
 }
 
-func (x *GetCostByTimeRequest) GetGroupBy() *GroupBy {
-	if x != nil {
-		return x.GroupBy
+func (m *RotateCredentialRequest) GetCred() isRotateCredentialRequest_Cred {
+	if m != nil {
+		return m.Cred
 	}
 	return nil
 }
 
-type GetCostByTimeResponse struct {
+func (x *RotateCredentialRequest) GetAwsCred() *AwsCredentials {
+	if x, ok := x.GetCred().(*RotateCredentialRequest_AwsCred); ok {
+		return x.AwsCred
+	}
+	return nil
+}
+
+func (x *RotateCredentialRequest) GetAzureCred() *AzureCredentials {
+	if x, ok := x.GetCred().(*RotateCredentialRequest_AzureCred); ok {
+		return x.AzureCred
+	}
+	return nil
+}
+
+func (x *RotateCredentialRequest) GetGitPat() *GithubPersonalAccessToken {
+	if x, ok := x.GetCred().(*RotateCredentialRequest_GitPat); ok {
+		return x.GitPat
+	}
+	return nil
+}
+
+func (x *RotateCredentialRequest) GetGcpCred() *GcpCredentials {
+	if x, ok := x.GetCred().(*RotateCredentialRequest_GcpCred); ok {
+		return x.GcpCred
+	}
+	return nil
+}
+
+func (x *RotateCredentialRequest) GetAwsRoleCred() *AwsRoleCredentials {
+	if x, ok := x.GetCred().(*RotateCredentialRequest_AwsRoleCred); ok {
+		return x.AwsRoleCred
+	}
+	return nil
+}
+
+func (x *RotateCredentialRequest) GetAzureFederatedCred() *AzureFederatedCredentials {
+	if x, ok := x.GetCred().(*RotateCredentialRequest_AzureFederatedCred); ok {
+		return x.AzureFederatedCred
+	}
+	return nil
+}
+
+func (x *RotateCredentialRequest) GetGcpFederatedCred() *GcpFederatedCredentials {
+	if x, ok := x.GetCred().(*RotateCredentialRequest_GcpFederatedCred); ok {
+		return x.GcpFederatedCred
+	}
+	return nil
+}
+
+func (x *RotateCredentialRequest) GetConfirm() bool {
+	if x != nil {
+		return x.Confirm
+	}
+	return false
+}
+
+func (x *RotateCredentialRequest) GetSkipVerification() bool {
+	if x != nil {
+		return x.SkipVerification
+	}
+	return false
+}
+
+type isRotateCredentialRequest_Cred interface {
+	isRotateCredentialRequest_Cred()
+}
+
+type RotateCredentialRequest_AwsCred struct {
+	AwsCred *AwsCredentials `protobuf:"bytes,3,opt,name=awsCred,proto3,oneof"`
+}
+
+type RotateCredentialRequest_AzureCred struct {
+	AzureCred *AzureCredentials `protobuf:"bytes,4,opt,name=azureCred,proto3,oneof"`
+}
+
+type RotateCredentialRequest_GitPat struct {
+	GitPat *GithubPersonalAccessToken `protobuf:"bytes,5,opt,name=gitPat,proto3,oneof"`
+}
+
+type RotateCredentialRequest_GcpCred struct {
+	GcpCred *GcpCredentials `protobuf:"bytes,6,opt,name=gcpCred,proto3,oneof"`
+}
+
+type RotateCredentialRequest_AwsRoleCred struct {
+	AwsRoleCred *AwsRoleCredentials `protobuf:"bytes,9,opt,name=awsRoleCred,proto3,oneof"`
+}
+
+type RotateCredentialRequest_AzureFederatedCred struct {
+	AzureFederatedCred *AzureFederatedCredentials `protobuf:"bytes,10,opt,name=azureFederatedCred,proto3,oneof"`
+}
+
+type RotateCredentialRequest_GcpFederatedCred struct {
+	GcpFederatedCred *GcpFederatedCredentials `protobuf:"bytes,11,opt,name=gcpFederatedCred,proto3,oneof"`
+}
+
+func (*RotateCredentialRequest_AwsCred) isRotateCredentialRequest_Cred() {}
+
+func (*RotateCredentialRequest_AzureCred) isRotateCredentialRequest_Cred() {}
+
+func (*RotateCredentialRequest_GitPat) isRotateCredentialRequest_Cred() {}
+
+func (*RotateCredentialRequest_GcpCred) isRotateCredentialRequest_Cred() {}
+
+func (*RotateCredentialRequest_AwsRoleCred) isRotateCredentialRequest_Cred() {}
+
+func (*RotateCredentialRequest_AzureFederatedCred) isRotateCredentialRequest_Cred() {}
+
+func (*RotateCredentialRequest_GcpFederatedCred) isRotateCredentialRequest_Cred() {}
+
+type CredentialVersion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupedCost map[string]*CostMap `protobuf:"bytes,1,rep,name=groupedCost,proto3" json:"groupedCost,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// current, pending or previous
+	Stages []string `protobuf:"bytes,2,rep,name=stages,proto3" json:"stages,omitempty"`
+	// unix time
+	CreatedAt int64 `protobuf:"varint,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 }
 
-func (x *GetCostByTimeResponse) Reset() {
-	*x = GetCostByTimeResponse{}
+func (x *CredentialVersion) Reset() {
+	*x = CredentialVersion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[54]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCostByTimeResponse) String() string {
+func (x *CredentialVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCostByTimeResponse) ProtoMessage() {}
+func (*CredentialVersion) ProtoMessage() {}
 
-func (x *GetCostByTimeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[54]
+func (x *CredentialVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3930,43 +4946,60 @@ func (x *GetCostByTimeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCostByTimeResponse.ProtoReflect.Descriptor instead.
-func (*GetCostByTimeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use CredentialVersion.ProtoReflect.Descriptor instead.
+func (*CredentialVersion) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *GetCostByTimeResponse) GetGroupedCost() map[string]*CostMap {
+func (x *CredentialVersion) GetId() string {
 	if x != nil {
-		return x.GroupedCost
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CredentialVersion) GetStages() []string {
+	if x != nil {
+		return x.Stages
 	}
 	return nil
 }
 
-type CostMap struct {
+func (x *CredentialVersion) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type RotateCredentialResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cost map[string]int64 `protobuf:"bytes,1,rep,name=cost,proto3" json:"cost,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// version staged or promoted
+	Version *CredentialVersion `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// identity of the staged credential when verified
+	Identity *CredentialIdentity `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
 }
 
-func (x *CostMap) Reset() {
-	*x = CostMap{}
+func (x *RotateCredentialResponse) Reset() {
+	*x = RotateCredentialResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[55]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CostMap) String() string {
+func (x *RotateCredentialResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CostMap) ProtoMessage() {}
+func (*RotateCredentialResponse) ProtoMessage() {}
 
-func (x *CostMap) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[55]
+func (x *RotateCredentialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3977,45 +5010,53 @@ func (x *CostMap) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CostMap.ProtoReflect.Descriptor instead.
-func (*CostMap) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use RotateCredentialResponse.ProtoReflect.Descriptor instead.
+func (*RotateCredentialResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *CostMap) GetCost() map[string]int64 {
+func (x *RotateCredentialResponse) GetVersion() *CredentialVersion {
 	if x != nil {
-		return x.Cost
+		return x.Version
 	}
 	return nil
 }
 
-type GetContainerRegistryAuthRequest struct {
+func (x *RotateCredentialResponse) GetIdentity() *CredentialIdentity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+type VerifyCredentialRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
-	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Type    string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// one of current, pending, previous. defaults to current
+	Stage string `protobuf:"bytes,3,opt,name=stage,proto3" json:"stage,omitempty"`
 }
 
-func (x *GetContainerRegistryAuthRequest) Reset() {
-	*x = GetContainerRegistryAuthRequest{}
+func (x *VerifyCredentialRequest) Reset() {
+	*x = VerifyCredentialRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[56]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetContainerRegistryAuthRequest) String() string {
+func (x *VerifyCredentialRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContainerRegistryAuthRequest) ProtoMessage() {}
+func (*VerifyCredentialRequest) ProtoMessage() {}
 
-func (x *GetContainerRegistryAuthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[56]
+func (x *VerifyCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4026,58 +5067,57 @@ func (x *GetContainerRegistryAuthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContainerRegistryAuthRequest.ProtoReflect.Descriptor instead.
-func (*GetContainerRegistryAuthRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use VerifyCredentialRequest.ProtoReflect.Descriptor instead.
+func (*VerifyCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetContainerRegistryAuthRequest) GetProvider() string {
+func (x *VerifyCredentialRequest) GetAccount() string {
 	if x != nil {
-		return x.Provider
+		return x.Account
 	}
 	return ""
 }
 
-func (x *GetContainerRegistryAuthRequest) GetRegion() string {
+func (x *VerifyCredentialRequest) GetType() string {
 	if x != nil {
-		return x.Region
+		return x.Type
 	}
 	return ""
 }
 
-func (x *GetContainerRegistryAuthRequest) GetAccountName() string {
+func (x *VerifyCredentialRequest) GetStage() string {
 	if x != nil {
-		return x.AccountName
+		return x.Stage
 	}
 	return ""
 }
 
-type GetContainerRegistryAuthResponse struct {
+type VerifyCredentialResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Url   string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Identity *CredentialIdentity `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
 }
 
-func (x *GetContainerRegistryAuthResponse) Reset() {
-	*x = GetContainerRegistryAuthResponse{}
+func (x *VerifyCredentialResponse) Reset() {
+	*x = VerifyCredentialResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[57]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetContainerRegistryAuthResponse) String() string {
+func (x *VerifyCredentialResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContainerRegistryAuthResponse) ProtoMessage() {}
+func (*VerifyCredentialResponse) ProtoMessage() {}
 
-func (x *GetContainerRegistryAuthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[57]
+func (x *VerifyCredentialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4088,51 +5128,44 @@ func (x *GetContainerRegistryAuthResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContainerRegistryAuthResponse.ProtoReflect.Descriptor instead.
-func (*GetContainerRegistryAuthResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{57}
-}
-
-func (x *GetContainerRegistryAuthResponse) GetUrl() string {
-	if x != nil {
-		return x.Url
-	}
-	return ""
+// Deprecated: Use VerifyCredentialResponse.ProtoReflect.Descriptor instead.
+func (*VerifyCredentialResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *GetContainerRegistryAuthResponse) GetToken() string {
+func (x *VerifyCredentialResponse) GetIdentity() *CredentialIdentity {
 	if x != nil {
-		return x.Token
+		return x.Identity
 	}
-	return ""
+	return nil
 }
 
-type CreateContainerRegistryRepoResponse struct {
+type ListCredentialVersionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RegistryId string `protobuf:"bytes,1,opt,name=registryId,proto3" json:"registryId,omitempty"`
-	Url        string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Type    string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
 }
 
-func (x *CreateContainerRegistryRepoResponse) Reset() {
-	*x = CreateContainerRegistryRepoResponse{}
+func (x *ListCredentialVersionsRequest) Reset() {
+	*x = ListCredentialVersionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[58]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateContainerRegistryRepoResponse) String() string {
+func (x *ListCredentialVersionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateContainerRegistryRepoResponse) ProtoMessage() {}
+func (*ListCredentialVersionsRequest) ProtoMessage() {}
 
-func (x *CreateContainerRegistryRepoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[58]
+func (x *ListCredentialVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4143,54 +5176,50 @@ func (x *CreateContainerRegistryRepoResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateContainerRegistryRepoResponse.ProtoReflect.Descriptor instead.
-func (*CreateContainerRegistryRepoResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use ListCredentialVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCredentialVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *CreateContainerRegistryRepoResponse) GetRegistryId() string {
+func (x *ListCredentialVersionsRequest) GetAccount() string {
 	if x != nil {
-		return x.RegistryId
+		return x.Account
 	}
 	return ""
 }
 
-func (x *CreateContainerRegistryRepoResponse) GetUrl() string {
+func (x *ListCredentialVersionsRequest) GetType() string {
 	if x != nil {
-		return x.Url
+		return x.Type
 	}
 	return ""
 }
 
-type CreateContainerRegistryRepoRequest struct {
+type ListCredentialVersionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider    string            `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Region      string            `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
-	AccountName string            `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	Name        string            `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Tags        map[string]string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Versions []*CredentialVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
 }
 
-func (x *CreateContainerRegistryRepoRequest) Reset() {
-	*x = CreateContainerRegistryRepoRequest{}
+func (x *ListCredentialVersionsResponse) Reset() {
+	*x = ListCredentialVersionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[59]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateContainerRegistryRepoRequest) String() string {
+func (x *ListCredentialVersionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateContainerRegistryRepoRequest) ProtoMessage() {}
+func (*ListCredentialVersionsResponse) ProtoMessage() {}
 
-func (x *CreateContainerRegistryRepoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[59]
+func (x *ListCredentialVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4201,74 +5230,123 @@ func (x *CreateContainerRegistryRepoRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateContainerRegistryRepoRequest.ProtoReflect.Descriptor instead.
-func (*CreateContainerRegistryRepoRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use ListCredentialVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCredentialVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *CreateContainerRegistryRepoRequest) GetProvider() string {
+func (x *ListCredentialVersionsResponse) GetVersions() []*CredentialVersion {
 	if x != nil {
-		return x.Provider
+		return x.Versions
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateContainerRegistryRepoRequest) GetRegion() string {
-	if x != nil {
-		return x.Region
-	}
-	return ""
+type GetKubeConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	RawToken    bool   `protobuf:"varint,5,opt,name=rawToken,proto3" json:"rawToken,omitempty"`
 }
 
-func (x *CreateContainerRegistryRepoRequest) GetAccountName() string {
+func (x *GetKubeConfigRequest) Reset() {
+	*x = GetKubeConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetKubeConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKubeConfigRequest) ProtoMessage() {}
+
+func (x *GetKubeConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKubeConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetKubeConfigRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetKubeConfigRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *GetKubeConfigRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *GetKubeConfigRequest) GetAccountName() string {
 	if x != nil {
 		return x.AccountName
 	}
 	return ""
 }
 
-func (x *CreateContainerRegistryRepoRequest) GetName() string {
+func (x *GetKubeConfigRequest) GetClusterName() string {
 	if x != nil {
-		return x.Name
+		return x.ClusterName
 	}
 	return ""
 }
 
-func (x *CreateContainerRegistryRepoRequest) GetTags() map[string]string {
+func (x *GetKubeConfigRequest) GetRawToken() bool {
 	if x != nil {
-		return x.Tags
+		return x.RawToken
 	}
-	return nil
+	return false
 }
 
-type DeleteSnapshotRequest struct {
+type GetKubeConfigResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
-	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	SnapshotId  string `protobuf:"bytes,4,opt,name=snapshotId,proto3" json:"snapshotId,omitempty"`
+	ClusterName string `protobuf:"bytes,1,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	Config      []byte `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *DeleteSnapshotRequest) Reset() {
-	*x = DeleteSnapshotRequest{}
+func (x *GetKubeConfigResponse) Reset() {
+	*x = GetKubeConfigResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[60]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteSnapshotRequest) String() string {
+func (x *GetKubeConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSnapshotRequest) ProtoMessage() {}
+func (*GetKubeConfigResponse) ProtoMessage() {}
 
-func (x *DeleteSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[60]
+func (x *GetKubeConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4279,62 +5357,48 @@ func (x *DeleteSnapshotRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSnapshotRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{60}
-}
-
-func (x *DeleteSnapshotRequest) GetProvider() string {
-	if x != nil {
-		return x.Provider
-	}
-	return ""
-}
-
-func (x *DeleteSnapshotRequest) GetRegion() string {
-	if x != nil {
-		return x.Region
-	}
-	return ""
+// Deprecated: Use GetKubeConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetKubeConfigResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *DeleteSnapshotRequest) GetAccountName() string {
+func (x *GetKubeConfigResponse) GetClusterName() string {
 	if x != nil {
-		return x.AccountName
+		return x.ClusterName
 	}
 	return ""
 }
 
-func (x *DeleteSnapshotRequest) GetSnapshotId() string {
+func (x *GetKubeConfigResponse) GetConfig() []byte {
 	if x != nil {
-		return x.SnapshotId
+		return x.Config
 	}
-	return ""
+	return nil
 }
 
-type DeleteSnapshotResponse struct {
+type TagNodeInstanceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DeleteSnapshotResponse) Reset() {
-	*x = DeleteSnapshotResponse{}
+func (x *TagNodeInstanceResponse) Reset() {
+	*x = TagNodeInstanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[61]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteSnapshotResponse) String() string {
+func (x *TagNodeInstanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSnapshotResponse) ProtoMessage() {}
+func (*TagNodeInstanceResponse) ProtoMessage() {}
 
-func (x *DeleteSnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[61]
+func (x *TagNodeInstanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4345,39 +5409,41 @@ func (x *DeleteSnapshotResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSnapshotResponse.ProtoReflect.Descriptor instead.
-func (*DeleteSnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use TagNodeInstanceResponse.ProtoReflect.Descriptor instead.
+func (*TagNodeInstanceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{71}
 }
 
-type RegisterClusterOIDCRequest struct {
+type TagNodeInstanceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
-	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	Provider    string            `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string            `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string            `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName string            `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	NodeGroup   string            `protobuf:"bytes,5,opt,name=nodeGroup,proto3" json:"nodeGroup,omitempty"`
+	Labels      map[string]string `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *RegisterClusterOIDCRequest) Reset() {
-	*x = RegisterClusterOIDCRequest{}
+func (x *TagNodeInstanceRequest) Reset() {
+	*x = TagNodeInstanceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[62]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegisterClusterOIDCRequest) String() string {
+func (x *TagNodeInstanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterClusterOIDCRequest) ProtoMessage() {}
+func (*TagNodeInstanceRequest) ProtoMessage() {}
 
-func (x *RegisterClusterOIDCRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[62]
+func (x *TagNodeInstanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4388,106 +5454,84 @@ func (x *RegisterClusterOIDCRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterClusterOIDCRequest.ProtoReflect.Descriptor instead.
-func (*RegisterClusterOIDCRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use TagNodeInstanceRequest.ProtoReflect.Descriptor instead.
+func (*TagNodeInstanceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *RegisterClusterOIDCRequest) GetProvider() string {
+func (x *TagNodeInstanceRequest) GetProvider() string {
 	if x != nil {
 		return x.Provider
 	}
 	return ""
 }
 
-func (x *RegisterClusterOIDCRequest) GetRegion() string {
+func (x *TagNodeInstanceRequest) GetRegion() string {
 	if x != nil {
 		return x.Region
 	}
 	return ""
 }
 
-func (x *RegisterClusterOIDCRequest) GetAccountName() string {
+func (x *TagNodeInstanceRequest) GetAccountName() string {
 	if x != nil {
 		return x.AccountName
 	}
 	return ""
 }
 
-func (x *RegisterClusterOIDCRequest) GetClusterName() string {
+func (x *TagNodeInstanceRequest) GetClusterName() string {
 	if x != nil {
 		return x.ClusterName
 	}
 	return ""
 }
 
-type RegisterClusterOIDCResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *RegisterClusterOIDCResponse) Reset() {
-	*x = RegisterClusterOIDCResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[63]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TagNodeInstanceRequest) GetNodeGroup() string {
+	if x != nil {
+		return x.NodeGroup
 	}
+	return ""
 }
 
-func (x *RegisterClusterOIDCResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RegisterClusterOIDCResponse) ProtoMessage() {}
-
-func (x *RegisterClusterOIDCResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[63]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TagNodeInstanceRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RegisterClusterOIDCResponse.ProtoReflect.Descriptor instead.
-func (*RegisterClusterOIDCResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{63}
+	return nil
 }
 
-type Route53ResourceRecordSet struct {
+type GetCostByTimeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// provide fqdn
-	Name            string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type            string                   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	TtlInSeconds    int64                    `protobuf:"varint,3,opt,name=ttlInSeconds,proto3" json:"ttlInSeconds,omitempty"`
-	ResourceRecords []*Route53ResourceRecord `protobuf:"bytes,4,rep,name=resourceRecords,proto3" json:"resourceRecords,omitempty"`
+	Provider    string   `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Ids         []string `protobuf:"bytes,2,rep,name=Ids,proto3" json:"Ids,omitempty"`
+	AccountName string   `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	StartDate   string   `protobuf:"bytes,4,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate     string   `protobuf:"bytes,5,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	Granularity string   `protobuf:"bytes,6,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	GroupBy     *GroupBy `protobuf:"bytes,7,opt,name=groupBy,proto3" json:"groupBy,omitempty"`
 }
 
-func (x *Route53ResourceRecordSet) Reset() {
-	*x = Route53ResourceRecordSet{}
+func (x *GetCostByTimeRequest) Reset() {
+	*x = GetCostByTimeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[64]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Route53ResourceRecordSet) String() string {
+func (x *GetCostByTimeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Route53ResourceRecordSet) ProtoMessage() {}
+func (*GetCostByTimeRequest) ProtoMessage() {}
 
-func (x *Route53ResourceRecordSet) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[64]
+func (x *GetCostByTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4498,64 +5542,85 @@ func (x *Route53ResourceRecordSet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Route53ResourceRecordSet.ProtoReflect.Descriptor instead.
-func (*Route53ResourceRecordSet) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use GetCostByTimeRequest.ProtoReflect.Descriptor instead.
+func (*GetCostByTimeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *Route53ResourceRecordSet) GetName() string {
+func (x *GetCostByTimeRequest) GetProvider() string {
 	if x != nil {
-		return x.Name
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *Route53ResourceRecordSet) GetType() string {
+func (x *GetCostByTimeRequest) GetIds() []string {
 	if x != nil {
-		return x.Type
+		return x.Ids
 	}
-	return ""
+	return nil
 }
 
-func (x *Route53ResourceRecordSet) GetTtlInSeconds() int64 {
+func (x *GetCostByTimeRequest) GetAccountName() string {
 	if x != nil {
-		return x.TtlInSeconds
+		return x.AccountName
 	}
-	return 0
+	return ""
 }
 
-func (x *Route53ResourceRecordSet) GetResourceRecords() []*Route53ResourceRecord {
+func (x *GetCostByTimeRequest) GetStartDate() string {
 	if x != nil {
-		return x.ResourceRecords
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetCostByTimeRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetCostByTimeRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *GetCostByTimeRequest) GetGroupBy() *GroupBy {
+	if x != nil {
+		return x.GroupBy
 	}
 	return nil
 }
 
-type Route53ResourceRecord struct {
+type GetCostByTimeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	GroupedCost map[string]*CostMap `protobuf:"bytes,1,rep,name=groupedCost,proto3" json:"groupedCost,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *Route53ResourceRecord) Reset() {
-	*x = Route53ResourceRecord{}
+func (x *GetCostByTimeResponse) Reset() {
+	*x = GetCostByTimeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[65]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Route53ResourceRecord) String() string {
+func (x *GetCostByTimeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Route53ResourceRecord) ProtoMessage() {}
+func (*GetCostByTimeResponse) ProtoMessage() {}
 
-func (x *Route53ResourceRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[65]
+func (x *GetCostByTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4566,43 +5631,43 @@ func (x *Route53ResourceRecord) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Route53ResourceRecord.ProtoReflect.Descriptor instead.
-func (*Route53ResourceRecord) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use GetCostByTimeResponse.ProtoReflect.Descriptor instead.
+func (*GetCostByTimeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *Route53ResourceRecord) GetValue() string {
+func (x *GetCostByTimeResponse) GetGroupedCost() map[string]*CostMap {
 	if x != nil {
-		return x.Value
+		return x.GroupedCost
 	}
-	return ""
+	return nil
 }
 
-type CreateRoute53RecordsRequest struct {
+type CostMap struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Records []*Route53ResourceRecordSet `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	Cost map[string]int64 `protobuf:"bytes,1,rep,name=cost,proto3" json:"cost,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
-func (x *CreateRoute53RecordsRequest) Reset() {
-	*x = CreateRoute53RecordsRequest{}
+func (x *CostMap) Reset() {
+	*x = CostMap{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[66]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateRoute53RecordsRequest) String() string {
+func (x *CostMap) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRoute53RecordsRequest) ProtoMessage() {}
+func (*CostMap) ProtoMessage() {}
 
-func (x *CreateRoute53RecordsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[66]
+func (x *CostMap) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4613,41 +5678,45 @@ func (x *CreateRoute53RecordsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRoute53RecordsRequest.ProtoReflect.Descriptor instead.
-func (*CreateRoute53RecordsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use CostMap.ProtoReflect.Descriptor instead.
+func (*CostMap) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *CreateRoute53RecordsRequest) GetRecords() []*Route53ResourceRecordSet {
+func (x *CostMap) GetCost() map[string]int64 {
 	if x != nil {
-		return x.Records
+		return x.Cost
 	}
 	return nil
 }
 
-type CreateRoute53RecordsResponse struct {
+type GetContainerRegistryAuthRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
 }
 
-func (x *CreateRoute53RecordsResponse) Reset() {
-	*x = CreateRoute53RecordsResponse{}
+func (x *GetContainerRegistryAuthRequest) Reset() {
+	*x = GetContainerRegistryAuthRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[67]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateRoute53RecordsResponse) String() string {
+func (x *GetContainerRegistryAuthRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRoute53RecordsResponse) ProtoMessage() {}
+func (*GetContainerRegistryAuthRequest) ProtoMessage() {}
 
-func (x *CreateRoute53RecordsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[67]
+func (x *GetContainerRegistryAuthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4658,74 +5727,58 @@ func (x *CreateRoute53RecordsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRoute53RecordsResponse.ProtoReflect.Descriptor instead.
-func (*CreateRoute53RecordsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{67}
-}
-
-type GetRoute53TXTRecordsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// Deprecated: Use GetContainerRegistryAuthRequest.ProtoReflect.Descriptor instead.
+func (*GetContainerRegistryAuthRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *GetRoute53TXTRecordsRequest) Reset() {
-	*x = GetRoute53TXTRecordsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[68]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetContainerRegistryAuthRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
 	}
+	return ""
 }
 
-func (x *GetRoute53TXTRecordsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetRoute53TXTRecordsRequest) ProtoMessage() {}
-
-func (x *GetRoute53TXTRecordsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[68]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetContainerRegistryAuthRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetRoute53TXTRecordsRequest.ProtoReflect.Descriptor instead.
-func (*GetRoute53TXTRecordsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{68}
+func (x *GetContainerRegistryAuthRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
 }
 
-type GetRoute53TXTRecordsResponse struct {
+type GetContainerRegistryAuthResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Records []*Route53ResourceRecordSet `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	Url   string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *GetRoute53TXTRecordsResponse) Reset() {
-	*x = GetRoute53TXTRecordsResponse{}
+func (x *GetContainerRegistryAuthResponse) Reset() {
+	*x = GetContainerRegistryAuthResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[69]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetRoute53TXTRecordsResponse) String() string {
+func (x *GetContainerRegistryAuthResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoute53TXTRecordsResponse) ProtoMessage() {}
+func (*GetContainerRegistryAuthResponse) ProtoMessage() {}
 
-func (x *GetRoute53TXTRecordsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[69]
+func (x *GetContainerRegistryAuthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4736,43 +5789,51 @@ func (x *GetRoute53TXTRecordsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoute53TXTRecordsResponse.ProtoReflect.Descriptor instead.
-func (*GetRoute53TXTRecordsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use GetContainerRegistryAuthResponse.ProtoReflect.Descriptor instead.
+func (*GetContainerRegistryAuthResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *GetRoute53TXTRecordsResponse) GetRecords() []*Route53ResourceRecordSet {
+func (x *GetContainerRegistryAuthResponse) GetUrl() string {
 	if x != nil {
-		return x.Records
+		return x.Url
 	}
-	return nil
+	return ""
 }
 
-type DeleteRoute53RecordsRequest struct {
+func (x *GetContainerRegistryAuthResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type CreateContainerRegistryRepoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Records []*Route53ResourceRecordSet `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	RegistryId string `protobuf:"bytes,1,opt,name=registryId,proto3" json:"registryId,omitempty"`
+	Url        string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
 }
 
-func (x *DeleteRoute53RecordsRequest) Reset() {
-	*x = DeleteRoute53RecordsRequest{}
+func (x *CreateContainerRegistryRepoResponse) Reset() {
+	*x = CreateContainerRegistryRepoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[70]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteRoute53RecordsRequest) String() string {
+func (x *CreateContainerRegistryRepoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRoute53RecordsRequest) ProtoMessage() {}
+func (*CreateContainerRegistryRepoResponse) ProtoMessage() {}
 
-func (x *DeleteRoute53RecordsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[70]
+func (x *CreateContainerRegistryRepoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4783,41 +5844,56 @@ func (x *DeleteRoute53RecordsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRoute53RecordsRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRoute53RecordsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use CreateContainerRegistryRepoResponse.ProtoReflect.Descriptor instead.
+func (*CreateContainerRegistryRepoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *DeleteRoute53RecordsRequest) GetRecords() []*Route53ResourceRecordSet {
+func (x *CreateContainerRegistryRepoResponse) GetRegistryId() string {
 	if x != nil {
-		return x.Records
+		return x.RegistryId
 	}
-	return nil
+	return ""
 }
 
-type DeleteRoute53RecordsResponse struct {
+func (x *CreateContainerRegistryRepoResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type CreateContainerRegistryRepoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Provider    string            `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string            `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string            `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	Name        string            `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Tags        map[string]string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// repeated calls with the same key return the result of the first call
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
 }
 
-func (x *DeleteRoute53RecordsResponse) Reset() {
-	*x = DeleteRoute53RecordsResponse{}
+func (x *CreateContainerRegistryRepoRequest) Reset() {
+	*x = CreateContainerRegistryRepoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[71]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteRoute53RecordsResponse) String() string {
+func (x *CreateContainerRegistryRepoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRoute53RecordsResponse) ProtoMessage() {}
+func (*CreateContainerRegistryRepoRequest) ProtoMessage() {}
 
-func (x *DeleteRoute53RecordsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[71]
+func (x *CreateContainerRegistryRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4828,41 +5904,81 @@ func (x *DeleteRoute53RecordsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRoute53RecordsResponse.ProtoReflect.Descriptor instead.
-func (*DeleteRoute53RecordsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use CreateContainerRegistryRepoRequest.ProtoReflect.Descriptor instead.
+func (*CreateContainerRegistryRepoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{79}
 }
 
-type CopySnapshotRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Provider    string            `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Region      string            `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
-	AccountName string            `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	SnapshotId  string            `protobuf:"bytes,4,opt,name=snapshotId,proto3" json:"snapshotId,omitempty"`
-	SnapshotUri string            `protobuf:"bytes,5,opt,name=snapshotUri,proto3" json:"snapshotUri,omitempty"`
-	Labels      map[string]string `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+func (x *CreateContainerRegistryRepoRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
 }
 
-func (x *CopySnapshotRequest) Reset() {
-	*x = CopySnapshotRequest{}
+func (x *CreateContainerRegistryRepoRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *CreateContainerRegistryRepoRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *CreateContainerRegistryRepoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateContainerRegistryRepoRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateContainerRegistryRepoRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type DeleteSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	SnapshotId  string `protobuf:"bytes,4,opt,name=snapshotId,proto3" json:"snapshotId,omitempty"`
+}
+
+func (x *DeleteSnapshotRequest) Reset() {
+	*x = DeleteSnapshotRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[72]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CopySnapshotRequest) String() string {
+func (x *DeleteSnapshotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CopySnapshotRequest) ProtoMessage() {}
+func (*DeleteSnapshotRequest) ProtoMessage() {}
 
-func (x *CopySnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[72]
+func (x *DeleteSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4873,79 +5989,62 @@ func (x *CopySnapshotRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CopySnapshotRequest.ProtoReflect.Descriptor instead.
-func (*CopySnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use DeleteSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *CopySnapshotRequest) GetProvider() string {
+func (x *DeleteSnapshotRequest) GetProvider() string {
 	if x != nil {
 		return x.Provider
 	}
 	return ""
 }
 
-func (x *CopySnapshotRequest) GetRegion() string {
+func (x *DeleteSnapshotRequest) GetRegion() string {
 	if x != nil {
 		return x.Region
 	}
 	return ""
 }
 
-func (x *CopySnapshotRequest) GetAccountName() string {
+func (x *DeleteSnapshotRequest) GetAccountName() string {
 	if x != nil {
 		return x.AccountName
 	}
 	return ""
 }
 
-func (x *CopySnapshotRequest) GetSnapshotId() string {
+func (x *DeleteSnapshotRequest) GetSnapshotId() string {
 	if x != nil {
 		return x.SnapshotId
 	}
 	return ""
 }
 
-func (x *CopySnapshotRequest) GetSnapshotUri() string {
-	if x != nil {
-		return x.SnapshotUri
-	}
-	return ""
-}
-
-func (x *CopySnapshotRequest) GetLabels() map[string]string {
-	if x != nil {
-		return x.Labels
-	}
-	return nil
-}
-
-type CopySnapshotResponse struct {
+type DeleteSnapshotResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	NewSnapshotId  string `protobuf:"bytes,1,opt,name=newSnapshotId,proto3" json:"newSnapshotId,omitempty"`
-	NewSnapshotUri string `protobuf:"bytes,2,opt,name=newSnapshotUri,proto3" json:"newSnapshotUri,omitempty"`
 }
 
-func (x *CopySnapshotResponse) Reset() {
-	*x = CopySnapshotResponse{}
+func (x *DeleteSnapshotResponse) Reset() {
+	*x = DeleteSnapshotResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[73]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CopySnapshotResponse) String() string {
+func (x *DeleteSnapshotResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CopySnapshotResponse) ProtoMessage() {}
+func (*DeleteSnapshotResponse) ProtoMessage() {}
 
-func (x *CopySnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[73]
+func (x *DeleteSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4956,54 +6055,39 @@ func (x *CopySnapshotResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CopySnapshotResponse.ProtoReflect.Descriptor instead.
-func (*CopySnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{73}
-}
-
-func (x *CopySnapshotResponse) GetNewSnapshotId() string {
-	if x != nil {
-		return x.NewSnapshotId
-	}
-	return ""
-}
-
-func (x *CopySnapshotResponse) GetNewSnapshotUri() string {
-	if x != nil {
-		return x.NewSnapshotUri
-	}
-	return ""
+// Deprecated: Use DeleteSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{81}
 }
 
-type PresignS3UrlRequest struct {
+type RegisterClusterOIDCRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Region          string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
-	AccountName     string `protobuf:"bytes,2,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	Bucket          string `protobuf:"bytes,3,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	File            string `protobuf:"bytes,4,opt,name=file,proto3" json:"file,omitempty"`
-	TimeoutInMinute int32  `protobuf:"varint,5,opt,name=timeoutInMinute,proto3" json:"timeoutInMinute,omitempty"`
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
 }
 
-func (x *PresignS3UrlRequest) Reset() {
-	*x = PresignS3UrlRequest{}
+func (x *RegisterClusterOIDCRequest) Reset() {
+	*x = RegisterClusterOIDCRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[74]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PresignS3UrlRequest) String() string {
+func (x *RegisterClusterOIDCRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PresignS3UrlRequest) ProtoMessage() {}
+func (*RegisterClusterOIDCRequest) ProtoMessage() {}
 
-func (x *PresignS3UrlRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[74]
+func (x *RegisterClusterOIDCRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5014,71 +6098,106 @@ func (x *PresignS3UrlRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PresignS3UrlRequest.ProtoReflect.Descriptor instead.
-func (*PresignS3UrlRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use RegisterClusterOIDCRequest.ProtoReflect.Descriptor instead.
+func (*RegisterClusterOIDCRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *PresignS3UrlRequest) GetRegion() string {
+func (x *RegisterClusterOIDCRequest) GetProvider() string {
 	if x != nil {
-		return x.Region
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *PresignS3UrlRequest) GetAccountName() string {
+func (x *RegisterClusterOIDCRequest) GetRegion() string {
 	if x != nil {
-		return x.AccountName
+		return x.Region
 	}
 	return ""
 }
 
-func (x *PresignS3UrlRequest) GetBucket() string {
+func (x *RegisterClusterOIDCRequest) GetAccountName() string {
 	if x != nil {
-		return x.Bucket
+		return x.AccountName
 	}
 	return ""
 }
 
-func (x *PresignS3UrlRequest) GetFile() string {
+func (x *RegisterClusterOIDCRequest) GetClusterName() string {
 	if x != nil {
-		return x.File
+		return x.ClusterName
 	}
 	return ""
 }
 
-func (x *PresignS3UrlRequest) GetTimeoutInMinute() int32 {
-	if x != nil {
-		return x.TimeoutInMinute
+type RegisterClusterOIDCResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RegisterClusterOIDCResponse) Reset() {
+	*x = RegisterClusterOIDCResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-type PresignS3UrlResponse struct {
+func (x *RegisterClusterOIDCResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterClusterOIDCResponse) ProtoMessage() {}
+
+func (x *RegisterClusterOIDCResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterClusterOIDCResponse.ProtoReflect.Descriptor instead.
+func (*RegisterClusterOIDCResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{83}
+}
+
+type Route53ResourceRecordSet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SignedUrl string `protobuf:"bytes,1,opt,name=signedUrl,proto3" json:"signedUrl,omitempty"`
+	// provide fqdn
+	Name            string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type            string                   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	TtlInSeconds    int64                    `protobuf:"varint,3,opt,name=ttlInSeconds,proto3" json:"ttlInSeconds,omitempty"`
+	ResourceRecords []*Route53ResourceRecord `protobuf:"bytes,4,rep,name=resourceRecords,proto3" json:"resourceRecords,omitempty"`
 }
 
-func (x *PresignS3UrlResponse) Reset() {
-	*x = PresignS3UrlResponse{}
+func (x *Route53ResourceRecordSet) Reset() {
+	*x = Route53ResourceRecordSet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[75]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PresignS3UrlResponse) String() string {
+func (x *Route53ResourceRecordSet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PresignS3UrlResponse) ProtoMessage() {}
+func (*Route53ResourceRecordSet) ProtoMessage() {}
 
-func (x *PresignS3UrlResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[75]
+func (x *Route53ResourceRecordSet) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5089,1102 +6208,3725 @@ func (x *PresignS3UrlResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PresignS3UrlResponse.ProtoReflect.Descriptor instead.
-func (*PresignS3UrlResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use Route53ResourceRecordSet.ProtoReflect.Descriptor instead.
+func (*Route53ResourceRecordSet) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *PresignS3UrlResponse) GetSignedUrl() string {
+func (x *Route53ResourceRecordSet) GetName() string {
 	if x != nil {
-		return x.SignedUrl
+		return x.Name
 	}
 	return ""
 }
 
-var File_proto_netbookai_spawner_spawner_proto protoreflect.FileDescriptor
+func (x *Route53ResourceRecordSet) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
 
-var file_proto_netbookai_spawner_spawner_proto_rawDesc = []byte{
-	0x0a, 0x25, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6e, 0x65, 0x74, 0x62, 0x6f, 0x6f, 0x6b, 0x61,
-	0x69, 0x2f, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2f, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
-	0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1f, 0x0a, 0x0b, 0x45, 0x63, 0x68,
-	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x20, 0x0a, 0x0c, 0x45, 0x63,
-	0x68, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
-	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x88, 0x05, 0x0a,
-	0x08, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73,
-	0x6b, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x69, 0x73,
-	0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69,
-	0x70, 0x41, 0x64, 0x64, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x70, 0x41,
-	0x64, 0x64, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c,
-	0x69, 0x74, 0x79, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x7a, 0x6f, 0x6e, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x35, 0x0a,
-	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63,
-	0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61,
-	0x62, 0x65, 0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x70, 0x75, 0x5f, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x67, 0x70, 0x75, 0x45, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
-	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x33,
-	0x0a, 0x0a, 0x6d, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x49, 0x47,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x0a, 0x6d, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0c, 0x63, 0x61, 0x70,
-	0x61, 0x63, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x15, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69,
-	0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x70, 0x6f, 0x74, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x70, 0x6f,
-	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x1a, 0x39, 0x0a, 0x0b,
-	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5f, 0x0a, 0x05, 0x49, 0x73, 0x73, 0x75, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x73, 0x22, 0x2e, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x12, 0x24, 0x0a, 0x05, 0x69, 0x73, 0x73, 0x75, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x49, 0x73, 0x73, 0x75,
-	0x65, 0x52, 0x05, 0x69, 0x73, 0x73, 0x75, 0x65, 0x22, 0xa7, 0x02, 0x0a, 0x0e, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12,
-	0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
-	0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x6c, 0x61,
-	0x62, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x70, 0x61,
-	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x8b, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0x6a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x6e, 0x0a, 0x0b,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2d, 0x0a,
-	0x08, 0x6e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70,
-	0x65, 0x63, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63, 0x22, 0x47, 0x0a, 0x13,
-	0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x52, 0x08, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x73, 0x22, 0x6f, 0x0a, 0x0f, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f,
-	0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x8e, 0x01, 0x0a, 0x14, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67,
-	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x45, 0x0a, 0x15, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x67,
-	0x0a, 0x0f, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a,
-	0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
-	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x40, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x89, 0x01, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
-	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x61,
-	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x44,
-	0x61, 0x74, 0x61, 0x22, 0xa9, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x35, 0x33, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67,
-	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x6e, 0x73, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x6e, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22,
-	0x48, 0x0a, 0x18, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xb9, 0x01, 0x0a, 0x10, 0x4e, 0x6f,
-	0x64, 0x65, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
-	0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69,
-	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x53, 0x70,
-	0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
-	0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x08, 0x6e, 0x6f, 0x64,
-	0x65, 0x53, 0x70, 0x65, 0x63, 0x22, 0x29, 0x0a, 0x11, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x61,
-	0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x22, 0xb0, 0x01, 0x0a, 0x14, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a,
-	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x22, 0x2d, 0x0a, 0x15, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0xb1, 0x01, 0x0a, 0x11, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2a, 0x0a, 0x12, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0xb2, 0x03, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20,
-	0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x2a, 0x0a, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
-	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x69, 0x64, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x69, 0x64,
-	0x12, 0x40, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x28, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c,
-	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55, 0x72,
-	0x69, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x55, 0x72, 0x69, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x1a, 0x39, 0x0a, 0x0b,
-	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6b, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x72,
-	0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x55, 0x72, 0x69, 0x22, 0x87, 0x01, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
-	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x69, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x69, 0x64, 0x22, 0x46,
-	0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x88, 0x02, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
-	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
-	0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x69, 0x64, 0x12, 0x42, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0x5a, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55, 0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55, 0x72, 0x69, 0x22, 0x9a, 0x02,
-	0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x41, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
-	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
-	0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x69, 0x64, 0x12, 0x4b, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x33, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x6e, 0x64, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65,
-	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a,
-	0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7d, 0x0a, 0x1f, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x6e, 0x64, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a,
-	0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x69, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x55, 0x72, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55, 0x72, 0x69, 0x22, 0x3e, 0x0a, 0x1a, 0x52, 0x61, 0x6e,
-	0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x7f, 0x0a, 0x1b, 0x52, 0x61, 0x6e,
-	0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x6e, 0x69,
-	0x66, 0x65, 0x73, 0x74, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d,
-	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x55, 0x52, 0x4c, 0x22, 0xa2, 0x02, 0x0a, 0x18, 0x47,
-	0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x43, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61,
-	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72,
-	0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x2a, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x42, 0x79, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x3a, 0x02, 0x18, 0x01, 0x22,
-	0xa4, 0x02, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26,
-	0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61,
-	0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a,
-	0x0a, 0x08, 0x63, 0x6f, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x63, 0x6f, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x42, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x70,
-	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x52, 0x07, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x22, 0x2f, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42,
-	0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0xd0, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x57,
-	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43,
-	0x6f, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f,
-	0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
-	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73,
-	0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x1a, 0x3e, 0x0a, 0x10, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd4, 0x01, 0x0a, 0x1b, 0x47,
-	0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e,
-	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73,
-	0x74, 0x1a, 0x3e, 0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0x72, 0x0a, 0x0e, 0x41, 0x77, 0x73, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x61, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x4b, 0x65, 0x79, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xbc, 0x01, 0x0a, 0x10, 0x41, 0x7a, 0x75, 0x72, 0x65, 0x43,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a,
-	0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x24,
-	0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x22, 0x31, 0x0a, 0x19, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x50, 0x65,
-	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x50, 0x0a, 0x0e, 0x47, 0x63, 0x70, 0x43, 0x72,
-	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x65,
-	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0xd1, 0x02, 0x0a, 0x16, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e,
-	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x61, 0x77, 0x73, 0x43, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x77,
-	0x73, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x07,
-	0x61, 0x77, 0x73, 0x43, 0x72, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x09, 0x61, 0x7a, 0x75, 0x72, 0x65,
-	0x43, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x70, 0x61,
-	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x7a, 0x75, 0x72, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x61, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x09, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x43, 0x72,
-	0x65, 0x64, 0x12, 0x3c, 0x0a, 0x06, 0x67, 0x69, 0x74, 0x50, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x67, 0x69, 0x74, 0x50, 0x61, 0x74,
-	0x12, 0x33, 0x0a, 0x07, 0x67, 0x63, 0x70, 0x43, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x63, 0x70, 0x43,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x07, 0x67, 0x63,
-	0x70, 0x43, 0x72, 0x65, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x63, 0x72, 0x65, 0x64, 0x22, 0x2f, 0x0a,
-	0x17, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x65,
-	0x0a, 0x15, 0x52, 0x65, 0x61, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xd1, 0x02, 0x0a, 0x16, 0x52, 0x65, 0x61, 0x64, 0x43, 0x72,
-	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01,
-	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x33,
-	0x0a, 0x07, 0x61, 0x77, 0x73, 0x43, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x77, 0x73, 0x43, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x07, 0x61, 0x77, 0x73, 0x43,
-	0x72, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x09, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x43, 0x72, 0x65, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
-	0x2e, 0x41, 0x7a, 0x75, 0x72, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x73, 0x48, 0x00, 0x52, 0x09, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x43, 0x72, 0x65, 0x64, 0x12, 0x3c,
-	0x0a, 0x06, 0x67, 0x69, 0x74, 0x50, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
-	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x50,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x67, 0x69, 0x74, 0x50, 0x61, 0x74, 0x12, 0x33, 0x0a, 0x07,
-	0x67, 0x63, 0x70, 0x43, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x63, 0x70, 0x43, 0x72, 0x65, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x07, 0x67, 0x63, 0x70, 0x43, 0x72, 0x65,
-	0x64, 0x42, 0x06, 0x0a, 0x04, 0x63, 0x72, 0x65, 0x64, 0x22, 0xaa, 0x01, 0x0a, 0x14, 0x47, 0x65,
-	0x74, 0x4b, 0x75, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16,
-	0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x61,
-	0x77, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x61,
-	0x77, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x51, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4b, 0x75, 0x62,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x19, 0x0a, 0x17, 0x54, 0x61, 0x67,
-	0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xae, 0x02, 0x0a, 0x16, 0x54, 0x61, 0x67, 0x4e, 0x6f, 0x64, 0x65,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67,
-	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x43, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
-	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
-	0x54, 0x61, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61,
-	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xec, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x73,
-	0x74, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x64,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x49, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65,
-	0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c,
-	0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x61,
-	0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x42, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x70, 0x61, 0x77,
-	0x6e, 0x65, 0x72, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x52, 0x07, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x42, 0x79, 0x22, 0xbc, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74,
-	0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51,
-	0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73,
-	0x74, 0x1a, 0x50, 0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
-	0x2e, 0x63, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x72, 0x0a, 0x07, 0x63, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x2e,
-	0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73,
-	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x43,
-	0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x1a, 0x37,
-	0x0a, 0x09, 0x43, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x77, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x41,
-	0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20,
-	0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0x4a, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x57, 0x0a, 0x23,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
-	0x79, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x92, 0x02, 0x0a, 0x22, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
-	0x79, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
-	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x74, 0x61, 0x67,
-	0x73, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8d, 0x01, 0x0a, 0x15, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4f, 0x49, 0x44, 0x43, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
-	0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4f, 0x49,
-	0x44, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x18, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x22, 0x0a, 0x0c, 0x74, 0x74, 0x6c, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x74, 0x6c, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f,
-	0x6e, 0x64, 0x73, 0x12, 0x48, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73,
-	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0f, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x2d, 0x0a,
-	0x15, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x5a, 0x0a, 0x1b,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x07, 0x72,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73,
-	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x74, 0x52,
-	0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x1e, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x54, 0x58, 0x54, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5b, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x35, 0x33, 0x54, 0x58, 0x54, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
-	0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x73, 0x22, 0x5a, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
-	0x22, 0x1e, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35,
-	0x33, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0xaa, 0x02, 0x0a, 0x13, 0x43, 0x6f, 0x70, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e,
-	0x0a, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x20,
-	0x0a, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55, 0x72, 0x69, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55, 0x72, 0x69,
-	0x12, 0x40, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x28, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x53,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c,
-	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x64, 0x0a,
-	0x14, 0x43, 0x6f, 0x70, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x53, 0x6e, 0x61, 0x70,
-	0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
-	0x77, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6e,
-	0x65, 0x77, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55, 0x72, 0x69, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x65, 0x77, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x55, 0x72, 0x69, 0x22, 0xa5, 0x01, 0x0a, 0x13, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x53,
-	0x33, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67,
-	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c,
-	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x4d, 0x69,
-	0x6e, 0x75, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x49, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x22, 0x34, 0x0a, 0x14, 0x50,
-	0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x53, 0x33, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x72, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x72,
-	0x6c, 0x2a, 0x50, 0x0a, 0x0a, 0x4d, 0x49, 0x47, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12,
-	0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
-	0x4d, 0x49, 0x47, 0x31, 0x67, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x49, 0x47, 0x32, 0x67,
-	0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x49, 0x47, 0x33, 0x67, 0x10, 0x03, 0x12, 0x09, 0x0a,
-	0x05, 0x4d, 0x49, 0x47, 0x34, 0x67, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x49, 0x47, 0x37,
-	0x67, 0x10, 0x05, 0x2a, 0x36, 0x0a, 0x0c, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x55, 0x4b, 0x4e, 0x4f, 0x57,
-	0x4e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x4e, 0x44, 0x45, 0x4d, 0x41, 0x4e, 0x44, 0x10,
-	0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x50, 0x4f, 0x54, 0x10, 0x02, 0x32, 0x8e, 0x16, 0x0a, 0x0e,
-	0x53, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2f,
-	0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x0e, 0x2e,
-	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0e, 0x2e,
-	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
-	0x35, 0x0a, 0x04, 0x45, 0x63, 0x68, 0x6f, 0x12, 0x14, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
-	0x72, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
-	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
-	0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x08,
-	0x41, 0x64, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
-	0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x41, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x2e, 0x73, 0x70,
-	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
-	0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
-	0x2e, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
-	0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a,
-	0x0a, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x73, 0x70,
-	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
-	0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x22, 0x00, 0x12,
-	0x4a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1b,
-	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x70,
-	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x07, 0x41,
-	0x64, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
-	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
-	0x53, 0x70, 0x61, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x50, 0x0a, 0x0d, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x50, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x12, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x64,
-	0x65, 0x12, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
-	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1c, 0x2e, 0x73,
-	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x70, 0x61,
-	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x70,
-	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77,
-	0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1e, 0x2e, 0x73,
-	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61,
-	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73,
-	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61,
-	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x53, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x12, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12,
-	0x27, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
-	0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x41, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x57, 0x69, 0x74, 0x68, 0x52, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x73, 0x70,
-	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x24, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x6e, 0x63, 0x68,
-	0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x57,
-	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x21, 0x2e,
-	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x22, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f,
-	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x88, 0x02, 0x01, 0x12, 0x62, 0x0a, 0x13, 0x47, 0x65, 0x74,
-	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x73, 0x74,
-	0x12, 0x23, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
-	0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43,
-	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a,
-	0x0f, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x12, 0x1f, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65,
-	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x57, 0x72, 0x69, 0x74,
-	0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x64, 0x43, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
-	0x72, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
-	0x72, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x47, 0x65,
-	0x74, 0x4b, 0x75, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x2e, 0x73, 0x70,
-	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x70, 0x61,
-	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0f,
-	0x54, 0x61, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
-	0x1f, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x67, 0x4e, 0x6f, 0x64,
-	0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x20, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x67, 0x4e, 0x6f,
-	0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x42,
-	0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x41, 0x75,
-	0x74, 0x68, 0x12, 0x28, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
-	0x79, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73,
-	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x41, 0x75, 0x74, 0x68, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7a, 0x0a, 0x1b, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x2b, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
-	0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4f, 0x49, 0x44, 0x43, 0x12, 0x23, 0x2e, 0x73,
-	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4f, 0x49, 0x44, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x24, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4f, 0x49, 0x44, 0x43, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x14, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x73, 0x12, 0x24, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
-	0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x65, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x54, 0x58,
-	0x54, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x24, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
-	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x54, 0x58, 0x54,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
-	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x35, 0x33, 0x54, 0x58, 0x54, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12,
-	0x24, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d,
-	0x0a, 0x0c, 0x43, 0x6f, 0x70, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1c,
-	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x53, 0x6e, 0x61,
-	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73,
-	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a,
-	0x0c, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x53, 0x33, 0x55, 0x72, 0x6c, 0x12, 0x1c, 0x2e,
-	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x53,
-	0x33, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x70,
-	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x53, 0x33, 0x55,
-	0x72, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x0a, 0x5a, 0x08,
-	0x2f, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *Route53ResourceRecordSet) GetTtlInSeconds() int64 {
+	if x != nil {
+		return x.TtlInSeconds
+	}
+	return 0
 }
 
-var (
-	file_proto_netbookai_spawner_spawner_proto_rawDescOnce sync.Once
-	file_proto_netbookai_spawner_spawner_proto_rawDescData = file_proto_netbookai_spawner_spawner_proto_rawDesc
-)
+func (x *Route53ResourceRecordSet) GetResourceRecords() []*Route53ResourceRecord {
+	if x != nil {
+		return x.ResourceRecords
+	}
+	return nil
+}
 
-func file_proto_netbookai_spawner_spawner_proto_rawDescGZIP() []byte {
-	file_proto_netbookai_spawner_spawner_proto_rawDescOnce.Do(func() {
-		file_proto_netbookai_spawner_spawner_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_netbookai_spawner_spawner_proto_rawDescData)
-	})
-	return file_proto_netbookai_spawner_spawner_proto_rawDescData
+type Route53ResourceRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-var file_proto_netbookai_spawner_spawner_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_proto_netbookai_spawner_spawner_proto_msgTypes = make([]protoimpl.MessageInfo, 88)
-var file_proto_netbookai_spawner_spawner_proto_goTypes = []interface{}{
-	(MIGProfile)(0),                             // 0: spawner.MIGProfile
-	(CapacityType)(0),                           // 1: spawner.CapacityType
-	(*Empty)(nil),                               // 2: spawner.Empty
-	(*EchoRequest)(nil),                         // 3: spawner.EchoRequest
-	(*EchoResponse)(nil),                        // 4: spawner.EchoResponse
-	(*NodeSpec)(nil),                            // 5: spawner.NodeSpec
-	(*Issue)(nil),                               // 6: spawner.Issue
-	(*Health)(nil),                              // 7: spawner.Health
-	(*ClusterRequest)(nil),                      // 8: spawner.ClusterRequest
-	(*GetClusterRequest)(nil),                   // 9: spawner.GetClusterRequest
-	(*GetClustersRequest)(nil),                  // 10: spawner.GetClustersRequest
-	(*ClusterSpec)(nil),                         // 11: spawner.ClusterSpec
-	(*GetClustersResponse)(nil),                 // 12: spawner.GetClustersResponse
-	(*ClusterResponse)(nil),                     // 13: spawner.ClusterResponse
-	(*ClusterStatusRequest)(nil),                // 14: spawner.ClusterStatusRequest
-	(*ClusterStatusResponse)(nil),               // 15: spawner.ClusterStatusResponse
-	(*AddTokenRequest)(nil),                     // 16: spawner.AddTokenRequest
-	(*AddTokenResponse)(nil),                    // 17: spawner.AddTokenResponse
-	(*GetTokenRequest)(nil),                     // 18: spawner.GetTokenRequest
-	(*GetTokenResponse)(nil),                    // 19: spawner.GetTokenResponse
-	(*AddRoute53RecordRequest)(nil),             // 20: spawner.AddRoute53RecordRequest
-	(*AddRoute53RecordResponse)(nil),            // 21: spawner.AddRoute53RecordResponse
-	(*NodeSpawnRequest)(nil),                    // 22: spawner.NodeSpawnRequest
-	(*NodeSpawnResponse)(nil),                   // 23: spawner.NodeSpawnResponse
-	(*ClusterDeleteRequest)(nil),                // 24: spawner.ClusterDeleteRequest
-	(*ClusterDeleteResponse)(nil),               // 25: spawner.ClusterDeleteResponse
-	(*NodeDeleteRequest)(nil),                   // 26: spawner.NodeDeleteRequest
-	(*NodeDeleteResponse)(nil),                  // 27: spawner.NodeDeleteResponse
-	(*CreateVolumeRequest)(nil),                 // 28: spawner.CreateVolumeRequest
-	(*CreateVolumeResponse)(nil),                // 29: spawner.CreateVolumeResponse
-	(*DeleteVolumeRequest)(nil),                 // 30: spawner.DeleteVolumeRequest
-	(*DeleteVolumeResponse)(nil),                // 31: spawner.DeleteVolumeResponse
-	(*CreateSnapshotRequest)(nil),               // 32: spawner.CreateSnapshotRequest
-	(*CreateSnapshotResponse)(nil),              // 33: spawner.CreateSnapshotResponse
-	(*CreateSnapshotAndDeleteRequest)(nil),      // 34: spawner.CreateSnapshotAndDeleteRequest
-	(*CreateSnapshotAndDeleteResponse)(nil),     // 35: spawner.CreateSnapshotAndDeleteResponse
-	(*RancherRegistrationRequest)(nil),          // 36: spawner.RancherRegistrationRequest
-	(*RancherRegistrationResponse)(nil),         // 37: spawner.RancherRegistrationResponse
-	(*GetWorkspacesCostRequest)(nil),            // 38: spawner.GetWorkspacesCostRequest
-	(*GetApplicationsCostRequest)(nil),          // 39: spawner.GetApplicationsCostRequest
-	(*GroupBy)(nil),                             // 40: spawner.GroupBy
-	(*GetWorkspacesCostResponse)(nil),           // 41: spawner.GetWorkspacesCostResponse
-	(*GetApplicationsCostResponse)(nil),         // 42: spawner.GetApplicationsCostResponse
-	(*AwsCredentials)(nil),                      // 43: spawner.AwsCredentials
-	(*AzureCredentials)(nil),                    // 44: spawner.AzureCredentials
-	(*GithubPersonalAccessToken)(nil),           // 45: spawner.GithubPersonalAccessToken
-	(*GcpCredentials)(nil),                      // 46: spawner.GcpCredentials
-	(*WriteCredentialRequest)(nil),              // 47: spawner.WriteCredentialRequest
-	(*WriteCredentialResponse)(nil),             // 48: spawner.WriteCredentialResponse
-	(*ReadCredentialRequest)(nil),               // 49: spawner.ReadCredentialRequest
-	(*ReadCredentialResponse)(nil),              // 50: spawner.ReadCredentialResponse
-	(*GetKubeConfigRequest)(nil),                // 51: spawner.GetKubeConfigRequest
-	(*GetKubeConfigResponse)(nil),               // 52: spawner.GetKubeConfigResponse
-	(*TagNodeInstanceResponse)(nil),             // 53: spawner.TagNodeInstanceResponse
-	(*TagNodeInstanceRequest)(nil),              // 54: spawner.TagNodeInstanceRequest
-	(*GetCostByTimeRequest)(nil),                // 55: spawner.GetCostByTimeRequest
-	(*GetCostByTimeResponse)(nil),               // 56: spawner.GetCostByTimeResponse
-	(*CostMap)(nil),                             // 57: spawner.costMap
-	(*GetContainerRegistryAuthRequest)(nil),     // 58: spawner.GetContainerRegistryAuthRequest
-	(*GetContainerRegistryAuthResponse)(nil),    // 59: spawner.GetContainerRegistryAuthResponse
-	(*CreateContainerRegistryRepoResponse)(nil), // 60: spawner.CreateContainerRegistryRepoResponse
-	(*CreateContainerRegistryRepoRequest)(nil),  // 61: spawner.CreateContainerRegistryRepoRequest
-	(*DeleteSnapshotRequest)(nil),               // 62: spawner.DeleteSnapshotRequest
-	(*DeleteSnapshotResponse)(nil),              // 63: spawner.DeleteSnapshotResponse
-	(*RegisterClusterOIDCRequest)(nil),          // 64: spawner.RegisterClusterOIDCRequest
-	(*RegisterClusterOIDCResponse)(nil),         // 65: spawner.RegisterClusterOIDCResponse
-	(*Route53ResourceRecordSet)(nil),            // 66: spawner.Route53ResourceRecordSet
-	(*Route53ResourceRecord)(nil),               // 67: spawner.Route53ResourceRecord
-	(*CreateRoute53RecordsRequest)(nil),         // 68: spawner.CreateRoute53RecordsRequest
-	(*CreateRoute53RecordsResponse)(nil),        // 69: spawner.CreateRoute53RecordsResponse
-	(*GetRoute53TXTRecordsRequest)(nil),         // 70: spawner.GetRoute53TXTRecordsRequest
-	(*GetRoute53TXTRecordsResponse)(nil),        // 71: spawner.GetRoute53TXTRecordsResponse
-	(*DeleteRoute53RecordsRequest)(nil),         // 72: spawner.DeleteRoute53RecordsRequest
-	(*DeleteRoute53RecordsResponse)(nil),        // 73: spawner.DeleteRoute53RecordsResponse
-	(*CopySnapshotRequest)(nil),                 // 74: spawner.CopySnapshotRequest
-	(*CopySnapshotResponse)(nil),                // 75: spawner.CopySnapshotResponse
-	(*PresignS3UrlRequest)(nil),                 // 76: spawner.PresignS3UrlRequest
-	(*PresignS3UrlResponse)(nil),                // 77: spawner.PresignS3UrlResponse
-	nil,                                         // 78: spawner.NodeSpec.LabelsEntry
-	nil,                                         // 79: spawner.ClusterRequest.LabelsEntry
-	nil,                                         // 80: spawner.CreateVolumeRequest.LabelsEntry
-	nil,                                         // 81: spawner.CreateSnapshotRequest.LabelsEntry
-	nil,                                         // 82: spawner.CreateSnapshotAndDeleteRequest.LabelsEntry
-	nil,                                         // 83: spawner.GetWorkspacesCostResponse.GroupedCostEntry
-	nil,                                         // 84: spawner.GetApplicationsCostResponse.GroupedCostEntry
-	nil,                                         // 85: spawner.TagNodeInstanceRequest.LabelsEntry
-	nil,                                         // 86: spawner.GetCostByTimeResponse.GroupedCostEntry
-	nil,                                         // 87: spawner.costMap.CostEntry
-	nil,                                         // 88: spawner.CreateContainerRegistryRepoRequest.TagsEntry
-	nil,                                         // 89: spawner.CopySnapshotRequest.LabelsEntry
+func (x *Route53ResourceRecord) Reset() {
+	*x = Route53ResourceRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_proto_netbookai_spawner_spawner_proto_depIdxs = []int32{
-	78, // 0: spawner.NodeSpec.labels:type_name -> spawner.NodeSpec.LabelsEntry
-	7,  // 1: spawner.NodeSpec.health:type_name -> spawner.Health
-	0,  // 2: spawner.NodeSpec.migProfile:type_name -> spawner.MIGProfile
-	1,  // 3: spawner.NodeSpec.capacityType:type_name -> spawner.CapacityType
-	6,  // 4: spawner.Health.issue:type_name -> spawner.Issue
-	5,  // 5: spawner.ClusterRequest.node:type_name -> spawner.NodeSpec
-	79, // 6: spawner.ClusterRequest.labels:type_name -> spawner.ClusterRequest.LabelsEntry
-	5,  // 7: spawner.ClusterSpec.nodeSpec:type_name -> spawner.NodeSpec
-	11, // 8: spawner.GetClustersResponse.clusters:type_name -> spawner.ClusterSpec
-	5,  // 9: spawner.NodeSpawnRequest.nodeSpec:type_name -> spawner.NodeSpec
-	80, // 10: spawner.CreateVolumeRequest.labels:type_name -> spawner.CreateVolumeRequest.LabelsEntry
-	81, // 11: spawner.CreateSnapshotRequest.labels:type_name -> spawner.CreateSnapshotRequest.LabelsEntry
-	82, // 12: spawner.CreateSnapshotAndDeleteRequest.labels:type_name -> spawner.CreateSnapshotAndDeleteRequest.LabelsEntry
-	40, // 13: spawner.GetWorkspacesCostRequest.groupBy:type_name -> spawner.GroupBy
-	40, // 14: spawner.GetApplicationsCostRequest.groupBy:type_name -> spawner.GroupBy
-	83, // 15: spawner.GetWorkspacesCostResponse.groupedCost:type_name -> spawner.GetWorkspacesCostResponse.GroupedCostEntry
-	84, // 16: spawner.GetApplicationsCostResponse.groupedCost:type_name -> spawner.GetApplicationsCostResponse.GroupedCostEntry
-	43, // 17: spawner.WriteCredentialRequest.awsCred:type_name -> spawner.AwsCredentials
-	44, // 18: spawner.WriteCredentialRequest.azureCred:type_name -> spawner.AzureCredentials
-	45, // 19: spawner.WriteCredentialRequest.gitPat:type_name -> spawner.GithubPersonalAccessToken
-	46, // 20: spawner.WriteCredentialRequest.gcpCred:type_name -> spawner.GcpCredentials
-	43, // 21: spawner.ReadCredentialResponse.awsCred:type_name -> spawner.AwsCredentials
-	44, // 22: spawner.ReadCredentialResponse.azureCred:type_name -> spawner.AzureCredentials
-	45, // 23: spawner.ReadCredentialResponse.gitPat:type_name -> spawner.GithubPersonalAccessToken
-	46, // 24: spawner.ReadCredentialResponse.gcpCred:type_name -> spawner.GcpCredentials
-	85, // 25: spawner.TagNodeInstanceRequest.labels:type_name -> spawner.TagNodeInstanceRequest.LabelsEntry
-	40, // 26: spawner.GetCostByTimeRequest.groupBy:type_name -> spawner.GroupBy
-	86, // 27: spawner.GetCostByTimeResponse.groupedCost:type_name -> spawner.GetCostByTimeResponse.GroupedCostEntry
-	87, // 28: spawner.costMap.cost:type_name -> spawner.costMap.CostEntry
-	88, // 29: spawner.CreateContainerRegistryRepoRequest.tags:type_name -> spawner.CreateContainerRegistryRepoRequest.TagsEntry
-	67, // 30: spawner.Route53ResourceRecordSet.resourceRecords:type_name -> spawner.Route53ResourceRecord
-	66, // 31: spawner.CreateRoute53RecordsRequest.records:type_name -> spawner.Route53ResourceRecordSet
-	66, // 32: spawner.GetRoute53TXTRecordsResponse.records:type_name -> spawner.Route53ResourceRecordSet
-	66, // 33: spawner.DeleteRoute53RecordsRequest.records:type_name -> spawner.Route53ResourceRecordSet
-	89, // 34: spawner.CopySnapshotRequest.labels:type_name -> spawner.CopySnapshotRequest.LabelsEntry
-	57, // 35: spawner.GetCostByTimeResponse.GroupedCostEntry.value:type_name -> spawner.costMap
-	2,  // 36: spawner.SpawnerService.HealthCheck:input_type -> spawner.Empty
-	3,  // 37: spawner.SpawnerService.Echo:input_type -> spawner.EchoRequest
-	8,  // 38: spawner.SpawnerService.CreateCluster:input_type -> spawner.ClusterRequest
-	16, // 39: spawner.SpawnerService.AddToken:input_type -> spawner.AddTokenRequest
-	18, // 40: spawner.SpawnerService.GetToken:input_type -> spawner.GetTokenRequest
-	20, // 41: spawner.SpawnerService.AddRoute53Record:input_type -> spawner.AddRoute53RecordRequest
-	9,  // 42: spawner.SpawnerService.GetCluster:input_type -> spawner.GetClusterRequest
-	10, // 43: spawner.SpawnerService.GetClusters:input_type -> spawner.GetClustersRequest
-	22, // 44: spawner.SpawnerService.AddNode:input_type -> spawner.NodeSpawnRequest
-	14, // 45: spawner.SpawnerService.ClusterStatus:input_type -> spawner.ClusterStatusRequest
-	24, // 46: spawner.SpawnerService.DeleteCluster:input_type -> spawner.ClusterDeleteRequest
-	26, // 47: spawner.SpawnerService.DeleteNode:input_type -> spawner.NodeDeleteRequest
-	28, // 48: spawner.SpawnerService.CreateVolume:input_type -> spawner.CreateVolumeRequest
-	30, // 49: spawner.SpawnerService.DeleteVolume:input_type -> spawner.DeleteVolumeRequest
-	32, // 50: spawner.SpawnerService.CreateSnapshot:input_type -> spawner.CreateSnapshotRequest
-	62, // 51: spawner.SpawnerService.DeleteSnapshot:input_type -> spawner.DeleteSnapshotRequest
-	34, // 52: spawner.SpawnerService.CreateSnapshotAndDelete:input_type -> spawner.CreateSnapshotAndDeleteRequest
-	36, // 53: spawner.SpawnerService.RegisterWithRancher:input_type -> spawner.RancherRegistrationRequest
-	38, // 54: spawner.SpawnerService.GetWorkspacesCost:input_type -> spawner.GetWorkspacesCostRequest
-	39, // 55: spawner.SpawnerService.GetApplicationsCost:input_type -> spawner.GetApplicationsCostRequest
-	47, // 56: spawner.SpawnerService.WriteCredential:input_type -> spawner.WriteCredentialRequest
-	49, // 57: spawner.SpawnerService.ReadCredential:input_type -> spawner.ReadCredentialRequest
-	51, // 58: spawner.SpawnerService.GetKubeConfig:input_type -> spawner.GetKubeConfigRequest
-	54, // 59: spawner.SpawnerService.TagNodeInstance:input_type -> spawner.TagNodeInstanceRequest
-	55, // 60: spawner.SpawnerService.GetCostByTime:input_type -> spawner.GetCostByTimeRequest
-	58, // 61: spawner.SpawnerService.GetContainerRegistryAuth:input_type -> spawner.GetContainerRegistryAuthRequest
-	61, // 62: spawner.SpawnerService.CreateContainerRegistryRepo:input_type -> spawner.CreateContainerRegistryRepoRequest
-	64, // 63: spawner.SpawnerService.RegisterClusterOIDC:input_type -> spawner.RegisterClusterOIDCRequest
-	68, // 64: spawner.SpawnerService.CreateRoute53Records:input_type -> spawner.CreateRoute53RecordsRequest
-	70, // 65: spawner.SpawnerService.GetRoute53TXTRecords:input_type -> spawner.GetRoute53TXTRecordsRequest
-	72, // 66: spawner.SpawnerService.DeleteRoute53Records:input_type -> spawner.DeleteRoute53RecordsRequest
-	74, // 67: spawner.SpawnerService.CopySnapshot:input_type -> spawner.CopySnapshotRequest
-	76, // 68: spawner.SpawnerService.PresignS3Url:input_type -> spawner.PresignS3UrlRequest
-	2,  // 69: spawner.SpawnerService.HealthCheck:output_type -> spawner.Empty
-	4,  // 70: spawner.SpawnerService.Echo:output_type -> spawner.EchoResponse
-	13, // 71: spawner.SpawnerService.CreateCluster:output_type -> spawner.ClusterResponse
-	17, // 72: spawner.SpawnerService.AddToken:output_type -> spawner.AddTokenResponse
-	19, // 73: spawner.SpawnerService.GetToken:output_type -> spawner.GetTokenResponse
-	21, // 74: spawner.SpawnerService.AddRoute53Record:output_type -> spawner.AddRoute53RecordResponse
-	11, // 75: spawner.SpawnerService.GetCluster:output_type -> spawner.ClusterSpec
-	12, // 76: spawner.SpawnerService.GetClusters:output_type -> spawner.GetClustersResponse
-	23, // 77: spawner.SpawnerService.AddNode:output_type -> spawner.NodeSpawnResponse
-	15, // 78: spawner.SpawnerService.ClusterStatus:output_type -> spawner.ClusterStatusResponse
-	25, // 79: spawner.SpawnerService.DeleteCluster:output_type -> spawner.ClusterDeleteResponse
-	27, // 80: spawner.SpawnerService.DeleteNode:output_type -> spawner.NodeDeleteResponse
-	29, // 81: spawner.SpawnerService.CreateVolume:output_type -> spawner.CreateVolumeResponse
-	31, // 82: spawner.SpawnerService.DeleteVolume:output_type -> spawner.DeleteVolumeResponse
-	33, // 83: spawner.SpawnerService.CreateSnapshot:output_type -> spawner.CreateSnapshotResponse
-	63, // 84: spawner.SpawnerService.DeleteSnapshot:output_type -> spawner.DeleteSnapshotResponse
-	35, // 85: spawner.SpawnerService.CreateSnapshotAndDelete:output_type -> spawner.CreateSnapshotAndDeleteResponse
-	37, // 86: spawner.SpawnerService.RegisterWithRancher:output_type -> spawner.RancherRegistrationResponse
-	41, // 87: spawner.SpawnerService.GetWorkspacesCost:output_type -> spawner.GetWorkspacesCostResponse
-	42, // 88: spawner.SpawnerService.GetApplicationsCost:output_type -> spawner.GetApplicationsCostResponse
-	48, // 89: spawner.SpawnerService.WriteCredential:output_type -> spawner.WriteCredentialResponse
-	50, // 90: spawner.SpawnerService.ReadCredential:output_type -> spawner.ReadCredentialResponse
-	52, // 91: spawner.SpawnerService.GetKubeConfig:output_type -> spawner.GetKubeConfigResponse
-	53, // 92: spawner.SpawnerService.TagNodeInstance:output_type -> spawner.TagNodeInstanceResponse
-	56, // 93: spawner.SpawnerService.GetCostByTime:output_type -> spawner.GetCostByTimeResponse
-	59, // 94: spawner.SpawnerService.GetContainerRegistryAuth:output_type -> spawner.GetContainerRegistryAuthResponse
-	60, // 95: spawner.SpawnerService.CreateContainerRegistryRepo:output_type -> spawner.CreateContainerRegistryRepoResponse
-	65, // 96: spawner.SpawnerService.RegisterClusterOIDC:output_type -> spawner.RegisterClusterOIDCResponse
-	69, // 97: spawner.SpawnerService.CreateRoute53Records:output_type -> spawner.CreateRoute53RecordsResponse
-	71, // 98: spawner.SpawnerService.GetRoute53TXTRecords:output_type -> spawner.GetRoute53TXTRecordsResponse
-	73, // 99: spawner.SpawnerService.DeleteRoute53Records:output_type -> spawner.DeleteRoute53RecordsResponse
-	75, // 100: spawner.SpawnerService.CopySnapshot:output_type -> spawner.CopySnapshotResponse
-	77, // 101: spawner.SpawnerService.PresignS3Url:output_type -> spawner.PresignS3UrlResponse
-	69, // [69:102] is the sub-list for method output_type
-	36, // [36:69] is the sub-list for method input_type
-	36, // [36:36] is the sub-list for extension type_name
-	36, // [36:36] is the sub-list for extension extendee
-	0,  // [0:36] is the sub-list for field type_name
+
+func (x *Route53ResourceRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_proto_netbookai_spawner_spawner_proto_init() }
-func file_proto_netbookai_spawner_spawner_proto_init() {
-	if File_proto_netbookai_spawner_spawner_proto != nil {
-		return
+func (*Route53ResourceRecord) ProtoMessage() {}
+
+func (x *Route53ResourceRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Empty); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Route53ResourceRecord.ProtoReflect.Descriptor instead.
+func (*Route53ResourceRecord) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Route53ResourceRecord) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type CreateRoute53RecordsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records []*Route53ResourceRecordSet `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *CreateRoute53RecordsRequest) Reset() {
+	*x = CreateRoute53RecordsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRoute53RecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoute53RecordsRequest) ProtoMessage() {}
+
+func (x *CreateRoute53RecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoute53RecordsRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoute53RecordsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CreateRoute53RecordsRequest) GetRecords() []*Route53ResourceRecordSet {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type CreateRoute53RecordsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreateRoute53RecordsResponse) Reset() {
+	*x = CreateRoute53RecordsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRoute53RecordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoute53RecordsResponse) ProtoMessage() {}
+
+func (x *CreateRoute53RecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoute53RecordsResponse.ProtoReflect.Descriptor instead.
+func (*CreateRoute53RecordsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{87}
+}
+
+type GetRoute53TXTRecordsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetRoute53TXTRecordsRequest) Reset() {
+	*x = GetRoute53TXTRecordsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRoute53TXTRecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoute53TXTRecordsRequest) ProtoMessage() {}
+
+func (x *GetRoute53TXTRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoute53TXTRecordsRequest.ProtoReflect.Descriptor instead.
+func (*GetRoute53TXTRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{88}
+}
+
+type GetRoute53TXTRecordsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records []*Route53ResourceRecordSet `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *GetRoute53TXTRecordsResponse) Reset() {
+	*x = GetRoute53TXTRecordsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRoute53TXTRecordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoute53TXTRecordsResponse) ProtoMessage() {}
+
+func (x *GetRoute53TXTRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoute53TXTRecordsResponse.ProtoReflect.Descriptor instead.
+func (*GetRoute53TXTRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetRoute53TXTRecordsResponse) GetRecords() []*Route53ResourceRecordSet {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type DeleteRoute53RecordsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records []*Route53ResourceRecordSet `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *DeleteRoute53RecordsRequest) Reset() {
+	*x = DeleteRoute53RecordsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRoute53RecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoute53RecordsRequest) ProtoMessage() {}
+
+func (x *DeleteRoute53RecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoute53RecordsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoute53RecordsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *DeleteRoute53RecordsRequest) GetRecords() []*Route53ResourceRecordSet {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type DeleteRoute53RecordsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteRoute53RecordsResponse) Reset() {
+	*x = DeleteRoute53RecordsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRoute53RecordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoute53RecordsResponse) ProtoMessage() {}
+
+func (x *DeleteRoute53RecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoute53RecordsResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRoute53RecordsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{91}
+}
+
+type CopySnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string            `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string            `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string            `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	SnapshotId  string            `protobuf:"bytes,4,opt,name=snapshotId,proto3" json:"snapshotId,omitempty"`
+	SnapshotUri string            `protobuf:"bytes,5,opt,name=snapshotUri,proto3" json:"snapshotUri,omitempty"`
+	Labels      map[string]string `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// repeated calls with the same key return the result of the first call
+	IdempotencyKey string `protobuf:"bytes,7,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+}
+
+func (x *CopySnapshotRequest) Reset() {
+	*x = CopySnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CopySnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopySnapshotRequest) ProtoMessage() {}
+
+func (x *CopySnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopySnapshotRequest.ProtoReflect.Descriptor instead.
+func (*CopySnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CopySnapshotRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *CopySnapshotRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *CopySnapshotRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *CopySnapshotRequest) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+func (x *CopySnapshotRequest) GetSnapshotUri() string {
+	if x != nil {
+		return x.SnapshotUri
+	}
+	return ""
+}
+
+func (x *CopySnapshotRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CopySnapshotRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type CopySnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NewSnapshotId  string `protobuf:"bytes,1,opt,name=newSnapshotId,proto3" json:"newSnapshotId,omitempty"`
+	NewSnapshotUri string `protobuf:"bytes,2,opt,name=newSnapshotUri,proto3" json:"newSnapshotUri,omitempty"`
+}
+
+func (x *CopySnapshotResponse) Reset() {
+	*x = CopySnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CopySnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopySnapshotResponse) ProtoMessage() {}
+
+func (x *CopySnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopySnapshotResponse.ProtoReflect.Descriptor instead.
+func (*CopySnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CopySnapshotResponse) GetNewSnapshotId() string {
+	if x != nil {
+		return x.NewSnapshotId
+	}
+	return ""
+}
+
+func (x *CopySnapshotResponse) GetNewSnapshotUri() string {
+	if x != nil {
+		return x.NewSnapshotUri
+	}
+	return ""
+}
+
+type PresignS3UrlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Region          string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName     string `protobuf:"bytes,2,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	Bucket          string `protobuf:"bytes,3,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	File            string `protobuf:"bytes,4,opt,name=file,proto3" json:"file,omitempty"`
+	TimeoutInMinute int32  `protobuf:"varint,5,opt,name=timeoutInMinute,proto3" json:"timeoutInMinute,omitempty"`
+}
+
+func (x *PresignS3UrlRequest) Reset() {
+	*x = PresignS3UrlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PresignS3UrlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresignS3UrlRequest) ProtoMessage() {}
+
+func (x *PresignS3UrlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresignS3UrlRequest.ProtoReflect.Descriptor instead.
+func (*PresignS3UrlRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *PresignS3UrlRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *PresignS3UrlRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *PresignS3UrlRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *PresignS3UrlRequest) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *PresignS3UrlRequest) GetTimeoutInMinute() int32 {
+	if x != nil {
+		return x.TimeoutInMinute
+	}
+	return 0
+}
+
+type PresignS3UrlResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SignedUrl string `protobuf:"bytes,1,opt,name=signedUrl,proto3" json:"signedUrl,omitempty"`
+}
+
+func (x *PresignS3UrlResponse) Reset() {
+	*x = PresignS3UrlResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PresignS3UrlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresignS3UrlResponse) ProtoMessage() {}
+
+func (x *PresignS3UrlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresignS3UrlResponse.ProtoReflect.Descriptor instead.
+func (*PresignS3UrlResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *PresignS3UrlResponse) GetSignedUrl() string {
+	if x != nil {
+		return x.SignedUrl
+	}
+	return ""
+}
+
+type Operation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// rpc which started the operation, such as CreateCluster
+	Kind        string         `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	State       OperationState `protobuf:"varint,3,opt,name=state,proto3,enum=spawner.OperationState" json:"state,omitempty"`
+	Provider    string         `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string         `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string         `protobuf:"bytes,6,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	// cluster, nodepool or volume the operation acts on
+	Resource string `protobuf:"bytes,7,opt,name=resource,proto3" json:"resource,omitempty"`
+	// progress in percentage
+	Progress int32  `protobuf:"varint,8,opt,name=progress,proto3" json:"progress,omitempty"`
+	Message  string `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
+	// error message, set when operation is failed
+	Error string `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	// unix timestamp in seconds
+	CreatedAt int64 `protobuf:"varint,11,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt int64 `protobuf:"varint,12,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	// canonical status of the error with its details, set when operation is
+	// failed
+	Status *status.Status `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"`
+	// result of the operation, set when operation is succeeded
+	//
+	// Types that are assignable to Result:
+	//	*Operation_CreateCluster
+	//	*Operation_AddNode
+	//	*Operation_DeleteCluster
+	//	*Operation_DeleteNode
+	//	*Operation_CreateVolume
+	//	*Operation_CreateSnapshotAndDelete
+	Result isOperation_Result `protobuf_oneof:"result"`
+}
+
+func (x *Operation) Reset() {
+	*x = Operation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Operation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Operation) ProtoMessage() {}
+
+func (x *Operation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Operation.ProtoReflect.Descriptor instead.
+func (*Operation) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *Operation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Operation) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Operation) GetState() OperationState {
+	if x != nil {
+		return x.State
+	}
+	return OperationState_OPERATION_UNKNOWN
+}
+
+func (x *Operation) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Operation) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Operation) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *Operation) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *Operation) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *Operation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Operation) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Operation) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Operation) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Operation) GetStatus() *status.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (m *Operation) GetResult() isOperation_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *Operation) GetCreateCluster() *ClusterResponse {
+	if x, ok := x.GetResult().(*Operation_CreateCluster); ok {
+		return x.CreateCluster
+	}
+	return nil
+}
+
+func (x *Operation) GetAddNode() *NodeSpawnResponse {
+	if x, ok := x.GetResult().(*Operation_AddNode); ok {
+		return x.AddNode
+	}
+	return nil
+}
+
+func (x *Operation) GetDeleteCluster() *ClusterDeleteResponse {
+	if x, ok := x.GetResult().(*Operation_DeleteCluster); ok {
+		return x.DeleteCluster
+	}
+	return nil
+}
+
+func (x *Operation) GetDeleteNode() *NodeDeleteResponse {
+	if x, ok := x.GetResult().(*Operation_DeleteNode); ok {
+		return x.DeleteNode
+	}
+	return nil
+}
+
+func (x *Operation) GetCreateVolume() *CreateVolumeResponse {
+	if x, ok := x.GetResult().(*Operation_CreateVolume); ok {
+		return x.CreateVolume
+	}
+	return nil
+}
+
+func (x *Operation) GetCreateSnapshotAndDelete() *CreateSnapshotAndDeleteResponse {
+	if x, ok := x.GetResult().(*Operation_CreateSnapshotAndDelete); ok {
+		return x.CreateSnapshotAndDelete
+	}
+	return nil
+}
+
+type isOperation_Result interface {
+	isOperation_Result()
+}
+
+type Operation_CreateCluster struct {
+	CreateCluster *ClusterResponse `protobuf:"bytes,20,opt,name=createCluster,proto3,oneof"`
+}
+
+type Operation_AddNode struct {
+	AddNode *NodeSpawnResponse `protobuf:"bytes,21,opt,name=addNode,proto3,oneof"`
+}
+
+type Operation_DeleteCluster struct {
+	DeleteCluster *ClusterDeleteResponse `protobuf:"bytes,22,opt,name=deleteCluster,proto3,oneof"`
+}
+
+type Operation_DeleteNode struct {
+	DeleteNode *NodeDeleteResponse `protobuf:"bytes,23,opt,name=deleteNode,proto3,oneof"`
+}
+
+type Operation_CreateVolume struct {
+	CreateVolume *CreateVolumeResponse `protobuf:"bytes,24,opt,name=createVolume,proto3,oneof"`
+}
+
+type Operation_CreateSnapshotAndDelete struct {
+	CreateSnapshotAndDelete *CreateSnapshotAndDeleteResponse `protobuf:"bytes,25,opt,name=createSnapshotAndDelete,proto3,oneof"`
+}
+
+func (*Operation_CreateCluster) isOperation_Result() {}
+
+func (*Operation_AddNode) isOperation_Result() {}
+
+func (*Operation_DeleteCluster) isOperation_Result() {}
+
+func (*Operation_DeleteNode) isOperation_Result() {}
+
+func (*Operation_CreateVolume) isOperation_Result() {}
+
+func (*Operation_CreateSnapshotAndDelete) isOperation_Result() {}
+
+type GetOperationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetOperationRequest) Reset() {
+	*x = GetOperationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOperationRequest) ProtoMessage() {}
+
+func (x *GetOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOperationRequest.ProtoReflect.Descriptor instead.
+func (*GetOperationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetOperationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListOperationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	Kind        string `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	// include succeeded, failed and cancelled operations
+	IncludeDone bool `protobuf:"varint,5,opt,name=includeDone,proto3" json:"includeDone,omitempty"`
+}
+
+func (x *ListOperationsRequest) Reset() {
+	*x = ListOperationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOperationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationsRequest) ProtoMessage() {}
+
+func (x *ListOperationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationsRequest.ProtoReflect.Descriptor instead.
+func (*ListOperationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ListOperationsRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ListOperationsRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ListOperationsRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *ListOperationsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ListOperationsRequest) GetIncludeDone() bool {
+	if x != nil {
+		return x.IncludeDone
+	}
+	return false
+}
+
+type ListOperationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operations []*Operation `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+}
+
+func (x *ListOperationsResponse) Reset() {
+	*x = ListOperationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOperationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationsResponse) ProtoMessage() {}
+
+func (x *ListOperationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationsResponse.ProtoReflect.Descriptor instead.
+func (*ListOperationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ListOperationsResponse) GetOperations() []*Operation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+type CancelOperationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CancelOperationRequest) Reset() {
+	*x = CancelOperationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOperationRequest) ProtoMessage() {}
+
+func (x *CancelOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOperationRequest.ProtoReflect.Descriptor instead.
+func (*CancelOperationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *CancelOperationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Resource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// provider/accountName/region/kind/[parent name/]name
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// one of cluster, nodegroup, volume, snapshot, container-registry,
+	// dns-record, network
+	Kind        string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Provider    string `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,6,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	// id of the resource this belongs to, such as the cluster of a nodegroup
+	ParentId string            `protobuf:"bytes,7,opt,name=parentId,proto3" json:"parentId,omitempty"`
+	Labels   map[string]string `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// one of created, active, deleted
+	State string `protobuf:"bytes,9,opt,name=state,proto3" json:"state,omitempty"`
+	// unix time
+	CreatedAt int64 `protobuf:"varint,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt int64 `protobuf:"varint,11,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	DeletedAt int64 `protobuf:"varint,12,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"`
+}
+
+func (x *Resource) Reset() {
+	*x = Resource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Resource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Resource) ProtoMessage() {}
+
+func (x *Resource) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Resource.ProtoReflect.Descriptor instead.
+func (*Resource) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *Resource) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Resource) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Resource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Resource) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Resource) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Resource) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *Resource) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Resource) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Resource) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Resource) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Resource) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Resource) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+type ListResourcesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider       string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region         string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName    string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	Kind           string `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	ParentId       string `protobuf:"bytes,5,opt,name=parentId,proto3" json:"parentId,omitempty"`
+	IncludeDeleted bool   `protobuf:"varint,6,opt,name=includeDeleted,proto3" json:"includeDeleted,omitempty"`
+}
+
+func (x *ListResourcesRequest) Reset() {
+	*x = ListResourcesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourcesRequest) ProtoMessage() {}
+
+func (x *ListResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourcesRequest.ProtoReflect.Descriptor instead.
+func (*ListResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ListResourcesRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ListResourcesRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ListResourcesRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *ListResourcesRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ListResourcesRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *ListResourcesRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type ListResourcesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resources []*Resource `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+}
+
+func (x *ListResourcesResponse) Reset() {
+	*x = ListResourcesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourcesResponse) ProtoMessage() {}
+
+func (x *ListResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourcesResponse.ProtoReflect.Descriptor instead.
+func (*ListResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ListResourcesResponse) GetResources() []*Resource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+type GetResourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetResourceRequest) Reset() {
+	*x = GetResourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceRequest) ProtoMessage() {}
+
+func (x *GetResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceRequest.ProtoReflect.Descriptor instead.
+func (*GetResourceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetResourceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListProvidersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListProvidersRequest) Reset() {
+	*x = ListProvidersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProvidersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProvidersRequest) ProtoMessage() {}
+
+func (x *ListProvidersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProvidersRequest.ProtoReflect.Descriptor instead.
+func (*ListProvidersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{105}
+}
+
+type ProviderInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// provider is enabled in spawner config
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// groups of rpcs supported by the provider, such as cluster, nodepool,
+	// volume, snapshot, cost
+	Capabilities []string `protobuf:"bytes,3,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+}
+
+func (x *ProviderInfo) Reset() {
+	*x = ProviderInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderInfo) ProtoMessage() {}
+
+func (x *ProviderInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderInfo.ProtoReflect.Descriptor instead.
+func (*ProviderInfo) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ProviderInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProviderInfo) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ProviderInfo) GetCapabilities() []string {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+type ListProvidersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Providers []*ProviderInfo `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+}
+
+func (x *ListProvidersResponse) Reset() {
+	*x = ListProvidersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProvidersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProvidersResponse) ProtoMessage() {}
+
+func (x *ListProvidersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProvidersResponse.ProtoReflect.Descriptor instead.
+func (*ListProvidersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListProvidersResponse) GetProviders() []*ProviderInfo {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+var File_proto_netbookai_spawner_spawner_proto protoreflect.FileDescriptor
+
+var file_proto_netbookai_spawner_spawner_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6e, 0x65, 0x74, 0x62, 0x6f, 0x6f, 0x6b, 0x61,
+	0x69, 0x2f, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2f, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
+	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x1f, 0x0a, 0x0b, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73,
+	0x67, 0x22, 0x20, 0x0a, 0x0c, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6d, 0x73, 0x67, 0x22, 0x88, 0x05, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x67,
+	0x70, 0x75, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x67, 0x70, 0x75, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x33, 0x0a, 0x0a, 0x6d, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x49, 0x47, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x0a,
+	0x6d, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x39, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
+	0x2e, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x63,
+	0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x73,
+	0x70, 0x6f, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0d, 0x73, 0x70, 0x6f, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5f,
+	0x0a, 0x05, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a,
+	0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x73, 0x22,
+	0x2e, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x05, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x65, 0x72, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x05, 0x69, 0x73, 0x73, 0x75, 0x65, 0x22,
+	0xcf, 0x02, 0x0a, 0x0e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x6e, 0x6f, 0x64,
+	0x65, 0x12, 0x3b, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x26,
+	0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x8b, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x6a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x6e, 0x0a, 0x0b, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x08,
+	0x6e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x65,
+	0x63, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63, 0x22, 0x47, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x0f, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f,
+	0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8e, 0x01, 0x0a, 0x14, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x45, 0x0a, 0x15, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0xc7, 0x01, 0x0a, 0x19, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x22, 0x7b, 0x0a, 0x0f, 0x4e, 0x6f,
+	0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x26, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52,
+	0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x22, 0xf2, 0x01, 0x0a, 0x12, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x18,
+	0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x67, 0x0a, 0x0f,
+	0x41, 0x64, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x40, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x89, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12,
+	0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x61, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x44, 0x61, 0x74,
+	0x61, 0x22, 0xa9, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x6e, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x6e, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x0a,
+	0x18, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xe1, 0x01, 0x0a, 0x10, 0x4e, 0x6f, 0x64, 0x65,
+	0x53, 0x70, 0x61, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x53,
+	0x70, 0x65, 0x63, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x5b, 0x0a, 0x11, 0x4e,
+	0x6f, 0x64, 0x65, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb0, 0x01, 0x0a, 0x14, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x22, 0x5f, 0x0a, 0x15, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x09, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb1, 0x01, 0x0a,
+	0x11, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f,
+	0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x5c, 0x0a, 0x12, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x09,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xda,
+	0x03, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x7a, 0x6f, 0x6e, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55, 0x72, 0x69, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55, 0x72, 0x69,
+	0x12, 0x26, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79,
+	0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9d, 0x01, 0x0a, 0x14,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x72, 0x69, 0x12, 0x30, 0x0a, 0x09, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x87, 0x01, 0x0a, 0x13,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x69, 0x64, 0x22, 0x46, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xb0, 0x02,
+	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x69, 0x64, 0x12, 0x42, 0x0a, 0x06, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x26, 0x0a,
+	0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4b, 0x65, 0x79, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x5a, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55, 0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55, 0x72, 0x69, 0x22, 0x9a, 0x02, 0x0a,
+	0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41,
+	0x6e, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x69,
+	0x64, 0x12, 0x4b, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x33, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x6e, 0x64, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39,
+	0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xaf, 0x01, 0x0a, 0x1f, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x6e, 0x64, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x69, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x55, 0x72, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55, 0x72, 0x69, 0x12, 0x30, 0x0a, 0x09, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3e, 0x0a, 0x1a, 0x52,
+	0x61, 0x6e, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x7f, 0x0a, 0x1b, 0x52,
+	0x61, 0x6e, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x55, 0x52, 0x4c, 0x22, 0xa2, 0x02, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x43, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c,
+	0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x73, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x73, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x2a, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x42, 0x79, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x3a, 0x02, 0x18,
+	0x01, 0x22, 0xa4, 0x02, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x26, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x52,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x22, 0x2f, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x42, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0xd0, 0x01, 0x0a, 0x19, 0x47, 0x65,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x43, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64,
+	0x43, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x1a, 0x3e, 0x0a, 0x10,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd4, 0x01, 0x0a,
+	0x1b, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x0b, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x35, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43,
+	0x6f, 0x73, 0x74, 0x1a, 0x3e, 0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f,
+	0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x72, 0x0a, 0x0e, 0x41, 0x77, 0x73, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b,
+	0x65, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x74, 0x0a, 0x12, 0x41, 0x77, 0x73, 0x52, 0x6f,
+	0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x6f, 0x6c, 0x65, 0x41, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x72, 0x6f, 0x6c, 0x65, 0x41, 0x72, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xbc, 0x01,
+	0x0a, 0x10, 0x41, 0x7a, 0x75, 0x72, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x31, 0x0a, 0x19,
+	0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x50, 0x0a, 0x0e, 0x47, 0x63, 0x70, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x12,
+	0x20, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x22, 0xa1, 0x01, 0x0a, 0x19, 0x41, 0x7a, 0x75, 0x72, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12,
+	0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12,
+	0x24, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x7b, 0x0a, 0x17, 0x47, 0x63, 0x70, 0x46, 0x65, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0xe4, 0x04, 0x0a, 0x16, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x61,
+	0x77, 0x73, 0x43, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x77, 0x73, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x07, 0x61, 0x77, 0x73, 0x43, 0x72, 0x65, 0x64,
+	0x12, 0x39, 0x0a, 0x09, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x43, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x7a,
+	0x75, 0x72, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x48, 0x00,
+	0x52, 0x09, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x43, 0x72, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x06, 0x67,
+	0x69, 0x74, 0x50, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x48,
+	0x00, 0x52, 0x06, 0x67, 0x69, 0x74, 0x50, 0x61, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x67, 0x63, 0x70,
+	0x43, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x63, 0x70, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x07, 0x67, 0x63, 0x70, 0x43, 0x72, 0x65, 0x64, 0x12, 0x3f,
+	0x0a, 0x0b, 0x61, 0x77, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x77,
+	0x73, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x48, 0x00, 0x52, 0x0b, 0x61, 0x77, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x12,
+	0x54, 0x0a, 0x12, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x64, 0x43, 0x72, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x7a, 0x75, 0x72, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x48,
+	0x00, 0x52, 0x12, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x64, 0x43, 0x72, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x10, 0x67, 0x63, 0x70, 0x46, 0x65, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x63, 0x70, 0x46, 0x65, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x48, 0x00, 0x52, 0x10, 0x67, 0x63, 0x70, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x64, 0x43, 0x72, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x73, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x06, 0x0a, 0x04, 0x63, 0x72, 0x65, 0x64, 0x22, 0xb0, 0x01, 0x0a, 0x12, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x68, 0x0a, 0x17,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x37, 0x0a,
+	0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x7b, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x67, 0x65, 0x22, 0xc4, 0x05, 0x0a, 0x16, 0x52, 0x65, 0x61, 0x64, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x07,
+	0x61, 0x77, 0x73, 0x43, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x77, 0x73, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x07, 0x61, 0x77, 0x73, 0x43, 0x72, 0x65,
+	0x64, 0x12, 0x39, 0x0a, 0x09, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x43, 0x72, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41,
+	0x7a, 0x75, 0x72, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x48,
+	0x00, 0x52, 0x09, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x43, 0x72, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x06,
+	0x67, 0x69, 0x74, 0x50, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x48, 0x00, 0x52, 0x06, 0x67, 0x69, 0x74, 0x50, 0x61, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x67, 0x63,
+	0x70, 0x43, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x63, 0x70, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x07, 0x67, 0x63, 0x70, 0x43, 0x72, 0x65, 0x64, 0x12,
+	0x3f, 0x0a, 0x0b, 0x61, 0x77, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41,
+	0x77, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x77, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64,
+	0x12, 0x54, 0x0a, 0x12, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x7a, 0x75, 0x72, 0x65, 0x46, 0x65, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x48, 0x00, 0x52, 0x12, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x10, 0x67, 0x63, 0x70, 0x46, 0x65, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x63, 0x70, 0x46, 0x65,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x48, 0x00, 0x52, 0x10, 0x67, 0x63, 0x70, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x44, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b,
+	0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74,
+	0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74,
+	0x65, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x63, 0x72, 0x65, 0x64, 0x22, 0x75, 0x0a, 0x17, 0x52, 0x65,
+	0x76, 0x65, 0x61, 0x6c, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0x5c, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x46, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x54, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
+	0x72, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0x47, 0x0a,
+	0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xdf, 0x04, 0x0a, 0x17, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x07,
+	0x61, 0x77, 0x73, 0x43, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x77, 0x73, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x07, 0x61, 0x77, 0x73, 0x43, 0x72, 0x65,
+	0x64, 0x12, 0x39, 0x0a, 0x09, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x43, 0x72, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41,
+	0x7a, 0x75, 0x72, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x48,
+	0x00, 0x52, 0x09, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x43, 0x72, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x06,
+	0x67, 0x69, 0x74, 0x50, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x48, 0x00, 0x52, 0x06, 0x67, 0x69, 0x74, 0x50, 0x61, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x67, 0x63,
+	0x70, 0x43, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x63, 0x70, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x07, 0x67, 0x63, 0x70, 0x43, 0x72, 0x65, 0x64, 0x12,
+	0x3f, 0x0a, 0x0b, 0x61, 0x77, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41,
+	0x77, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x77, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64,
+	0x12, 0x54, 0x0a, 0x12, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x7a, 0x75, 0x72, 0x65, 0x46, 0x65, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x48, 0x00, 0x52, 0x12, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x10, 0x67, 0x63, 0x70, 0x46, 0x65, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x63, 0x70, 0x46, 0x65,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x48, 0x00, 0x52, 0x10, 0x67, 0x63, 0x70, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x12, 0x2a, 0x0a, 0x10, 0x73, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x6b, 0x69, 0x70,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0x0a, 0x04,
+	0x63, 0x72, 0x65, 0x64, 0x22, 0x59, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x89, 0x01, 0x0a, 0x18, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x5d, 0x0a, 0x17, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x22, 0x53, 0x0a, 0x18, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22,
+	0x4d, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x58,
+	0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x36, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x4b, 0x75, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x61, 0x77,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x61, 0x77,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x51, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4b, 0x75, 0x62, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x19, 0x0a, 0x17, 0x54, 0x61, 0x67, 0x4e,
+	0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xae, 0x02, 0x0a, 0x16, 0x54, 0x61, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x43, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x54,
+	0x61, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xec, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74,
+	0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x49, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e,
+	0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x42, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x65, 0x72, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x42, 0x79, 0x22, 0xbc, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x42,
+	0x79, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a,
+	0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74,
+	0x1a, 0x50, 0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x72, 0x0a, 0x07, 0x63, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x2e, 0x0a,
+	0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x43, 0x6f,
+	0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x1a, 0x37, 0x0a,
+	0x09, 0x43, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x77, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x41, 0x75,
+	0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a,
+	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x4a, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x57, 0x0a, 0x23, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x22, 0xba, 0x02, 0x0a, 0x22, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12,
+	0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b,
+	0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x8d, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12,
+	0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49,
+	0x64, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x1a,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4f,
+	0x49, 0x44, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x4f, 0x49, 0x44, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xb0, 0x01, 0x0a, 0x18, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x74, 0x6c, 0x49, 0x6e, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x74,
+	0x6c, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x48, 0x0a, 0x0f, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x22, 0x2d, 0x0a, 0x15, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x5a, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3b, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x53, 0x65, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22,
+	0x1e, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x54, 0x58, 0x54,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5b,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x54, 0x58, 0x54, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b,
+	0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35,
+	0x33, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53,
+	0x65, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x5a, 0x0a, 0x1b, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x07, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x1e, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd2, 0x02, 0x0a, 0x13, 0x43, 0x6f, 0x70, 0x79,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x55, 0x72, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x55, 0x72, 0x69, 0x12, 0x40, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
+	0x72, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65,
+	0x79, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x64, 0x0a, 0x14,
+	0x43, 0x6f, 0x70, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x77,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x65,
+	0x77, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x6e, 0x65, 0x77, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55,
+	0x72, 0x69, 0x22, 0xa5, 0x01, 0x0a, 0x13, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x53, 0x33,
+	0x55, 0x72, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x28, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x4d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x49, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x22, 0x34, 0x0a, 0x14, 0x50, 0x72,
+	0x65, 0x73, 0x69, 0x67, 0x6e, 0x53, 0x33, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x72, 0x6c,
+	0x22, 0xba, 0x06, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x40, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x4e, 0x6f, 0x64, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x07, 0x61, 0x64, 0x64, 0x4e, 0x6f, 0x64,
+	0x65, 0x12, 0x46, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0a, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x64, 0x0a,
+	0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41,
+	0x6e, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x17, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x6e, 0x64, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x25, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0xa3, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x44, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x6f, 0x6e, 0x65, 0x22, 0x4c, 0x0a, 0x16, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x28, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x96, 0x03, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc4, 0x01, 0x0a, 0x14,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x22, 0x48, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x24, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x60, 0x0a, 0x0c, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
+	0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x4c, 0x0a, 0x15,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2a, 0x50, 0x0a, 0x0a, 0x4d, 0x49,
+	0x47, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x49, 0x47, 0x31, 0x67, 0x10, 0x01,
+	0x12, 0x09, 0x0a, 0x05, 0x4d, 0x49, 0x47, 0x32, 0x67, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x4d,
+	0x49, 0x47, 0x33, 0x67, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x49, 0x47, 0x34, 0x67, 0x10,
+	0x04, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x49, 0x47, 0x37, 0x67, 0x10, 0x05, 0x2a, 0x36, 0x0a, 0x0c,
+	0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a,
+	0x54, 0x79, 0x70, 0x65, 0x55, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
+	0x4f, 0x4e, 0x44, 0x45, 0x4d, 0x41, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x50,
+	0x4f, 0x54, 0x10, 0x02, 0x2a, 0x9d, 0x01, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x50, 0x45, 0x52, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x15,
+	0x0a, 0x11, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x45, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13,
+	0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45,
+	0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x4f,
+	0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c,
+	0x45, 0x44, 0x10, 0x05, 0x32, 0xa4, 0x30, 0x0a, 0x0e, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x0e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a,
+	0x2f, 0x76, 0x31, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x48, 0x0a, 0x04, 0x45, 0x63,
+	0x68, 0x6f, 0x12, 0x14, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x45, 0x63, 0x68,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x65, 0x72, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x3a, 0x01, 0x2a, 0x22, 0x08, 0x2f, 0x76, 0x31, 0x2f,
+	0x65, 0x63, 0x68, 0x6f, 0x12, 0x70, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26,
+	0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x7d, 0x2f, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x81, 0x01, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x18, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x64,
+	0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a,
+	0x3a, 0x01, 0x2a, 0x22, 0x35, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x7d, 0x2f, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x7e, 0x0a, 0x08, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x7d, 0x2f, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x76, 0x0a, 0x10, 0x41, 0x64,
+	0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x20,
+	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x35, 0x33, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12,
+	0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x2f, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x12, 0x77, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x70,
+	0x65, 0x63, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x7d, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x73, 0x0a, 0x0b, 0x47,
+	0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x7d, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x86, 0x01, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x2e, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x61, 0x77, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
+	0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x3a, 0x01, 0x2a, 0x22, 0x39,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x7d, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
+	0x6e, 0x6f, 0x64, 0x65, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x8e, 0x01, 0x0a, 0x0d, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x38, 0x12, 0x36, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x7d, 0x2f, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x0d, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x31, 0x2a, 0x2f, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x7d, 0x2f, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x7d, 0x12, 0x98, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e,
+	0x6f, 0x64, 0x65, 0x12, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x4b, 0x2a, 0x49, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x7d, 0x2f, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2f,
+	0x7b, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x12,
+	0x78, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12,
+	0x1c, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x7d, 0x2f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x80, 0x01, 0x0a, 0x0c, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x2a,
+	0x2b, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x7b,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x7d, 0x2f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x73, 0x2f, 0x7b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x69, 0x64, 0x7d, 0x12, 0x93, 0x01, 0x0a,
+	0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
+	0x1e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x3a, 0x01, 0x2a, 0x22, 0x35, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x7d, 0x2f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x2f, 0x7b, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x12, 0x8a, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x2a, 0x2f,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x7d, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x2f, 0x7b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x7d, 0x12,
+	0xb6, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x41, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x27, 0x2e, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x41, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x6e, 0x64,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x42, 0x3a, 0x01, 0x2a, 0x22, 0x3d, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x7d, 0x2f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x2f, 0x7b, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x69, 0x64, 0x7d, 0x3a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41,
+	0x6e, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x8f, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x52, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x72,
+	0x12, 0x23, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x6e, 0x63, 0x68,
+	0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
+	0x52, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x22, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x90, 0x01, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x43, 0x6f, 0x73, 0x74,
+	0x12, 0x21, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x88, 0x02, 0x01, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2b, 0x12, 0x29, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x7d, 0x2f, 0x63, 0x6f, 0x73,
+	0x74, 0x73, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x95, 0x01,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x7d, 0x2f, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x8a, 0x01, 0x0a, 0x0f, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1f, 0x2e, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x65, 0x72, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x1a, 0x29, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x2f,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x74, 0x79, 0x70,
+	0x65, 0x7d, 0x12, 0x84, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x61, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x61, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x2f, 0x7b, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x12, 0x92, 0x01, 0x0a, 0x10, 0x52, 0x65,
+	0x76, 0x65, 0x61, 0x6c, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x20,
+	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x3a, 0x01, 0x2a, 0x22, 0x30, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x7d, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x2f, 0x7b, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x3a, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x12, 0x6d,
+	0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x12, 0x1f, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x8a, 0x01,
+	0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x12, 0x20, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x2a,
+	0x29, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x12, 0x94, 0x01, 0x0a, 0x10, 0x52,
+	0x6f, 0x74, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12,
+	0x20, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x3a, 0x01, 0x2a, 0x22,
+	0x30, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x3a, 0x72, 0x6f, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x94, 0x01, 0x0a, 0x10, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x20, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
+	0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x65, 0x72, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x35, 0x3a, 0x01, 0x2a, 0x22, 0x30, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x2f, 0x63,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x74, 0x79, 0x70, 0x65,
+	0x7d, 0x3a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0xa5, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x26, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x7d, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x2f, 0x7b, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x92, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x4b, 0x75, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4b,
+	0x75, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x12, 0x3a, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x7d, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0xb3, 0x01, 0x0a, 0x0f, 0x54, 0x61, 0x67, 0x4e, 0x6f, 0x64,
+	0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x2e, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x57, 0x3a, 0x01, 0x2a, 0x22, 0x52, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x7d, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x70, 0x6f, 0x6f,
+	0x6c, 0x73, 0x2f, 0x7b, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x7d, 0x3a, 0x74,
+	0x61, 0x67, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x7b, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x2e, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x42, 0x79,
+	0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x54,
+	0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x7d, 0x2f, 0x63, 0x6f,
+	0x73, 0x74, 0x73, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x9f, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x28, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x79, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x41, 0x75,
+	0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x28, 0x12, 0x26, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x7d, 0x2f, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x79, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x12, 0xb3, 0x01, 0x0a, 0x1b, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x2b, 0x2e, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
+	0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x3a, 0x01, 0x2a,
+	0x22, 0x2e, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2f,
+	0x7b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x7d, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x79, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0xa9, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x4f, 0x49, 0x44, 0x43, 0x12, 0x23, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x65, 0x72, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x4f, 0x49, 0x44, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4f, 0x49, 0x44, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x47, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x3a, 0x01, 0x2a, 0x22, 0x3c,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x7d, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x3a,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4f, 0x49, 0x44, 0x43, 0x12, 0x83, 0x01, 0x0a,
+	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x24, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x35, 0x33, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f,
+	0x76, 0x31, 0x2f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x12, 0x80, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35,
+	0x33, 0x54, 0x58, 0x54, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x24, 0x2e, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33,
+	0x54, 0x58, 0x54, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x54, 0x58, 0x54, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15,
+	0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x2f, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x8a, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x24,
+	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x35, 0x33, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x3a, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x70, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x1c, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6f,
+	0x70, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x70, 0x79,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39, 0x3a, 0x01, 0x2a, 0x22, 0x34, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x7d, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2f,
+	0x7b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x7d, 0x3a, 0x63, 0x6f, 0x70,
+	0x79, 0x12, 0x66, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x53, 0x33, 0x55, 0x72,
+	0x6c, 0x12, 0x1c, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x65, 0x73,
+	0x69, 0x67, 0x6e, 0x53, 0x33, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67,
+	0x6e, 0x53, 0x33, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x33, 0x2f, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x5d, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
+	0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x69, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x6d, 0x0a, 0x0f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
+	0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x25, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x12, 0x9d, 0x01, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x65, 0x72, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x44, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x3e, 0x12, 0x3c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x7d, 0x2f, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x77, 0x61, 0x74, 0x63, 0x68,
+	0x30, 0x01, 0x12, 0x65, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x76, 0x31, 0x2f,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x59, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14,
+	0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x12, 0x65, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x42, 0x0a, 0x5a, 0x08, 0x2f,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_netbookai_spawner_spawner_proto_rawDescOnce sync.Once
+	file_proto_netbookai_spawner_spawner_proto_rawDescData = file_proto_netbookai_spawner_spawner_proto_rawDesc
+)
+
+func file_proto_netbookai_spawner_spawner_proto_rawDescGZIP() []byte {
+	file_proto_netbookai_spawner_spawner_proto_rawDescOnce.Do(func() {
+		file_proto_netbookai_spawner_spawner_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_netbookai_spawner_spawner_proto_rawDescData)
+	})
+	return file_proto_netbookai_spawner_spawner_proto_rawDescData
+}
+
+var file_proto_netbookai_spawner_spawner_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_proto_netbookai_spawner_spawner_proto_msgTypes = make([]protoimpl.MessageInfo, 121)
+var file_proto_netbookai_spawner_spawner_proto_goTypes = []interface{}{
+	(MIGProfile)(0),                             // 0: spawner.MIGProfile
+	(CapacityType)(0),                           // 1: spawner.CapacityType
+	(OperationState)(0),                         // 2: spawner.OperationState
+	(*Empty)(nil),                               // 3: spawner.Empty
+	(*EchoRequest)(nil),                         // 4: spawner.EchoRequest
+	(*EchoResponse)(nil),                        // 5: spawner.EchoResponse
+	(*NodeSpec)(nil),                            // 6: spawner.NodeSpec
+	(*Issue)(nil),                               // 7: spawner.Issue
+	(*Health)(nil),                              // 8: spawner.Health
+	(*ClusterRequest)(nil),                      // 9: spawner.ClusterRequest
+	(*GetClusterRequest)(nil),                   // 10: spawner.GetClusterRequest
+	(*GetClustersRequest)(nil),                  // 11: spawner.GetClustersRequest
+	(*ClusterSpec)(nil),                         // 12: spawner.ClusterSpec
+	(*GetClustersResponse)(nil),                 // 13: spawner.GetClustersResponse
+	(*ClusterResponse)(nil),                     // 14: spawner.ClusterResponse
+	(*ClusterStatusRequest)(nil),                // 15: spawner.ClusterStatusRequest
+	(*ClusterStatusResponse)(nil),               // 16: spawner.ClusterStatusResponse
+	(*WatchClusterStatusRequest)(nil),           // 17: spawner.WatchClusterStatusRequest
+	(*NodeGroupStatus)(nil),                     // 18: spawner.NodeGroupStatus
+	(*ClusterStatusEvent)(nil),                  // 19: spawner.ClusterStatusEvent
+	(*AddTokenRequest)(nil),                     // 20: spawner.AddTokenRequest
+	(*AddTokenResponse)(nil),                    // 21: spawner.AddTokenResponse
+	(*GetTokenRequest)(nil),                     // 22: spawner.GetTokenRequest
+	(*GetTokenResponse)(nil),                    // 23: spawner.GetTokenResponse
+	(*AddRoute53RecordRequest)(nil),             // 24: spawner.AddRoute53RecordRequest
+	(*AddRoute53RecordResponse)(nil),            // 25: spawner.AddRoute53RecordResponse
+	(*NodeSpawnRequest)(nil),                    // 26: spawner.NodeSpawnRequest
+	(*NodeSpawnResponse)(nil),                   // 27: spawner.NodeSpawnResponse
+	(*ClusterDeleteRequest)(nil),                // 28: spawner.ClusterDeleteRequest
+	(*ClusterDeleteResponse)(nil),               // 29: spawner.ClusterDeleteResponse
+	(*NodeDeleteRequest)(nil),                   // 30: spawner.NodeDeleteRequest
+	(*NodeDeleteResponse)(nil),                  // 31: spawner.NodeDeleteResponse
+	(*CreateVolumeRequest)(nil),                 // 32: spawner.CreateVolumeRequest
+	(*CreateVolumeResponse)(nil),                // 33: spawner.CreateVolumeResponse
+	(*DeleteVolumeRequest)(nil),                 // 34: spawner.DeleteVolumeRequest
+	(*DeleteVolumeResponse)(nil),                // 35: spawner.DeleteVolumeResponse
+	(*CreateSnapshotRequest)(nil),               // 36: spawner.CreateSnapshotRequest
+	(*CreateSnapshotResponse)(nil),              // 37: spawner.CreateSnapshotResponse
+	(*CreateSnapshotAndDeleteRequest)(nil),      // 38: spawner.CreateSnapshotAndDeleteRequest
+	(*CreateSnapshotAndDeleteResponse)(nil),     // 39: spawner.CreateSnapshotAndDeleteResponse
+	(*RancherRegistrationRequest)(nil),          // 40: spawner.RancherRegistrationRequest
+	(*RancherRegistrationResponse)(nil),         // 41: spawner.RancherRegistrationResponse
+	(*GetWorkspacesCostRequest)(nil),            // 42: spawner.GetWorkspacesCostRequest
+	(*GetApplicationsCostRequest)(nil),          // 43: spawner.GetApplicationsCostRequest
+	(*GroupBy)(nil),                             // 44: spawner.GroupBy
+	(*GetWorkspacesCostResponse)(nil),           // 45: spawner.GetWorkspacesCostResponse
+	(*GetApplicationsCostResponse)(nil),         // 46: spawner.GetApplicationsCostResponse
+	(*AwsCredentials)(nil),                      // 47: spawner.AwsCredentials
+	(*AwsRoleCredentials)(nil),                  // 48: spawner.AwsRoleCredentials
+	(*AzureCredentials)(nil),                    // 49: spawner.AzureCredentials
+	(*GithubPersonalAccessToken)(nil),           // 50: spawner.GithubPersonalAccessToken
+	(*GcpCredentials)(nil),                      // 51: spawner.GcpCredentials
+	(*AzureFederatedCredentials)(nil),           // 52: spawner.AzureFederatedCredentials
+	(*GcpFederatedCredentials)(nil),             // 53: spawner.GcpFederatedCredentials
+	(*WriteCredentialRequest)(nil),              // 54: spawner.WriteCredentialRequest
+	(*CredentialIdentity)(nil),                  // 55: spawner.CredentialIdentity
+	(*WriteCredentialResponse)(nil),             // 56: spawner.WriteCredentialResponse
+	(*ReadCredentialRequest)(nil),               // 57: spawner.ReadCredentialRequest
+	(*ReadCredentialResponse)(nil),              // 58: spawner.ReadCredentialResponse
+	(*RevealCredentialRequest)(nil),             // 59: spawner.RevealCredentialRequest
+	(*CredentialInfo)(nil),                      // 60: spawner.CredentialInfo
+	(*ListCredentialsRequest)(nil),              // 61: spawner.ListCredentialsRequest
+	(*ListCredentialsResponse)(nil),             // 62: spawner.ListCredentialsResponse
+	(*DeleteCredentialRequest)(nil),             // 63: spawner.DeleteCredentialRequest
+	(*DeleteCredentialResponse)(nil),            // 64: spawner.DeleteCredentialResponse
+	(*RotateCredentialRequest)(nil),             // 65: spawner.RotateCredentialRequest
+	(*CredentialVersion)(nil),                   // 66: spawner.CredentialVersion
+	(*RotateCredentialResponse)(nil),            // 67: spawner.RotateCredentialResponse
+	(*VerifyCredentialRequest)(nil),             // 68: spawner.VerifyCredentialRequest
+	(*VerifyCredentialResponse)(nil),            // 69: spawner.VerifyCredentialResponse
+	(*ListCredentialVersionsRequest)(nil),       // 70: spawner.ListCredentialVersionsRequest
+	(*ListCredentialVersionsResponse)(nil),      // 71: spawner.ListCredentialVersionsResponse
+	(*GetKubeConfigRequest)(nil),                // 72: spawner.GetKubeConfigRequest
+	(*GetKubeConfigResponse)(nil),               // 73: spawner.GetKubeConfigResponse
+	(*TagNodeInstanceResponse)(nil),             // 74: spawner.TagNodeInstanceResponse
+	(*TagNodeInstanceRequest)(nil),              // 75: spawner.TagNodeInstanceRequest
+	(*GetCostByTimeRequest)(nil),                // 76: spawner.GetCostByTimeRequest
+	(*GetCostByTimeResponse)(nil),               // 77: spawner.GetCostByTimeResponse
+	(*CostMap)(nil),                             // 78: spawner.costMap
+	(*GetContainerRegistryAuthRequest)(nil),     // 79: spawner.GetContainerRegistryAuthRequest
+	(*GetContainerRegistryAuthResponse)(nil),    // 80: spawner.GetContainerRegistryAuthResponse
+	(*CreateContainerRegistryRepoResponse)(nil), // 81: spawner.CreateContainerRegistryRepoResponse
+	(*CreateContainerRegistryRepoRequest)(nil),  // 82: spawner.CreateContainerRegistryRepoRequest
+	(*DeleteSnapshotRequest)(nil),               // 83: spawner.DeleteSnapshotRequest
+	(*DeleteSnapshotResponse)(nil),              // 84: spawner.DeleteSnapshotResponse
+	(*RegisterClusterOIDCRequest)(nil),          // 85: spawner.RegisterClusterOIDCRequest
+	(*RegisterClusterOIDCResponse)(nil),         // 86: spawner.RegisterClusterOIDCResponse
+	(*Route53ResourceRecordSet)(nil),            // 87: spawner.Route53ResourceRecordSet
+	(*Route53ResourceRecord)(nil),               // 88: spawner.Route53ResourceRecord
+	(*CreateRoute53RecordsRequest)(nil),         // 89: spawner.CreateRoute53RecordsRequest
+	(*CreateRoute53RecordsResponse)(nil),        // 90: spawner.CreateRoute53RecordsResponse
+	(*GetRoute53TXTRecordsRequest)(nil),         // 91: spawner.GetRoute53TXTRecordsRequest
+	(*GetRoute53TXTRecordsResponse)(nil),        // 92: spawner.GetRoute53TXTRecordsResponse
+	(*DeleteRoute53RecordsRequest)(nil),         // 93: spawner.DeleteRoute53RecordsRequest
+	(*DeleteRoute53RecordsResponse)(nil),        // 94: spawner.DeleteRoute53RecordsResponse
+	(*CopySnapshotRequest)(nil),                 // 95: spawner.CopySnapshotRequest
+	(*CopySnapshotResponse)(nil),                // 96: spawner.CopySnapshotResponse
+	(*PresignS3UrlRequest)(nil),                 // 97: spawner.PresignS3UrlRequest
+	(*PresignS3UrlResponse)(nil),                // 98: spawner.PresignS3UrlResponse
+	(*Operation)(nil),                           // 99: spawner.Operation
+	(*GetOperationRequest)(nil),                 // 100: spawner.GetOperationRequest
+	(*ListOperationsRequest)(nil),               // 101: spawner.ListOperationsRequest
+	(*ListOperationsResponse)(nil),              // 102: spawner.ListOperationsResponse
+	(*CancelOperationRequest)(nil),              // 103: spawner.CancelOperationRequest
+	(*Resource)(nil),                            // 104: spawner.Resource
+	(*ListResourcesRequest)(nil),                // 105: spawner.ListResourcesRequest
+	(*ListResourcesResponse)(nil),               // 106: spawner.ListResourcesResponse
+	(*GetResourceRequest)(nil),                  // 107: spawner.GetResourceRequest
+	(*ListProvidersRequest)(nil),                // 108: spawner.ListProvidersRequest
+	(*ProviderInfo)(nil),                        // 109: spawner.ProviderInfo
+	(*ListProvidersResponse)(nil),               // 110: spawner.ListProvidersResponse
+	nil,                                         // 111: spawner.NodeSpec.LabelsEntry
+	nil,                                         // 112: spawner.ClusterRequest.LabelsEntry
+	nil,                                         // 113: spawner.CreateVolumeRequest.LabelsEntry
+	nil,                                         // 114: spawner.CreateSnapshotRequest.LabelsEntry
+	nil,                                         // 115: spawner.CreateSnapshotAndDeleteRequest.LabelsEntry
+	nil,                                         // 116: spawner.GetWorkspacesCostResponse.GroupedCostEntry
+	nil,                                         // 117: spawner.GetApplicationsCostResponse.GroupedCostEntry
+	nil,                                         // 118: spawner.TagNodeInstanceRequest.LabelsEntry
+	nil,                                         // 119: spawner.GetCostByTimeResponse.GroupedCostEntry
+	nil,                                         // 120: spawner.costMap.CostEntry
+	nil,                                         // 121: spawner.CreateContainerRegistryRepoRequest.TagsEntry
+	nil,                                         // 122: spawner.CopySnapshotRequest.LabelsEntry
+	nil,                                         // 123: spawner.Resource.LabelsEntry
+	(*status.Status)(nil),                       // 124: google.rpc.Status
+}
+var file_proto_netbookai_spawner_spawner_proto_depIdxs = []int32{
+	111, // 0: spawner.NodeSpec.labels:type_name -> spawner.NodeSpec.LabelsEntry
+	8,   // 1: spawner.NodeSpec.health:type_name -> spawner.Health
+	0,   // 2: spawner.NodeSpec.migProfile:type_name -> spawner.MIGProfile
+	1,   // 3: spawner.NodeSpec.capacityType:type_name -> spawner.CapacityType
+	7,   // 4: spawner.Health.issue:type_name -> spawner.Issue
+	6,   // 5: spawner.ClusterRequest.node:type_name -> spawner.NodeSpec
+	112, // 6: spawner.ClusterRequest.labels:type_name -> spawner.ClusterRequest.LabelsEntry
+	6,   // 7: spawner.ClusterSpec.nodeSpec:type_name -> spawner.NodeSpec
+	12,  // 8: spawner.GetClustersResponse.clusters:type_name -> spawner.ClusterSpec
+	99,  // 9: spawner.ClusterResponse.operation:type_name -> spawner.Operation
+	7,   // 10: spawner.NodeGroupStatus.issues:type_name -> spawner.Issue
+	18,  // 11: spawner.ClusterStatusEvent.nodeGroups:type_name -> spawner.NodeGroupStatus
+	6,   // 12: spawner.NodeSpawnRequest.nodeSpec:type_name -> spawner.NodeSpec
+	99,  // 13: spawner.NodeSpawnResponse.operation:type_name -> spawner.Operation
+	99,  // 14: spawner.ClusterDeleteResponse.operation:type_name -> spawner.Operation
+	99,  // 15: spawner.NodeDeleteResponse.operation:type_name -> spawner.Operation
+	113, // 16: spawner.CreateVolumeRequest.labels:type_name -> spawner.CreateVolumeRequest.LabelsEntry
+	99,  // 17: spawner.CreateVolumeResponse.operation:type_name -> spawner.Operation
+	114, // 18: spawner.CreateSnapshotRequest.labels:type_name -> spawner.CreateSnapshotRequest.LabelsEntry
+	115, // 19: spawner.CreateSnapshotAndDeleteRequest.labels:type_name -> spawner.CreateSnapshotAndDeleteRequest.LabelsEntry
+	99,  // 20: spawner.CreateSnapshotAndDeleteResponse.operation:type_name -> spawner.Operation
+	44,  // 21: spawner.GetWorkspacesCostRequest.groupBy:type_name -> spawner.GroupBy
+	44,  // 22: spawner.GetApplicationsCostRequest.groupBy:type_name -> spawner.GroupBy
+	116, // 23: spawner.GetWorkspacesCostResponse.groupedCost:type_name -> spawner.GetWorkspacesCostResponse.GroupedCostEntry
+	117, // 24: spawner.GetApplicationsCostResponse.groupedCost:type_name -> spawner.GetApplicationsCostResponse.GroupedCostEntry
+	47,  // 25: spawner.WriteCredentialRequest.awsCred:type_name -> spawner.AwsCredentials
+	49,  // 26: spawner.WriteCredentialRequest.azureCred:type_name -> spawner.AzureCredentials
+	50,  // 27: spawner.WriteCredentialRequest.gitPat:type_name -> spawner.GithubPersonalAccessToken
+	51,  // 28: spawner.WriteCredentialRequest.gcpCred:type_name -> spawner.GcpCredentials
+	48,  // 29: spawner.WriteCredentialRequest.awsRoleCred:type_name -> spawner.AwsRoleCredentials
+	52,  // 30: spawner.WriteCredentialRequest.azureFederatedCred:type_name -> spawner.AzureFederatedCredentials
+	53,  // 31: spawner.WriteCredentialRequest.gcpFederatedCred:type_name -> spawner.GcpFederatedCredentials
+	55,  // 32: spawner.WriteCredentialResponse.identity:type_name -> spawner.CredentialIdentity
+	47,  // 33: spawner.ReadCredentialResponse.awsCred:type_name -> spawner.AwsCredentials
+	49,  // 34: spawner.ReadCredentialResponse.azureCred:type_name -> spawner.AzureCredentials
+	50,  // 35: spawner.ReadCredentialResponse.gitPat:type_name -> spawner.GithubPersonalAccessToken
+	51,  // 36: spawner.ReadCredentialResponse.gcpCred:type_name -> spawner.GcpCredentials
+	48,  // 37: spawner.ReadCredentialResponse.awsRoleCred:type_name -> spawner.AwsRoleCredentials
+	52,  // 38: spawner.ReadCredentialResponse.azureFederatedCred:type_name -> spawner.AzureFederatedCredentials
+	53,  // 39: spawner.ReadCredentialResponse.gcpFederatedCred:type_name -> spawner.GcpFederatedCredentials
+	60,  // 40: spawner.ListCredentialsResponse.credentials:type_name -> spawner.CredentialInfo
+	47,  // 41: spawner.RotateCredentialRequest.awsCred:type_name -> spawner.AwsCredentials
+	49,  // 42: spawner.RotateCredentialRequest.azureCred:type_name -> spawner.AzureCredentials
+	50,  // 43: spawner.RotateCredentialRequest.gitPat:type_name -> spawner.GithubPersonalAccessToken
+	51,  // 44: spawner.RotateCredentialRequest.gcpCred:type_name -> spawner.GcpCredentials
+	48,  // 45: spawner.RotateCredentialRequest.awsRoleCred:type_name -> spawner.AwsRoleCredentials
+	52,  // 46: spawner.RotateCredentialRequest.azureFederatedCred:type_name -> spawner.AzureFederatedCredentials
+	53,  // 47: spawner.RotateCredentialRequest.gcpFederatedCred:type_name -> spawner.GcpFederatedCredentials
+	66,  // 48: spawner.RotateCredentialResponse.version:type_name -> spawner.CredentialVersion
+	55,  // 49: spawner.RotateCredentialResponse.identity:type_name -> spawner.CredentialIdentity
+	55,  // 50: spawner.VerifyCredentialResponse.identity:type_name -> spawner.CredentialIdentity
+	66,  // 51: spawner.ListCredentialVersionsResponse.versions:type_name -> spawner.CredentialVersion
+	118, // 52: spawner.TagNodeInstanceRequest.labels:type_name -> spawner.TagNodeInstanceRequest.LabelsEntry
+	44,  // 53: spawner.GetCostByTimeRequest.groupBy:type_name -> spawner.GroupBy
+	119, // 54: spawner.GetCostByTimeResponse.groupedCost:type_name -> spawner.GetCostByTimeResponse.GroupedCostEntry
+	120, // 55: spawner.costMap.cost:type_name -> spawner.costMap.CostEntry
+	121, // 56: spawner.CreateContainerRegistryRepoRequest.tags:type_name -> spawner.CreateContainerRegistryRepoRequest.TagsEntry
+	88,  // 57: spawner.Route53ResourceRecordSet.resourceRecords:type_name -> spawner.Route53ResourceRecord
+	87,  // 58: spawner.CreateRoute53RecordsRequest.records:type_name -> spawner.Route53ResourceRecordSet
+	87,  // 59: spawner.GetRoute53TXTRecordsResponse.records:type_name -> spawner.Route53ResourceRecordSet
+	87,  // 60: spawner.DeleteRoute53RecordsRequest.records:type_name -> spawner.Route53ResourceRecordSet
+	122, // 61: spawner.CopySnapshotRequest.labels:type_name -> spawner.CopySnapshotRequest.LabelsEntry
+	2,   // 62: spawner.Operation.state:type_name -> spawner.OperationState
+	124, // 63: spawner.Operation.status:type_name -> google.rpc.Status
+	14,  // 64: spawner.Operation.createCluster:type_name -> spawner.ClusterResponse
+	27,  // 65: spawner.Operation.addNode:type_name -> spawner.NodeSpawnResponse
+	29,  // 66: spawner.Operation.deleteCluster:type_name -> spawner.ClusterDeleteResponse
+	31,  // 67: spawner.Operation.deleteNode:type_name -> spawner.NodeDeleteResponse
+	33,  // 68: spawner.Operation.createVolume:type_name -> spawner.CreateVolumeResponse
+	39,  // 69: spawner.Operation.createSnapshotAndDelete:type_name -> spawner.CreateSnapshotAndDeleteResponse
+	99,  // 70: spawner.ListOperationsResponse.operations:type_name -> spawner.Operation
+	123, // 71: spawner.Resource.labels:type_name -> spawner.Resource.LabelsEntry
+	104, // 72: spawner.ListResourcesResponse.resources:type_name -> spawner.Resource
+	109, // 73: spawner.ListProvidersResponse.providers:type_name -> spawner.ProviderInfo
+	78,  // 74: spawner.GetCostByTimeResponse.GroupedCostEntry.value:type_name -> spawner.costMap
+	3,   // 75: spawner.SpawnerService.HealthCheck:input_type -> spawner.Empty
+	4,   // 76: spawner.SpawnerService.Echo:input_type -> spawner.EchoRequest
+	9,   // 77: spawner.SpawnerService.CreateCluster:input_type -> spawner.ClusterRequest
+	20,  // 78: spawner.SpawnerService.AddToken:input_type -> spawner.AddTokenRequest
+	22,  // 79: spawner.SpawnerService.GetToken:input_type -> spawner.GetTokenRequest
+	24,  // 80: spawner.SpawnerService.AddRoute53Record:input_type -> spawner.AddRoute53RecordRequest
+	10,  // 81: spawner.SpawnerService.GetCluster:input_type -> spawner.GetClusterRequest
+	11,  // 82: spawner.SpawnerService.GetClusters:input_type -> spawner.GetClustersRequest
+	26,  // 83: spawner.SpawnerService.AddNode:input_type -> spawner.NodeSpawnRequest
+	15,  // 84: spawner.SpawnerService.ClusterStatus:input_type -> spawner.ClusterStatusRequest
+	28,  // 85: spawner.SpawnerService.DeleteCluster:input_type -> spawner.ClusterDeleteRequest
+	30,  // 86: spawner.SpawnerService.DeleteNode:input_type -> spawner.NodeDeleteRequest
+	32,  // 87: spawner.SpawnerService.CreateVolume:input_type -> spawner.CreateVolumeRequest
+	34,  // 88: spawner.SpawnerService.DeleteVolume:input_type -> spawner.DeleteVolumeRequest
+	36,  // 89: spawner.SpawnerService.CreateSnapshot:input_type -> spawner.CreateSnapshotRequest
+	83,  // 90: spawner.SpawnerService.DeleteSnapshot:input_type -> spawner.DeleteSnapshotRequest
+	38,  // 91: spawner.SpawnerService.CreateSnapshotAndDelete:input_type -> spawner.CreateSnapshotAndDeleteRequest
+	40,  // 92: spawner.SpawnerService.RegisterWithRancher:input_type -> spawner.RancherRegistrationRequest
+	42,  // 93: spawner.SpawnerService.GetWorkspacesCost:input_type -> spawner.GetWorkspacesCostRequest
+	43,  // 94: spawner.SpawnerService.GetApplicationsCost:input_type -> spawner.GetApplicationsCostRequest
+	54,  // 95: spawner.SpawnerService.WriteCredential:input_type -> spawner.WriteCredentialRequest
+	57,  // 96: spawner.SpawnerService.ReadCredential:input_type -> spawner.ReadCredentialRequest
+	59,  // 97: spawner.SpawnerService.RevealCredential:input_type -> spawner.RevealCredentialRequest
+	61,  // 98: spawner.SpawnerService.ListCredentials:input_type -> spawner.ListCredentialsRequest
+	63,  // 99: spawner.SpawnerService.DeleteCredential:input_type -> spawner.DeleteCredentialRequest
+	65,  // 100: spawner.SpawnerService.RotateCredential:input_type -> spawner.RotateCredentialRequest
+	68,  // 101: spawner.SpawnerService.VerifyCredential:input_type -> spawner.VerifyCredentialRequest
+	70,  // 102: spawner.SpawnerService.ListCredentialVersions:input_type -> spawner.ListCredentialVersionsRequest
+	72,  // 103: spawner.SpawnerService.GetKubeConfig:input_type -> spawner.GetKubeConfigRequest
+	75,  // 104: spawner.SpawnerService.TagNodeInstance:input_type -> spawner.TagNodeInstanceRequest
+	76,  // 105: spawner.SpawnerService.GetCostByTime:input_type -> spawner.GetCostByTimeRequest
+	79,  // 106: spawner.SpawnerService.GetContainerRegistryAuth:input_type -> spawner.GetContainerRegistryAuthRequest
+	82,  // 107: spawner.SpawnerService.CreateContainerRegistryRepo:input_type -> spawner.CreateContainerRegistryRepoRequest
+	85,  // 108: spawner.SpawnerService.RegisterClusterOIDC:input_type -> spawner.RegisterClusterOIDCRequest
+	89,  // 109: spawner.SpawnerService.CreateRoute53Records:input_type -> spawner.CreateRoute53RecordsRequest
+	91,  // 110: spawner.SpawnerService.GetRoute53TXTRecords:input_type -> spawner.GetRoute53TXTRecordsRequest
+	93,  // 111: spawner.SpawnerService.DeleteRoute53Records:input_type -> spawner.DeleteRoute53RecordsRequest
+	95,  // 112: spawner.SpawnerService.CopySnapshot:input_type -> spawner.CopySnapshotRequest
+	97,  // 113: spawner.SpawnerService.PresignS3Url:input_type -> spawner.PresignS3UrlRequest
+	100, // 114: spawner.SpawnerService.GetOperation:input_type -> spawner.GetOperationRequest
+	101, // 115: spawner.SpawnerService.ListOperations:input_type -> spawner.ListOperationsRequest
+	103, // 116: spawner.SpawnerService.CancelOperation:input_type -> spawner.CancelOperationRequest
+	17,  // 117: spawner.SpawnerService.WatchClusterStatus:input_type -> spawner.WatchClusterStatusRequest
+	105, // 118: spawner.SpawnerService.ListResources:input_type -> spawner.ListResourcesRequest
+	107, // 119: spawner.SpawnerService.GetResource:input_type -> spawner.GetResourceRequest
+	108, // 120: spawner.SpawnerService.ListProviders:input_type -> spawner.ListProvidersRequest
+	3,   // 121: spawner.SpawnerService.HealthCheck:output_type -> spawner.Empty
+	5,   // 122: spawner.SpawnerService.Echo:output_type -> spawner.EchoResponse
+	14,  // 123: spawner.SpawnerService.CreateCluster:output_type -> spawner.ClusterResponse
+	21,  // 124: spawner.SpawnerService.AddToken:output_type -> spawner.AddTokenResponse
+	23,  // 125: spawner.SpawnerService.GetToken:output_type -> spawner.GetTokenResponse
+	25,  // 126: spawner.SpawnerService.AddRoute53Record:output_type -> spawner.AddRoute53RecordResponse
+	12,  // 127: spawner.SpawnerService.GetCluster:output_type -> spawner.ClusterSpec
+	13,  // 128: spawner.SpawnerService.GetClusters:output_type -> spawner.GetClustersResponse
+	27,  // 129: spawner.SpawnerService.AddNode:output_type -> spawner.NodeSpawnResponse
+	16,  // 130: spawner.SpawnerService.ClusterStatus:output_type -> spawner.ClusterStatusResponse
+	29,  // 131: spawner.SpawnerService.DeleteCluster:output_type -> spawner.ClusterDeleteResponse
+	31,  // 132: spawner.SpawnerService.DeleteNode:output_type -> spawner.NodeDeleteResponse
+	33,  // 133: spawner.SpawnerService.CreateVolume:output_type -> spawner.CreateVolumeResponse
+	35,  // 134: spawner.SpawnerService.DeleteVolume:output_type -> spawner.DeleteVolumeResponse
+	37,  // 135: spawner.SpawnerService.CreateSnapshot:output_type -> spawner.CreateSnapshotResponse
+	84,  // 136: spawner.SpawnerService.DeleteSnapshot:output_type -> spawner.DeleteSnapshotResponse
+	39,  // 137: spawner.SpawnerService.CreateSnapshotAndDelete:output_type -> spawner.CreateSnapshotAndDeleteResponse
+	41,  // 138: spawner.SpawnerService.RegisterWithRancher:output_type -> spawner.RancherRegistrationResponse
+	45,  // 139: spawner.SpawnerService.GetWorkspacesCost:output_type -> spawner.GetWorkspacesCostResponse
+	46,  // 140: spawner.SpawnerService.GetApplicationsCost:output_type -> spawner.GetApplicationsCostResponse
+	56,  // 141: spawner.SpawnerService.WriteCredential:output_type -> spawner.WriteCredentialResponse
+	58,  // 142: spawner.SpawnerService.ReadCredential:output_type -> spawner.ReadCredentialResponse
+	58,  // 143: spawner.SpawnerService.RevealCredential:output_type -> spawner.ReadCredentialResponse
+	62,  // 144: spawner.SpawnerService.ListCredentials:output_type -> spawner.ListCredentialsResponse
+	64,  // 145: spawner.SpawnerService.DeleteCredential:output_type -> spawner.DeleteCredentialResponse
+	67,  // 146: spawner.SpawnerService.RotateCredential:output_type -> spawner.RotateCredentialResponse
+	69,  // 147: spawner.SpawnerService.VerifyCredential:output_type -> spawner.VerifyCredentialResponse
+	71,  // 148: spawner.SpawnerService.ListCredentialVersions:output_type -> spawner.ListCredentialVersionsResponse
+	73,  // 149: spawner.SpawnerService.GetKubeConfig:output_type -> spawner.GetKubeConfigResponse
+	74,  // 150: spawner.SpawnerService.TagNodeInstance:output_type -> spawner.TagNodeInstanceResponse
+	77,  // 151: spawner.SpawnerService.GetCostByTime:output_type -> spawner.GetCostByTimeResponse
+	80,  // 152: spawner.SpawnerService.GetContainerRegistryAuth:output_type -> spawner.GetContainerRegistryAuthResponse
+	81,  // 153: spawner.SpawnerService.CreateContainerRegistryRepo:output_type -> spawner.CreateContainerRegistryRepoResponse
+	86,  // 154: spawner.SpawnerService.RegisterClusterOIDC:output_type -> spawner.RegisterClusterOIDCResponse
+	90,  // 155: spawner.SpawnerService.CreateRoute53Records:output_type -> spawner.CreateRoute53RecordsResponse
+	92,  // 156: spawner.SpawnerService.GetRoute53TXTRecords:output_type -> spawner.GetRoute53TXTRecordsResponse
+	94,  // 157: spawner.SpawnerService.DeleteRoute53Records:output_type -> spawner.DeleteRoute53RecordsResponse
+	96,  // 158: spawner.SpawnerService.CopySnapshot:output_type -> spawner.CopySnapshotResponse
+	98,  // 159: spawner.SpawnerService.PresignS3Url:output_type -> spawner.PresignS3UrlResponse
+	99,  // 160: spawner.SpawnerService.GetOperation:output_type -> spawner.Operation
+	102, // 161: spawner.SpawnerService.ListOperations:output_type -> spawner.ListOperationsResponse
+	99,  // 162: spawner.SpawnerService.CancelOperation:output_type -> spawner.Operation
+	19,  // 163: spawner.SpawnerService.WatchClusterStatus:output_type -> spawner.ClusterStatusEvent
+	106, // 164: spawner.SpawnerService.ListResources:output_type -> spawner.ListResourcesResponse
+	104, // 165: spawner.SpawnerService.GetResource:output_type -> spawner.Resource
+	110, // 166: spawner.SpawnerService.ListProviders:output_type -> spawner.ListProvidersResponse
+	121, // [121:167] is the sub-list for method output_type
+	75,  // [75:121] is the sub-list for method input_type
+	75,  // [75:75] is the sub-list for extension type_name
+	75,  // [75:75] is the sub-list for extension extendee
+	0,   // [0:75] is the sub-list for field type_name
+}
+
+func init() { file_proto_netbookai_spawner_spawner_proto_init() }
+func file_proto_netbookai_spawner_spawner_proto_init() {
+	if File_proto_netbookai_spawner_spawner_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EchoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EchoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Issue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Health); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClusterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClustersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClustersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchClusterStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeGroupStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterStatusEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddRoute53RecordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddRoute53RecordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeSpawnRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeSpawnResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateVolumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateVolumeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteVolumeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6195,8 +9937,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EchoRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteVolumeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6207,8 +9949,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EchoResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSnapshotRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6219,8 +9961,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeSpec); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSnapshotResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6231,8 +9973,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Issue); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSnapshotAndDeleteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6243,8 +9985,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Health); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSnapshotAndDeleteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6255,8 +9997,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RancherRegistrationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6267,8 +10009,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetClusterRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RancherRegistrationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6279,8 +10021,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetClustersRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkspacesCostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6291,8 +10033,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterSpec); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetApplicationsCostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6303,8 +10045,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetClustersResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupBy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6315,8 +10057,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkspacesCostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6327,8 +10069,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterStatusRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetApplicationsCostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6339,8 +10081,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterStatusResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AwsCredentials); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6351,8 +10093,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddTokenRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AwsRoleCredentials); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6363,8 +10105,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddTokenResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AzureCredentials); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6375,8 +10117,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTokenRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GithubPersonalAccessToken); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6387,8 +10129,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTokenResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GcpCredentials); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6399,8 +10141,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddRoute53RecordRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AzureFederatedCredentials); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6411,8 +10153,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddRoute53RecordResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GcpFederatedCredentials); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6423,8 +10165,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeSpawnRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteCredentialRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6435,8 +10177,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeSpawnResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CredentialIdentity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6447,8 +10189,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterDeleteRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteCredentialResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6459,8 +10201,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterDeleteResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadCredentialRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6471,8 +10213,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeDeleteRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadCredentialResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6483,8 +10225,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeDeleteResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevealCredentialRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6495,8 +10237,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateVolumeRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CredentialInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6507,8 +10249,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateVolumeResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCredentialsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6519,8 +10261,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteVolumeRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCredentialsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6531,8 +10273,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteVolumeResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCredentialRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6543,8 +10285,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSnapshotRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCredentialResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6555,8 +10297,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSnapshotResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotateCredentialRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6567,8 +10309,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSnapshotAndDeleteRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CredentialVersion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6579,8 +10321,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSnapshotAndDeleteResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotateCredentialResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6591,8 +10333,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RancherRegistrationRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyCredentialRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6603,8 +10345,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RancherRegistrationResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyCredentialResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6615,8 +10357,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWorkspacesCostRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCredentialVersionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6627,8 +10369,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetApplicationsCostRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCredentialVersionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6639,8 +10381,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupBy); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetKubeConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6651,8 +10393,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWorkspacesCostResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetKubeConfigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6663,8 +10405,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetApplicationsCostResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagNodeInstanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6675,8 +10417,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AwsCredentials); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagNodeInstanceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6687,8 +10429,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AzureCredentials); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCostByTimeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6699,8 +10441,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GithubPersonalAccessToken); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCostByTimeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6711,8 +10453,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GcpCredentials); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CostMap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6723,8 +10465,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteCredentialRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContainerRegistryAuthRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6735,8 +10477,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteCredentialResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContainerRegistryAuthResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6747,8 +10489,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadCredentialRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateContainerRegistryRepoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6759,8 +10501,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadCredentialResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateContainerRegistryRepoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6771,8 +10513,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetKubeConfigRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSnapshotRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6783,8 +10525,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetKubeConfigResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSnapshotResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6795,8 +10537,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TagNodeInstanceResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterClusterOIDCRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6807,8 +10549,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TagNodeInstanceRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterClusterOIDCResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6819,8 +10561,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCostByTimeRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Route53ResourceRecordSet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6831,8 +10573,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCostByTimeResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Route53ResourceRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6843,8 +10585,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CostMap); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoute53RecordsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6855,8 +10597,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetContainerRegistryAuthRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoute53RecordsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6867,8 +10609,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetContainerRegistryAuthResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRoute53TXTRecordsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6879,8 +10621,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateContainerRegistryRepoResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRoute53TXTRecordsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6891,8 +10633,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateContainerRegistryRepoRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRoute53RecordsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6903,8 +10645,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSnapshotRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRoute53RecordsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6915,8 +10657,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSnapshotResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CopySnapshotRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6927,8 +10669,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterClusterOIDCRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CopySnapshotResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6939,8 +10681,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterClusterOIDCResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PresignS3UrlRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6951,8 +10693,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Route53ResourceRecordSet); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PresignS3UrlResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6963,8 +10705,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Route53ResourceRecord); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Operation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6975,8 +10717,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRoute53RecordsRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOperationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6987,8 +10729,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRoute53RecordsResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOperationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6999,8 +10741,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRoute53TXTRecordsRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOperationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7011,8 +10753,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRoute53TXTRecordsResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelOperationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7023,8 +10765,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRoute53RecordsRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Resource); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7035,8 +10777,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRoute53RecordsResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListResourcesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7047,8 +10789,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CopySnapshotRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListResourcesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7059,8 +10801,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CopySnapshotResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResourceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7071,8 +10813,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PresignS3UrlRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProvidersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7083,8 +10825,20 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PresignS3UrlResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProvidersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7096,25 +10850,48 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 			}
 		}
 	}
-	file_proto_netbookai_spawner_spawner_proto_msgTypes[45].OneofWrappers = []interface{}{
+	file_proto_netbookai_spawner_spawner_proto_msgTypes[51].OneofWrappers = []interface{}{
 		(*WriteCredentialRequest_AwsCred)(nil),
 		(*WriteCredentialRequest_AzureCred)(nil),
 		(*WriteCredentialRequest_GitPat)(nil),
 		(*WriteCredentialRequest_GcpCred)(nil),
+		(*WriteCredentialRequest_AwsRoleCred)(nil),
+		(*WriteCredentialRequest_AzureFederatedCred)(nil),
+		(*WriteCredentialRequest_GcpFederatedCred)(nil),
 	}
-	file_proto_netbookai_spawner_spawner_proto_msgTypes[48].OneofWrappers = []interface{}{
+	file_proto_netbookai_spawner_spawner_proto_msgTypes[55].OneofWrappers = []interface{}{
 		(*ReadCredentialResponse_AwsCred)(nil),
 		(*ReadCredentialResponse_AzureCred)(nil),
 		(*ReadCredentialResponse_GitPat)(nil),
 		(*ReadCredentialResponse_GcpCred)(nil),
+		(*ReadCredentialResponse_AwsRoleCred)(nil),
+		(*ReadCredentialResponse_AzureFederatedCred)(nil),
+		(*ReadCredentialResponse_GcpFederatedCred)(nil),
+	}
+	file_proto_netbookai_spawner_spawner_proto_msgTypes[62].OneofWrappers = []interface{}{
+		(*RotateCredentialRequest_AwsCred)(nil),
+		(*RotateCredentialRequest_AzureCred)(nil),
+		(*RotateCredentialRequest_GitPat)(nil),
+		(*RotateCredentialRequest_GcpCred)(nil),
+		(*RotateCredentialRequest_AwsRoleCred)(nil),
+		(*RotateCredentialRequest_AzureFederatedCred)(nil),
+		(*RotateCredentialRequest_GcpFederatedCred)(nil),
+	}
+	file_proto_netbookai_spawner_spawner_proto_msgTypes[96].OneofWrappers = []interface{}{
+		(*Operation_CreateCluster)(nil),
+		(*Operation_AddNode)(nil),
+		(*Operation_DeleteCluster)(nil),
+		(*Operation_DeleteNode)(nil),
+		(*Operation_CreateVolume)(nil),
+		(*Operation_CreateSnapshotAndDelete)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_netbookai_spawner_spawner_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   88,
+			NumEnums:      3,
+			NumMessages:   121,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -56,7 +56,23 @@ type SpawnerServiceClient interface {
 	GetWorkspacesCost(ctx context.Context, in *GetWorkspacesCostRequest, opts ...grpc.CallOption) (*GetWorkspacesCostResponse, error)
 	GetApplicationsCost(ctx context.Context, in *GetApplicationsCostRequest, opts ...grpc.CallOption) (*GetApplicationsCostResponse, error)
 	WriteCredential(ctx context.Context, in *WriteCredentialRequest, opts ...grpc.CallOption) (*WriteCredentialResponse, error)
+	// ReadCredential metadata of the credential, secret fields are masked
 	ReadCredential(ctx context.Context, in *ReadCredentialRequest, opts ...grpc.CallOption) (*ReadCredentialResponse, error)
+	// RevealCredential credential along with the secrets, the auth policy must
+	// grant the method by its name
+	RevealCredential(ctx context.Context, in *RevealCredentialRequest, opts ...grpc.CallOption) (*ReadCredentialResponse, error)
+	// ListCredentials stored credentials and the time they were last updated,
+	// secrets are not returned
+	ListCredentials(ctx context.Context, in *ListCredentialsRequest, opts ...grpc.CallOption) (*ListCredentialsResponse, error)
+	DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*DeleteCredentialResponse, error)
+	// RotateCredential stages the new credential as pending, the current one is
+	// used till the rotation is confirmed. confirming makes the pending
+	// credential current and keeps the replaced one as previous
+	RotateCredential(ctx context.Context, in *RotateCredentialRequest, opts ...grpc.CallOption) (*RotateCredentialResponse, error)
+	// VerifyCredential checks the stored credential authenticates with the
+	// provider and reports the permissions spawner requires which are missing
+	VerifyCredential(ctx context.Context, in *VerifyCredentialRequest, opts ...grpc.CallOption) (*VerifyCredentialResponse, error)
+	ListCredentialVersions(ctx context.Context, in *ListCredentialVersionsRequest, opts ...grpc.CallOption) (*ListCredentialVersionsResponse, error)
 	GetKubeConfig(ctx context.Context, in *GetKubeConfigRequest, opts ...grpc.CallOption) (*GetKubeConfigResponse, error)
 	TagNodeInstance(ctx context.Context, in *TagNodeInstanceRequest, opts ...grpc.CallOption) (*TagNodeInstanceResponse, error)
 	GetCostByTime(ctx context.Context, in *GetCostByTimeRequest, opts ...grpc.CallOption) (*GetCostByTimeResponse, error)
@@ -68,6 +84,19 @@ type SpawnerServiceClient interface {
 	DeleteRoute53Records(ctx context.Context, in *DeleteRoute53RecordsRequest, opts ...grpc.CallOption) (*DeleteRoute53RecordsResponse, error)
 	CopySnapshot(ctx context.Context, in *CopySnapshotRequest, opts ...grpc.CallOption) (*CopySnapshotResponse, error)
 	PresignS3Url(ctx context.Context, in *PresignS3UrlRequest, opts ...grpc.CallOption) (*PresignS3UrlResponse, error)
+	// long running operations started by cluster, nodepool and volume mutations
+	GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*Operation, error)
+	ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error)
+	CancelOperation(ctx context.Context, in *CancelOperationRequest, opts ...grpc.CallOption) (*Operation, error)
+	// WatchClusterStatus streams cluster and node group state changes till the
+	// cluster reaches terminal state or client cancels
+	WatchClusterStatus(ctx context.Context, in *WatchClusterStatusRequest, opts ...grpc.CallOption) (SpawnerService_WatchClusterStatusClient, error)
+	// resources provisioned by spawner, served from the inventory without
+	// calling the provider
+	ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error)
+	GetResource(ctx context.Context, in *GetResourceRequest, opts ...grpc.CallOption) (*Resource, error)
+	// ListProviders registered providers and the capabilities they support
+	ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersResponse, error)
 }
 
 type spawnerServiceClient struct {
@@ -277,6 +306,60 @@ func (c *spawnerServiceClient) ReadCredential(ctx context.Context, in *ReadCrede
 	return out, nil
 }
 
+func (c *spawnerServiceClient) RevealCredential(ctx context.Context, in *RevealCredentialRequest, opts ...grpc.CallOption) (*ReadCredentialResponse, error) {
+	out := new(ReadCredentialResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/RevealCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) ListCredentials(ctx context.Context, in *ListCredentialsRequest, opts ...grpc.CallOption) (*ListCredentialsResponse, error) {
+	out := new(ListCredentialsResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/ListCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*DeleteCredentialResponse, error) {
+	out := new(DeleteCredentialResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/DeleteCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) RotateCredential(ctx context.Context, in *RotateCredentialRequest, opts ...grpc.CallOption) (*RotateCredentialResponse, error) {
+	out := new(RotateCredentialResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/RotateCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) VerifyCredential(ctx context.Context, in *VerifyCredentialRequest, opts ...grpc.CallOption) (*VerifyCredentialResponse, error) {
+	out := new(VerifyCredentialResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/VerifyCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) ListCredentialVersions(ctx context.Context, in *ListCredentialVersionsRequest, opts ...grpc.CallOption) (*ListCredentialVersionsResponse, error) {
+	out := new(ListCredentialVersionsResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/ListCredentialVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *spawnerServiceClient) GetKubeConfig(ctx context.Context, in *GetKubeConfigRequest, opts ...grpc.CallOption) (*GetKubeConfigResponse, error) {
 	out := new(GetKubeConfigResponse)
 	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/GetKubeConfig", in, out, opts...)
@@ -376,6 +459,92 @@ func (c *spawnerServiceClient) PresignS3Url(ctx context.Context, in *PresignS3Ur
 	return out, nil
 }
 
+func (c *spawnerServiceClient) GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*Operation, error) {
+	out := new(Operation)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/GetOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error) {
+	out := new(ListOperationsResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/ListOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) CancelOperation(ctx context.Context, in *CancelOperationRequest, opts ...grpc.CallOption) (*Operation, error) {
+	out := new(Operation)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/CancelOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) WatchClusterStatus(ctx context.Context, in *WatchClusterStatusRequest, opts ...grpc.CallOption) (SpawnerService_WatchClusterStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SpawnerService_ServiceDesc.Streams[0], "/spawner.SpawnerService/WatchClusterStatus", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &spawnerServiceWatchClusterStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SpawnerService_WatchClusterStatusClient interface {
+	Recv() (*ClusterStatusEvent, error)
+	grpc.ClientStream
+}
+
+type spawnerServiceWatchClusterStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *spawnerServiceWatchClusterStatusClient) Recv() (*ClusterStatusEvent, error) {
+	m := new(ClusterStatusEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *spawnerServiceClient) ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error) {
+	out := new(ListResourcesResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/ListResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) GetResource(ctx context.Context, in *GetResourceRequest, opts ...grpc.CallOption) (*Resource, error) {
+	out := new(Resource)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/GetResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersResponse, error) {
+	out := new(ListProvidersResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/ListProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SpawnerServiceServer is the server API for SpawnerService service.
 // All implementations must embed UnimplementedSpawnerServiceServer
 // for forward compatibility
@@ -414,7 +583,23 @@ type SpawnerServiceServer interface {
 	GetWorkspacesCost(context.Context, *GetWorkspacesCostRequest) (*GetWorkspacesCostResponse, error)
 	GetApplicationsCost(context.Context, *GetApplicationsCostRequest) (*GetApplicationsCostResponse, error)
 	WriteCredential(context.Context, *WriteCredentialRequest) (*WriteCredentialResponse, error)
+	// ReadCredential metadata of the credential, secret fields are masked
 	ReadCredential(context.Context, *ReadCredentialRequest) (*ReadCredentialResponse, error)
+	// RevealCredential credential along with the secrets, the auth policy must
+	// grant the method by its name
+	RevealCredential(context.Context, *RevealCredentialRequest) (*ReadCredentialResponse, error)
+	// ListCredentials stored credentials and the time they were last updated,
+	// secrets are not returned
+	ListCredentials(context.Context, *ListCredentialsRequest) (*ListCredentialsResponse, error)
+	DeleteCredential(context.Context, *DeleteCredentialRequest) (*DeleteCredentialResponse, error)
+	// RotateCredential stages the new credential as pending, the current one is
+	// used till the rotation is confirmed. confirming makes the pending
+	// credential current and keeps the replaced one as previous
+	RotateCredential(context.Context, *RotateCredentialRequest) (*RotateCredentialResponse, error)
+	// VerifyCredential checks the stored credential authenticates with the
+	// provider and reports the permissions spawner requires which are missing
+	VerifyCredential(context.Context, *VerifyCredentialRequest) (*VerifyCredentialResponse, error)
+	ListCredentialVersions(context.Context, *ListCredentialVersionsRequest) (*ListCredentialVersionsResponse, error)
 	GetKubeConfig(context.Context, *GetKubeConfigRequest) (*GetKubeConfigResponse, error)
 	TagNodeInstance(context.Context, *TagNodeInstanceRequest) (*TagNodeInstanceResponse, error)
 	GetCostByTime(context.Context, *GetCostByTimeRequest) (*GetCostByTimeResponse, error)
@@ -426,6 +611,19 @@ type SpawnerServiceServer interface {
 	DeleteRoute53Records(context.Context, *DeleteRoute53RecordsRequest) (*DeleteRoute53RecordsResponse, error)
 	CopySnapshot(context.Context, *CopySnapshotRequest) (*CopySnapshotResponse, error)
 	PresignS3Url(context.Context, *PresignS3UrlRequest) (*PresignS3UrlResponse, error)
+	// long running operations started by cluster, nodepool and volume mutations
+	GetOperation(context.Context, *GetOperationRequest) (*Operation, error)
+	ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error)
+	CancelOperation(context.Context, *CancelOperationRequest) (*Operation, error)
+	// WatchClusterStatus streams cluster and node group state changes till the
+	// cluster reaches terminal state or client cancels
+	WatchClusterStatus(*WatchClusterStatusRequest, SpawnerService_WatchClusterStatusServer) error
+	// resources provisioned by spawner, served from the inventory without
+	// calling the provider
+	ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error)
+	GetResource(context.Context, *GetResourceRequest) (*Resource, error)
+	// ListProviders registered providers and the capabilities they support
+	ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error)
 	mustEmbedUnimplementedSpawnerServiceServer()
 }
 
@@ -499,6 +697,24 @@ func (UnimplementedSpawnerServiceServer) WriteCredential(context.Context, *Write
 func (UnimplementedSpawnerServiceServer) ReadCredential(context.Context, *ReadCredentialRequest) (*ReadCredentialResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadCredential not implemented")
 }
+func (UnimplementedSpawnerServiceServer) RevealCredential(context.Context, *RevealCredentialRequest) (*ReadCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealCredential not implemented")
+}
+func (UnimplementedSpawnerServiceServer) ListCredentials(context.Context, *ListCredentialsRequest) (*ListCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredentials not implemented")
+}
+func (UnimplementedSpawnerServiceServer) DeleteCredential(context.Context, *DeleteCredentialRequest) (*DeleteCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredential not implemented")
+}
+func (UnimplementedSpawnerServiceServer) RotateCredential(context.Context, *RotateCredentialRequest) (*RotateCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateCredential not implemented")
+}
+func (UnimplementedSpawnerServiceServer) VerifyCredential(context.Context, *VerifyCredentialRequest) (*VerifyCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCredential not implemented")
+}
+func (UnimplementedSpawnerServiceServer) ListCredentialVersions(context.Context, *ListCredentialVersionsRequest) (*ListCredentialVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredentialVersions not implemented")
+}
 func (UnimplementedSpawnerServiceServer) GetKubeConfig(context.Context, *GetKubeConfigRequest) (*GetKubeConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetKubeConfig not implemented")
 }
@@ -532,6 +748,27 @@ func (UnimplementedSpawnerServiceServer) CopySnapshot(context.Context, *CopySnap
 func (UnimplementedSpawnerServiceServer) PresignS3Url(context.Context, *PresignS3UrlRequest) (*PresignS3UrlResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PresignS3Url not implemented")
 }
+func (UnimplementedSpawnerServiceServer) GetOperation(context.Context, *GetOperationRequest) (*Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperation not implemented")
+}
+func (UnimplementedSpawnerServiceServer) ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOperations not implemented")
+}
+func (UnimplementedSpawnerServiceServer) CancelOperation(context.Context, *CancelOperationRequest) (*Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOperation not implemented")
+}
+func (UnimplementedSpawnerServiceServer) WatchClusterStatus(*WatchClusterStatusRequest, SpawnerService_WatchClusterStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchClusterStatus not implemented")
+}
+func (UnimplementedSpawnerServiceServer) ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResources not implemented")
+}
+func (UnimplementedSpawnerServiceServer) GetResource(context.Context, *GetResourceRequest) (*Resource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResource not implemented")
+}
+func (UnimplementedSpawnerServiceServer) ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProviders not implemented")
+}
 func (UnimplementedSpawnerServiceServer) mustEmbedUnimplementedSpawnerServiceServer() {}
 
 // UnsafeSpawnerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -941,6 +1178,114 @@ func _SpawnerService_ReadCredential_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SpawnerService_RevealCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevealCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).RevealCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/RevealCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).RevealCredential(ctx, req.(*RevealCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_ListCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).ListCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/ListCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).ListCredentials(ctx, req.(*ListCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_DeleteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).DeleteCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/DeleteCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).DeleteCredential(ctx, req.(*DeleteCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_RotateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).RotateCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/RotateCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).RotateCredential(ctx, req.(*RotateCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_VerifyCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).VerifyCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/VerifyCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).VerifyCredential(ctx, req.(*VerifyCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_ListCredentialVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCredentialVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).ListCredentialVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/ListCredentialVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).ListCredentialVersions(ctx, req.(*ListCredentialVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SpawnerService_GetKubeConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetKubeConfigRequest)
 	if err := dec(in); err != nil {
@@ -1139,6 +1484,135 @@ func _SpawnerService_PresignS3Url_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SpawnerService_GetOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).GetOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/GetOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).GetOperation(ctx, req.(*GetOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_ListOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).ListOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/ListOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).ListOperations(ctx, req.(*ListOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_CancelOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).CancelOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/CancelOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).CancelOperation(ctx, req.(*CancelOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_WatchClusterStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchClusterStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SpawnerServiceServer).WatchClusterStatus(m, &spawnerServiceWatchClusterStatusServer{stream})
+}
+
+type SpawnerService_WatchClusterStatusServer interface {
+	Send(*ClusterStatusEvent) error
+	grpc.ServerStream
+}
+
+type spawnerServiceWatchClusterStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *spawnerServiceWatchClusterStatusServer) Send(m *ClusterStatusEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SpawnerService_ListResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).ListResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/ListResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).ListResources(ctx, req.(*ListResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_GetResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).GetResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/GetResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).GetResource(ctx, req.(*GetResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_ListProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).ListProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/ListProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).ListProviders(ctx, req.(*ListProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SpawnerService_ServiceDesc is the grpc.ServiceDesc for SpawnerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1234,6 +1708,30 @@ var SpawnerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReadCredential",
 			Handler:    _SpawnerService_ReadCredential_Handler,
 		},
+		{
+			MethodName: "RevealCredential",
+			Handler:    _SpawnerService_RevealCredential_Handler,
+		},
+		{
+			MethodName: "ListCredentials",
+			Handler:    _SpawnerService_ListCredentials_Handler,
+		},
+		{
+			MethodName: "DeleteCredential",
+			Handler:    _SpawnerService_DeleteCredential_Handler,
+		},
+		{
+			MethodName: "RotateCredential",
+			Handler:    _SpawnerService_RotateCredential_Handler,
+		},
+		{
+			MethodName: "VerifyCredential",
+			Handler:    _SpawnerService_VerifyCredential_Handler,
+		},
+		{
+			MethodName: "ListCredentialVersions",
+			Handler:    _SpawnerService_ListCredentialVersions_Handler,
+		},
 		{
 			MethodName: "GetKubeConfig",
 			Handler:    _SpawnerService_GetKubeConfig_Handler,
@@ -1278,7 +1776,37 @@ var SpawnerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PresignS3Url",
 			Handler:    _SpawnerService_PresignS3Url_Handler,
 		},
+		{
+			MethodName: "GetOperation",
+			Handler:    _SpawnerService_GetOperation_Handler,
+		},
+		{
+			MethodName: "ListOperations",
+			Handler:    _SpawnerService_ListOperations_Handler,
+		},
+		{
+			MethodName: "CancelOperation",
+			Handler:    _SpawnerService_CancelOperation_Handler,
+		},
+		{
+			MethodName: "ListResources",
+			Handler:    _SpawnerService_ListResources_Handler,
+		},
+		{
+			MethodName: "GetResource",
+			Handler:    _SpawnerService_GetResource_Handler,
+		},
+		{
+			MethodName: "ListProviders",
+			Handler:    _SpawnerService_ListProviders_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchClusterStatus",
+			Handler:       _SpawnerService_WatchClusterStatus_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/netbookai/spawner/spawner.proto",
 }